@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"maps"
+	"sync"
+	"testing"
+)
+
+// ============================================================================
+// Batch Operations
+// ============================================================================
+
+type batchCache interface {
+	Cache[string, int]
+	SetMany(items map[string]int)
+	GetMany(keys []string) map[string]int
+	DeleteMany(keys []string) int
+	OnEvict(callback EvictCallback[string, int])
+}
+
+func batchCaches() map[string]batchCache {
+	return map[string]batchCache{
+		"LRU":  NewLRUCache[string, int](10),
+		"LFU":  NewLFUCache[string, int](10),
+		"FIFO": NewFIFOCache[string, int](10),
+	}
+}
+
+func TestCache_SetMany(t *testing.T) {
+	for name, cache := range batchCaches() {
+		t.Run(name, func(t *testing.T) {
+			cache.Set("a", 0)
+			cache.SetMany(map[string]int{"a": 1, "b": 2, "c": 3})
+
+			if cache.Len() != 3 {
+				t.Errorf("Expected 3 items, got %d", cache.Len())
+			}
+			for key, expected := range map[string]int{"a": 1, "b": 2, "c": 3} {
+				if val, exists := cache.Get(key); !exists || val != expected {
+					t.Errorf("Expected %s=%d, got %d, %v", key, expected, val, exists)
+				}
+			}
+
+			cache.SetMany(nil)
+			if cache.Len() != 3 {
+				t.Errorf("SetMany(nil) should be a no-op, got %d items", cache.Len())
+			}
+		})
+	}
+}
+
+func TestCache_GetMany_PartialHit(t *testing.T) {
+	for name, cache := range batchCaches() {
+		t.Run(name, func(t *testing.T) {
+			cache.SetMany(map[string]int{"a": 1, "b": 2, "c": 3})
+
+			found := cache.GetMany([]string{"a", "missing", "c", "other"})
+			expected := map[string]int{"a": 1, "c": 3}
+			if !maps.Equal(found, expected) {
+				t.Errorf("Expected %v, got %v", expected, found)
+			}
+
+			if found := cache.GetMany(nil); len(found) != 0 {
+				t.Errorf("Expected no items for no keys, got %v", found)
+			}
+		})
+	}
+}
+
+func TestCache_DeleteMany_Count(t *testing.T) {
+	for name, cache := range batchCaches() {
+		t.Run(name, func(t *testing.T) {
+			cache.SetMany(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+
+			if deleted := cache.DeleteMany([]string{"a", "c", "missing"}); deleted != 2 {
+				t.Errorf("Expected 2 deleted items, got %d", deleted)
+			}
+			if deleted := cache.DeleteMany([]string{"a", "c"}); deleted != 0 {
+				t.Errorf("Expected no deleted items on repeat, got %d", deleted)
+			}
+			if deleted := cache.DeleteMany([]string{"b", "b"}); deleted != 1 {
+				t.Errorf("Duplicate keys should be deleted once, got %d", deleted)
+			}
+
+			if cache.Len() != 1 {
+				t.Errorf("Expected 1 item, got %d", cache.Len())
+			}
+			if _, exists := cache.Get("d"); !exists {
+				t.Error("Untouched key should remain")
+			}
+		})
+	}
+}
+
+func TestCache_DeleteMany_NotifiesEvictions(t *testing.T) {
+	for name, cache := range batchCaches() {
+		t.Run(name, func(t *testing.T) {
+			recorder := &evictionRecorder{}
+			cache.OnEvict(recorder.callback)
+			cache.SetMany(map[string]int{"a": 1, "b": 2})
+
+			cache.DeleteMany([]string{"a", "b"})
+
+			events := recorder.take()
+			if len(events) != 2 {
+				t.Fatalf("Expected 2 eviction callbacks, got %v", events)
+			}
+			for _, event := range events {
+				if event.reason != Deleted {
+					t.Errorf("Expected reason Deleted, got %v", event.reason)
+				}
+			}
+		})
+	}
+}
+
+func TestCache_SetMany_Atomic(t *testing.T) {
+	for name, cache := range batchCaches() {
+		t.Run(name, func(t *testing.T) {
+			batch := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					cache.SetMany(batch)
+					cache.DeleteMany([]string{"a", "b", "c", "d"})
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					if found := len(cache.GetMany([]string{"a", "b", "c", "d"})); found != 0 && found != 4 {
+						t.Errorf("Expected none or all of the batch, got %d items", found)
+						return
+					}
+				}
+			}()
+			wg.Wait()
+		})
+	}
+}
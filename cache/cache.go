@@ -1,32 +1,42 @@
 // Package cache provides implementations of various caching strategies
-// including LRU (Least Recently Used) and LFU (Least Frequently Used) caches.
+// including LRU (Least Recently Used), LFU (Least Frequently Used) and FIFO (First In, First Out) caches.
 //
 // Caching helps improve performance by storing frequently accessed data
 // in memory for quick retrieval, while automatically evicting less important
-// data when capacity limits are reached.
+// data when capacity limits are reached. Items can additionally expire after
+// a time to live (see SetWithTTL, WithDefaultTTL and StartJanitor), and the contents
+// of a cache can be saved with Snapshot and loaded back with Restore to warm it up.
+// LoadingCache reads through to a backing store, loading missing keys on demand.
+// SegmentedLRUCache is a scan-resistant LRU variant keeping items accessed more than once
+// in a protected segment, and WeightedLRUCache bounds the total weight of its items,
+// e.g. their size in bytes, instead of their number.
 package cache
 
 // Cache defines the interface for a generic cache implementation.
 // All cache implementations must support basic operations: setting values,
-// retrieving values, deleting values, clearing all data, and flushing to capacity.
+// retrieving values, deleting values, clearing all data, flushing to capacity
+// and reporting their size.
 //
 // The interface is designed to work with various eviction strategies such as
-// LRU (Least Recently Used) and LFU (Least Frequently Used).
+// LRU (Least Recently Used), LFU (Least Frequently Used) and FIFO (First In, First Out).
+// Use NewCache to pick the strategy at runtime, e.g. from a configuration value.
 //
 // Type parameters:
-//   - D: The type of data stored in the cache
 //   - K: The type of keys used to identify cached data (must be comparable)
+//   - D: The type of data stored in the cache
 //
 // Thread Safety:
 //
-// Cache implementations are not thread-safe by design. For concurrent access,
-// wrap the cache with appropriate synchronization primitives (e.g., sync.RWMutex).
-type Cache[D any, K comparable] interface {
+// LRUCache, SegmentedLRUCache, WeightedLRUCache, LFUCache and FIFOCache are safe for concurrent use; every operation is guarded by a mutex.
+// Under heavy contention, ShardedCache spreads keys over several independently locked caches.
+// Custom implementations should provide the same guarantee.
+type Cache[K comparable, D any] interface {
 	// Set stores a value in the cache with the specified key.
-	// If the key already exists, the existing value is preserved (not updated).
+	// If the key already exists, its value is overwritten.
 	// When the cache is at capacity, behavior varies by implementation:
 	//   - LRU: Evicts the least recently used item
 	//   - LFU: Items are organized by frequency; call Flush to manage capacity
+	//   - FIFO: Evicts the oldest inserted item
 	Set(key K, data D)
 
 	// Get retrieves a value from the cache by its key.
@@ -35,13 +45,13 @@ type Cache[D any, K comparable] interface {
 	// Side effects vary by implementation:
 	//   - LRU: Marks the item as most recently used
 	//   - LFU: Increments the item's access frequency
+	//   - FIFO: None
 	Get(key K) (D, bool)
 
 	// Delete removes a value from the cache by its key.
 	// Returns true if the key was found and deleted, false otherwise.
 	//
 	// Implementation-specific behavior:
-	//   - LRU: Always returns true (for historical reasons)
 	//   - LFU: Always returns true (for historical reasons)
 	Delete(key K) bool
 
@@ -59,13 +69,17 @@ type Cache[D any, K comparable] interface {
 	// cache's eviction policy. The exact behavior depends on the implementation:
 	//
 	//   - LRU: Removes items beyond capacity, keeping only the most recently used
-	//   - LFU: Removes low-frequency buckets when bucket count exceeds capacity
+	//   - LFU: Removes items beyond capacity, keeping only the most frequently used
+	//   - FIFO: Removes items beyond capacity, keeping only the newest
 	//
 	// This operation is useful for:
 	//   - Periodic cleanup to enforce capacity limits
 	//   - Reclaiming memory when the cache has grown beyond desired size
 	//   - Ensuring consistent cache size after bulk operations
 	Flush()
+
+	// Len returns the number of items in the cache, not counting expired items.
+	Len() int
 }
 
 // PrimaryCache is a simple map-based cache with no eviction policy.
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrComputePanicked is returned to callers waiting on a GetOrCompute computation that panicked.
+var ErrComputePanicked = errors.New("cache: compute function panicked")
+
+// call is an in-flight or completed computation of a cache value.
+type call[D any] struct {
+	// done is closed once item and err are set
+	done chan struct{}
+	item D
+	err  error
+}
+
+// flightGroup deduplicates concurrent computations of the same key,
+// so only one caller runs the computation and the others wait for its result.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of the computed values
+type flightGroup[K comparable, D any] struct {
+	// mutex guards calls
+	mutex sync.Mutex
+	// calls maps keys to their in-flight computations
+	calls map[K]*call[D]
+}
+
+// do runs compute for key unless a computation for the same key is already running,
+// in which case it waits for that computation and returns its result.
+//
+// Parameters:
+//   - key: The key identifying the computation
+//   - compute: The function producing the value
+//
+// Returns:
+//   - The computed value and error, shared by all callers waiting on key
+func (group *flightGroup[K, D]) do(key K, compute func() (D, error)) (D, error) {
+	group.mutex.Lock()
+
+	if running, exists := group.calls[key]; exists {
+		group.mutex.Unlock()
+		<-running.done
+		return running.item, running.err
+	}
+
+	if group.calls == nil {
+		group.calls = make(map[K]*call[D])
+	}
+
+	current := &call[D]{done: make(chan struct{}), err: ErrComputePanicked}
+	group.calls[key] = current
+	group.mutex.Unlock()
+
+	// Release waiters even if compute panics
+	defer func() {
+		group.mutex.Lock()
+		delete(group.calls, key)
+		group.mutex.Unlock()
+		close(current.done)
+	}()
+
+	current.item, current.err = compute()
+
+	return current.item, current.err
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// GetOrCompute
+// ============================================================================
+
+type computingCache interface {
+	Get(key string) (int, bool)
+	GetOrCompute(key string, compute func() (int, error)) (int, error)
+}
+
+func computingCaches() map[string]computingCache {
+	return map[string]computingCache{
+		"LRU":  NewLRUCache[string, int](10),
+		"LFU":  NewLFUCache[string, int](10),
+		"FIFO": NewFIFOCache[string, int](10),
+	}
+}
+
+func TestCache_GetOrCompute(t *testing.T) {
+	for name, cache := range computingCaches() {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			compute := func() (int, error) {
+				calls++
+				return 42, nil
+			}
+
+			for i := 0; i < 3; i++ {
+				val, err := cache.GetOrCompute("key", compute)
+				if err != nil || val != 42 {
+					t.Errorf("Expected 42, got %d, %v", val, err)
+				}
+			}
+			if calls != 1 {
+				t.Errorf("compute should run once, ran %d times", calls)
+			}
+			if val, exists := cache.Get("key"); !exists || val != 42 {
+				t.Errorf("Computed value should be cached, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestCache_GetOrCompute_Error(t *testing.T) {
+	failure := errors.New("failure")
+
+	for name, cache := range computingCaches() {
+		t.Run(name, func(t *testing.T) {
+			val, err := cache.GetOrCompute("key", func() (int, error) { return 1, failure })
+			if !errors.Is(err, failure) || val != 0 {
+				t.Errorf("Expected zero value and failure, got %d, %v", val, err)
+			}
+			if _, exists := cache.Get("key"); exists {
+				t.Error("Errors should not be cached")
+			}
+
+			val, err = cache.GetOrCompute("key", func() (int, error) { return 2, nil })
+			if err != nil || val != 2 {
+				t.Errorf("Expected retry to compute 2, got %d, %v", val, err)
+			}
+		})
+	}
+}
+
+func TestCache_GetOrCompute_ConcurrentMissesComputeOnce(t *testing.T) {
+	for name, cache := range computingCaches() {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+
+			compute := func() (int, error) {
+				calls.Add(1)
+				<-release
+				return 7, nil
+			}
+
+			const callers = 32
+			var started, wg sync.WaitGroup
+			results := make([]int, callers)
+
+			started.Add(callers)
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					started.Done()
+					results[i], _ = cache.GetOrCompute("key", compute)
+				}(i)
+			}
+
+			started.Wait()
+			time.Sleep(10 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			if calls.Load() != 1 {
+				t.Errorf("compute should run exactly once, ran %d times", calls.Load())
+			}
+			for i, result := range results {
+				if result != 7 {
+					t.Errorf("Caller %d expected 7, got %d", i, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCache_GetOrCompute_Panic(t *testing.T) {
+	cache := NewLRUCache[string, int](10)
+	entered := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		defer func() { _ = recover() }()
+		_, _ = cache.GetOrCompute("key", func() (int, error) {
+			close(entered)
+			<-release
+			panic("boom")
+		})
+	}()
+
+	<-entered
+	done := make(chan error)
+	go func() {
+		_, err := cache.GetOrCompute("key", func() (int, error) { return 1, nil })
+		done <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if err := <-done; !errors.Is(err, ErrComputePanicked) && err != nil {
+		t.Errorf("Waiter should get ErrComputePanicked or recompute, got %v", err)
+	}
+
+	if val, err := cache.GetOrCompute("key", func() (int, error) { return 3, nil }); err != nil || (val != 3 && val != 1) {
+		t.Errorf("Cache should recover after a panicking compute, got %d, %v", val, err)
+	}
+}
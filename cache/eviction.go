@@ -0,0 +1,85 @@
+package cache
+
+// EvictReason describes why an item left the cache (or had its value replaced).
+type EvictReason uint8
+
+const (
+	// Capacity indicates the item was evicted to keep the cache within its capacity.
+	Capacity EvictReason = iota
+
+	// Expired indicates the item outlived its time to live.
+	Expired
+
+	// Deleted indicates the item was removed with Delete.
+	Deleted
+
+	// Replaced indicates the value of the item was overwritten by Set or SetWithTTL.
+	// The callback receives the old value; the key stays in the cache.
+	Replaced
+
+	// Cleared indicates the item was removed by Clear.
+	Cleared
+)
+
+// String returns the name of the reason.
+func (reason EvictReason) String() string {
+	switch reason {
+	case Capacity:
+		return "Capacity"
+	case Expired:
+		return "Expired"
+	case Deleted:
+		return "Deleted"
+	case Replaced:
+		return "Replaced"
+	case Cleared:
+		return "Cleared"
+	default:
+		return "Unknown"
+	}
+}
+
+// EvictCallback is called after an item has been removed from a cache.
+//
+// Type parameters:
+//   - K: The type of keys
+//   - D: The type of data stored
+type EvictCallback[K comparable, D any] func(key K, item D, reason EvictReason)
+
+// eviction is a removed item waiting to be reported to the eviction callback.
+type eviction[K comparable, D any] struct {
+	key    K
+	item   D
+	reason EvictReason
+}
+
+// evictions collects removed items while the owning cache is locked,
+// so the callback can be invoked after the lock is released.
+// It is not thread-safe; the owning cache guards it.
+type evictions[K comparable, D any] struct {
+	// callback is the registered eviction callback, or nil if none
+	callback EvictCallback[K, D]
+	// pending holds the evictions recorded since the last take
+	pending []eviction[K, D]
+}
+
+// record queues an eviction for the callback. It is a no-op when no callback is registered.
+func (evictions *evictions[K, D]) record(key K, item D, reason EvictReason) {
+	if evictions.callback != nil {
+		evictions.pending = append(evictions.pending, eviction[K, D]{key: key, item: item, reason: reason})
+	}
+}
+
+// take returns the callback and the queued evictions, leaving the queue empty.
+func (evictions *evictions[K, D]) take() (EvictCallback[K, D], []eviction[K, D]) {
+	pending := evictions.pending
+	evictions.pending = nil
+	return evictions.callback, pending
+}
+
+// notify invokes callback for every eviction in order. It must be called without holding the cache lock.
+func notify[K comparable, D any](callback EvictCallback[K, D], pending []eviction[K, D]) {
+	for _, evicted := range pending {
+		callback(evicted.key, evicted.item, evicted.reason)
+	}
+}
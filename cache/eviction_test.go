@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Eviction Callback
+// ============================================================================
+
+type evicted struct {
+	key    string
+	item   int
+	reason EvictReason
+}
+
+// evictionRecorder registers an eviction callback collecting every eviction.
+type evictionRecorder struct {
+	events []evicted
+}
+
+func (recorder *evictionRecorder) callback(key string, item int, reason EvictReason) {
+	recorder.events = append(recorder.events, evicted{key, item, reason})
+}
+
+func (recorder *evictionRecorder) take() []evicted {
+	events := recorder.events
+	recorder.events = nil
+	return events
+}
+
+type evictingCache interface {
+	ttlCache
+	OnEvict(callback EvictCallback[string, int])
+}
+
+func evictingCaches(capacity int, opts ...Option) map[string]evictingCache {
+	return map[string]evictingCache{
+		"LRU":  NewLRUCache[string, int](capacity, opts...),
+		"LFU":  NewLFUCache[string, int](capacity, opts...),
+		"FIFO": NewFIFOCache[string, int](capacity, opts...),
+	}
+}
+
+func TestCache_OnEvict_Reasons(t *testing.T) {
+	clock := newFakeClock()
+
+	for name, cache := range evictingCaches(10, WithClock(clock.Now)) {
+		t.Run(name, func(t *testing.T) {
+			recorder := &evictionRecorder{}
+			cache.OnEvict(recorder.callback)
+
+			cache.Set("a", 1)
+			cache.Set("a", 2)
+			if events := recorder.take(); !slices.Equal(events, []evicted{{"a", 1, Replaced}}) {
+				t.Errorf("Expected Replaced event, got %v", events)
+			}
+
+			cache.Delete("a")
+			cache.Delete("missing")
+			if events := recorder.take(); !slices.Equal(events, []evicted{{"a", 2, Deleted}}) {
+				t.Errorf("Expected Deleted event, got %v", events)
+			}
+
+			cache.SetWithTTL("b", 3, time.Second)
+			clock.Advance(time.Second)
+			cache.Get("b")
+			if events := recorder.take(); !slices.Equal(events, []evicted{{"b", 3, Expired}}) {
+				t.Errorf("Expected Expired event, got %v", events)
+			}
+
+			cache.Set("c", 4)
+			cache.Set("d", 5)
+			cache.Clear()
+			events := recorder.take()
+			slices.SortFunc(events, func(x, y evicted) int { return x.item - y.item })
+			if !slices.Equal(events, []evicted{{"c", 4, Cleared}, {"d", 5, Cleared}}) {
+				t.Errorf("Expected Cleared events, got %v", events)
+			}
+
+			cache.OnEvict(nil)
+			cache.Set("e", 6)
+			cache.Delete("e")
+			if events := recorder.take(); len(events) != 0 {
+				t.Errorf("Unregistered callback should not be called, got %v", events)
+			}
+		})
+	}
+}
+
+func TestLRUCache_OnEvict_Capacity(t *testing.T) {
+	cache := NewLRUCache[string, int](2)
+	recorder := &evictionRecorder{}
+	cache.OnEvict(recorder.callback)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"a", 1, Capacity}}) {
+		t.Errorf("Expected Capacity event for a, got %v", events)
+	}
+
+	cache.capacity = 1
+	cache.Flush()
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"b", 2, Capacity}}) {
+		t.Errorf("Expected Capacity event for b on flush, got %v", events)
+	}
+}
+
+func TestLFUCache_OnEvict_Capacity(t *testing.T) {
+	cache := NewLFUCache[string, int](2)
+	recorder := &evictionRecorder{}
+	cache.OnEvict(recorder.callback)
+
+	cache.Set("hot", 1)
+	cache.Get("hot")
+	cache.Set("cold", 2)
+	cache.Set("new", 3)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"cold", 2, Capacity}}) {
+		t.Errorf("Expected Capacity event for cold, got %v", events)
+	}
+
+	cache.capacity = 1
+	cache.Flush()
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"new", 3, Capacity}}) {
+		t.Errorf("Expected Capacity event for new on flush, got %v", events)
+	}
+}
+
+func TestCache_OnEvict_Janitor(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewLRUCache[string, int](10, WithClock(clock.Now))
+
+	done := make(chan evicted, 1)
+	cache.OnEvict(func(key string, item int, reason EvictReason) {
+		done <- evicted{key, item, reason}
+	})
+
+	cache.SetWithTTL("a", 1, time.Second)
+	clock.Advance(time.Second)
+	cache.StartJanitor(time.Millisecond)
+	defer cache.StopJanitor()
+
+	select {
+	case event := <-done:
+		if event != (evicted{"a", 1, Expired}) {
+			t.Errorf("Expected Expired event for a, got %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("janitor did not report the expired item")
+	}
+}
+
+func TestCache_OnEvict_CallbackMayUseCache(t *testing.T) {
+	for name, cache := range evictingCaches(10) {
+		t.Run(name, func(t *testing.T) {
+			cache.OnEvict(func(key string, item int, reason EvictReason) {
+				if reason == Deleted {
+					cache.Set(key+"-tombstone", item)
+				}
+			})
+
+			cache.Set("a", 1)
+			cache.Delete("a")
+
+			if val, exists := cache.Get("a-tombstone"); !exists || val != 1 {
+				t.Errorf("Callback should be able to write to the cache, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestEvictReason_String(t *testing.T) {
+	reasons := map[EvictReason]string{
+		Capacity:        "Capacity",
+		Expired:         "Expired",
+		Deleted:         "Deleted",
+		Replaced:        "Replaced",
+		Cleared:         "Cleared",
+		EvictReason(99): "Unknown",
+	}
+
+	for reason, expected := range reasons {
+		if reason.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, reason.String())
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock returns the current time. Caches use it for every expiry check,
+// so tests can substitute a fake clock and advance time deterministically.
+type Clock func() time.Time
+
+// Option configures a cache created by NewLRUCache, NewLFUCache or NewFIFOCache.
+type Option func(*options)
+
+// options holds the settings shared by all cache implementations.
+type options struct {
+	// defaultTTL is the time to live applied by Set, or 0 for no expiration
+	defaultTTL time.Duration
+	// clock is the time source for expiry checks
+	clock Clock
+	// decayInterval is the time between two frequency decays of an LFU cache, or 0 for no decay
+	decayInterval time.Duration
+	// decayFactor is the multiplier applied to the frequencies of an LFU cache on every decay
+	decayFactor float64
+}
+
+// WithDefaultTTL sets the time to live applied to items stored with Set.
+// A ttl of 0 or less disables expiration, which is the default.
+//
+// Parameters:
+//   - ttl: The time after which items stored with Set expire
+//
+// Example:
+//
+//	sessions := cache.NewLRUCache[string, *Session](1000, cache.WithDefaultTTL(30*time.Minute))
+func WithDefaultTTL(ttl time.Duration) Option {
+	return func(options *options) {
+		options.defaultTTL = ttl
+	}
+}
+
+// WithClock replaces the time source used for expiry checks (time.Now by default).
+//
+// Parameters:
+//   - clock: The function returning the current time
+//
+// Example:
+//
+//	now := time.Now()
+//	lru := cache.NewLRUCache[string, int](10, cache.WithClock(func() time.Time { return now }))
+func WithClock(clock Clock) Option {
+	return func(options *options) {
+		if clock != nil {
+			options.clock = clock
+		}
+	}
+}
+
+// WithDecay makes an LFU cache multiply the access frequency of every item by factor once per interval,
+// so items that were popular long ago age out instead of outliving recently popular ones.
+// Frequencies are rounded down and never drop below 1. Decay is applied lazily, measured with
+// the cache clock, by the next operation after an interval has passed; if several intervals
+// have passed, the factor is applied once for each.
+// An interval of 0 or less, or a factor outside (0, 1), disables decay, which is the default.
+// Caches other than LFUCache ignore this option.
+//
+// Parameters:
+//   - interval: The time between two decays
+//   - factor: The multiplier applied to frequencies, e.g. 0.5 to halve them
+//
+// Example:
+//
+//	lfu := cache.NewLFUCache[string, int](100, cache.WithDecay(time.Minute, 0.5))
+func WithDecay(interval time.Duration, factor float64) Option {
+	return func(options *options) {
+		if interval <= 0 || factor <= 0 || factor >= 1 {
+			options.decayInterval, options.decayFactor = 0, 0
+			return
+		}
+		options.decayInterval, options.decayFactor = interval, factor
+	}
+}
+
+// newOptions applies the given options on top of the defaults.
+func newOptions(opts []Option) options {
+	result := options{clock: time.Now}
+	for _, opt := range opts {
+		opt(&result)
+	}
+	return result
+}
+
+// expiry tracks expiration deadlines of cache keys.
+// Keys without a deadline never expire. It is not thread-safe; the owning cache guards it.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+type expiry[K comparable] struct {
+	options
+	// deadlines maps keys to the moment they expire
+	deadlines map[K]time.Time
+}
+
+// newExpiry creates an expiry tracker configured by opts.
+func newExpiry[K comparable](opts []Option) expiry[K] {
+	return expiry[K]{
+		options:   newOptions(opts),
+		deadlines: make(map[K]time.Time),
+	}
+}
+
+// track records the deadline of key, replacing any previous one.
+// A ttl of 0 or less means the key never expires.
+func (expiry *expiry[K]) track(key K, ttl time.Duration) {
+	if ttl <= 0 {
+		delete(expiry.deadlines, key)
+		return
+	}
+	expiry.deadlines[key] = expiry.clock().Add(ttl)
+}
+
+// expired reports whether key has a deadline that has passed.
+func (expiry *expiry[K]) expired(key K) bool {
+	deadline, exists := expiry.deadlines[key]
+	return exists && !expiry.clock().Before(deadline)
+}
+
+// deadline returns the deadline of key, or the zero time if it never expires.
+func (expiry *expiry[K]) deadline(key K) time.Time {
+	return expiry.deadlines[key]
+}
+
+// restore records a deadline exported by Snapshot; the zero time means the key never expires.
+func (expiry *expiry[K]) restore(key K, deadline time.Time) {
+	if deadline.IsZero() {
+		delete(expiry.deadlines, key)
+		return
+	}
+	expiry.deadlines[key] = deadline
+}
+
+// forget removes the deadline of key.
+func (expiry *expiry[K]) forget(key K) {
+	delete(expiry.deadlines, key)
+}
+
+// reset removes all deadlines.
+func (expiry *expiry[K]) reset() {
+	clear(expiry.deadlines)
+}
+
+// countExpired returns the number of keys whose deadline has passed.
+func (expiry *expiry[K]) countExpired() int {
+	now := expiry.clock()
+
+	count := 0
+	for _, deadline := range expiry.deadlines {
+		if !now.Before(deadline) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// collect returns the keys whose deadline has passed.
+func (expiry *expiry[K]) collect() []K {
+	now := expiry.clock()
+
+	var keys []K
+	for key, deadline := range expiry.deadlines {
+		if !now.Before(deadline) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// janitor runs a function periodically in a background goroutine until stopped.
+type janitor struct {
+	// mutex guards stop
+	mutex sync.Mutex
+	// stop is closed to terminate the running goroutine, or nil if none is running
+	stop chan struct{}
+}
+
+// start launches the goroutine calling sweep every interval, replacing a running one.
+// A non-positive interval only stops the running goroutine.
+func (janitor *janitor) start(interval time.Duration, sweep func()) {
+	janitor.mutex.Lock()
+	defer janitor.mutex.Unlock()
+
+	if janitor.stop != nil {
+		close(janitor.stop)
+		janitor.stop = nil
+	}
+
+	if interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	janitor.stop = stop
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				sweep()
+			}
+		}
+	}()
+}
+
+// halt stops the running goroutine, if any.
+func (janitor *janitor) halt() {
+	janitor.mutex.Lock()
+	defer janitor.mutex.Unlock()
+
+	if janitor.stop != nil {
+		close(janitor.stop)
+		janitor.stop = nil
+	}
+}
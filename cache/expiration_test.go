@@ -0,0 +1,268 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Expiration
+// ============================================================================
+
+// fakeClock is a manually advanced time source for deterministic expiry tests.
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(duration time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(duration)
+}
+
+// ttlCache is the subset of cache methods covered by the expiration tests.
+type ttlCache interface {
+	Cache[string, int]
+	SetWithTTL(key string, data int, ttl time.Duration)
+	GetWithExpiry(key string) (int, time.Time, bool)
+	StartJanitor(interval time.Duration)
+	StopJanitor()
+}
+
+func ttlCaches(opts ...Option) map[string]ttlCache {
+	return map[string]ttlCache{
+		"LRU":      NewLRUCache[string, int](10, opts...),
+		"LFU":      NewLFUCache[string, int](10, opts...),
+		"FIFO":     NewFIFOCache[string, int](10, opts...),
+		"SLRU":     NewSegmentedLRUCache[string, int](10, 0.8, opts...),
+		"Weighted": NewWeightedLRUCache[string, int](10, opts...),
+	}
+}
+
+func TestCache_SetWithTTL_ExpiresOnGet(t *testing.T) {
+	clock := newFakeClock()
+
+	for name, cache := range ttlCaches(WithClock(clock.Now)) {
+		t.Run(name, func(t *testing.T) {
+			cache.SetWithTTL("short", 1, time.Second)
+			cache.SetWithTTL("long", 2, time.Hour)
+			cache.Set("forever", 3)
+
+			if val, exists := cache.Get("short"); !exists || val != 1 {
+				t.Errorf("short should exist before expiry, got %d, %v", val, exists)
+			}
+
+			clock.Advance(time.Second)
+
+			if val, exists := cache.Get("short"); exists || val != 0 {
+				t.Errorf("short should be expired, got %d, %v", val, exists)
+			}
+			if val, exists := cache.Get("long"); !exists || val != 2 {
+				t.Errorf("long should not be expired, got %d, %v", val, exists)
+			}
+			if val, exists := cache.Get("forever"); !exists || val != 3 {
+				t.Errorf("forever should never expire, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestCache_WithDefaultTTL(t *testing.T) {
+	clock := newFakeClock()
+
+	for name, cache := range ttlCaches(WithClock(clock.Now), WithDefaultTTL(time.Minute)) {
+		t.Run(name, func(t *testing.T) {
+			cache.Set("default", 1)
+			cache.SetWithTTL("forever", 2, 0)
+
+			clock.Advance(30 * time.Second)
+			cache.Set("refreshed", 3)
+
+			clock.Advance(30 * time.Second)
+
+			if _, exists := cache.Get("default"); exists {
+				t.Error("default should expire after the default TTL")
+			}
+			if val, exists := cache.Get("refreshed"); !exists || val != 3 {
+				t.Errorf("refreshed should not be expired yet, got %d, %v", val, exists)
+			}
+			if val, exists := cache.Get("forever"); !exists || val != 2 {
+				t.Errorf("forever should never expire, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestCache_Set_ResetsTTL(t *testing.T) {
+	clock := newFakeClock()
+
+	for name, cache := range ttlCaches(WithClock(clock.Now)) {
+		t.Run(name, func(t *testing.T) {
+			cache.SetWithTTL("key", 1, time.Second)
+			cache.Set("key", 2) // no default TTL, so the key no longer expires
+
+			clock.Advance(time.Hour)
+
+			if val, exists := cache.Get("key"); !exists || val != 2 {
+				t.Errorf("key should not expire after being overwritten, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestCache_Expired_AllowsReinsert(t *testing.T) {
+	clock := newFakeClock()
+
+	for name, cache := range ttlCaches(WithClock(clock.Now)) {
+		t.Run(name, func(t *testing.T) {
+			cache.SetWithTTL("key", 1, time.Second)
+			clock.Advance(2 * time.Second)
+
+			if _, exists := cache.Get("key"); exists {
+				t.Fatal("key should be expired")
+			}
+
+			cache.Set("key", 2)
+			if val, exists := cache.Get("key"); !exists || val != 2 {
+				t.Errorf("key should be reinserted, got %d, %v", val, exists)
+			}
+		})
+	}
+}
+
+func TestCache_Janitor_SweepsExpired(t *testing.T) {
+	clock := newFakeClock()
+
+	lru := NewLRUCache[string, int](10, WithClock(clock.Now))
+	lfu := NewLFUCache[string, int](10, WithClock(clock.Now))
+
+	for _, cache := range []ttlCache{lru, lfu} {
+		cache.SetWithTTL("expiring", 1, time.Second)
+		cache.Set("kept", 2)
+		cache.StartJanitor(time.Millisecond)
+	}
+	defer lru.StopJanitor()
+	defer lfu.StopJanitor()
+
+	clock.Advance(time.Second)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		lru.mutex.Lock()
+		_, lruExists := lru.data["expiring"]
+		lru.mutex.Unlock()
+
+		lfu.mutex.Lock()
+		_, lfuExists := lfu.spot["expiring"]
+		lfu.mutex.Unlock()
+
+		if !lruExists && !lfuExists {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("janitor did not sweep expired items (LRU: %v, LFU: %v)", lruExists, lfuExists)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if lru.recent.Size() != 1 || len(lru.expiry.deadlines) != 0 {
+		t.Errorf("LRU should only keep the non-expiring item, size %d", lru.recent.Size())
+	}
+	if val, exists := lru.Get("kept"); !exists || val != 2 {
+		t.Errorf("LRU kept should survive the sweep, got %d, %v", val, exists)
+	}
+	if val, exists := lfu.Get("kept"); !exists || val != 2 {
+		t.Errorf("LFU kept should survive the sweep, got %d, %v", val, exists)
+	}
+}
+
+func TestCache_Janitor_StartStop(t *testing.T) {
+	cache := NewLRUCache[string, int](10)
+
+	cache.StopJanitor() // no-op when not started
+	cache.StartJanitor(time.Millisecond)
+	cache.StartJanitor(time.Millisecond) // replaces the running janitor
+	cache.StopJanitor()
+	cache.StopJanitor()
+
+	if cache.janitor.stop != nil {
+		t.Error("janitor should be stopped")
+	}
+}
+
+func TestCache_Expiry_ForgottenOnRemoval(t *testing.T) {
+	clock := newFakeClock()
+	lru := NewLRUCache[string, int](2, WithClock(clock.Now), WithDefaultTTL(time.Minute))
+
+	lru.Set("a", 1)
+	lru.Set("b", 2)
+	lru.Set("c", 3) // evicts a
+	lru.Delete("b")
+
+	if len(lru.expiry.deadlines) != 1 {
+		t.Errorf("Expected 1 tracked deadline, got %d", len(lru.expiry.deadlines))
+	}
+
+	lru.Clear()
+	if len(lru.expiry.deadlines) != 0 {
+		t.Errorf("Expected no tracked deadlines after Clear, got %d", len(lru.expiry.deadlines))
+	}
+}
+
+func TestCache_GetWithExpiry(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+
+	for name, cache := range ttlCaches(WithClock(clock.Now)) {
+		t.Run(name, func(t *testing.T) {
+			cache.SetWithTTL("expiring", 1, time.Minute)
+			cache.Set("forever", 2)
+
+			val, expiresAt, exists := cache.GetWithExpiry("expiring")
+			if !exists || val != 1 || !expiresAt.Equal(start.Add(time.Minute)) {
+				t.Errorf("GetWithExpiry(expiring) = %d, %v, %v, want 1, %v, true", val, expiresAt, exists, start.Add(time.Minute))
+			}
+
+			val, expiresAt, exists = cache.GetWithExpiry("forever")
+			if !exists || val != 2 || !expiresAt.IsZero() {
+				t.Errorf("GetWithExpiry(forever) = %d, %v, %v, want 2, zero time, true", val, expiresAt, exists)
+			}
+
+			if _, expiresAt, exists := cache.GetWithExpiry("missing"); exists || !expiresAt.IsZero() {
+				t.Errorf("GetWithExpiry(missing) = %v, %v, want zero time, false", expiresAt, exists)
+			}
+		})
+	}
+
+	clock.Advance(time.Minute)
+
+	for name, cache := range ttlCaches(WithClock(clock.Now), WithDefaultTTL(time.Second)) {
+		t.Run(name+"/DefaultTTL", func(t *testing.T) {
+			cache.Set("key", 1)
+
+			if _, expiresAt, _ := cache.GetWithExpiry("key"); !expiresAt.Equal(clock.Now().Add(time.Second)) {
+				t.Errorf("Expected the default TTL deadline, got %v", expiresAt)
+			}
+
+			clock.Advance(time.Second)
+			if _, _, exists := cache.GetWithExpiry("key"); exists {
+				t.Error("Expected the expired item to be reported as not found")
+			}
+			if cache.Len() != 0 {
+				t.Errorf("Expected the expired item to be evicted, got Len() = %d", cache.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package cache
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// ============================================================================
+// COMPREHENSIVE TEST SUITE FOR FIFO CACHE
+// ============================================================================
+
+// ----------------------------------------------------------------------------
+// Edge Cases: Basic Operations
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_NewCache(t *testing.T) {
+	cache := NewFIFOCache[string, int](5)
+	if cache == nil {
+		t.Fatal("NewFIFOCache returned nil")
+	}
+	if cache.capacity != 5 {
+		t.Errorf("Expected capacity 5, got %d", cache.capacity)
+	}
+}
+
+func TestFIFOCache_NewCache_ZeroCapacity(t *testing.T) {
+	cache := NewFIFOCache[string, int](0)
+
+	// Should allow unlimited items with zero capacity
+	for i := 0; i < 100; i++ {
+		cache.Set(string(rune('a'+i%26))+string(rune('0'+i/26)), i)
+	}
+
+	if cache.Len() != 100 {
+		t.Errorf("Expected 100 items with zero capacity, got %d", cache.Len())
+	}
+	if val, exists := cache.Get("a0"); !exists || val != 0 {
+		t.Error("Should be able to store items with zero capacity")
+	}
+}
+
+func TestFIFOCache_SetAndGet_SingleItem(t *testing.T) {
+	cache := NewFIFOCache[string, int](5)
+
+	cache.Set("key1", 100)
+
+	val, exists := cache.Get("key1")
+	if !exists {
+		t.Error("Expected key1 to exist")
+	}
+	if val != 100 {
+		t.Errorf("Expected value 100, got %d", val)
+	}
+}
+
+func TestFIFOCache_Get_NonExistentKey(t *testing.T) {
+	cache := NewFIFOCache[string, int](5)
+
+	val, exists := cache.Get("nonexistent")
+	if exists {
+		t.Error("Expected nonexistent key to not exist")
+	}
+	if val != 0 {
+		t.Errorf("Expected zero value, got %d", val)
+	}
+}
+
+func TestFIFOCache_Set_DuplicateKey(t *testing.T) {
+	cache := NewFIFOCache[string, int](5)
+
+	cache.Set("key1", 100)
+	cache.Set("key1", 200) // Should update
+
+	val, exists := cache.Get("key1")
+	if !exists {
+		t.Error("Expected key1 to exist")
+	}
+	if val != 200 {
+		t.Errorf("Expected updated value 200, got %d", val)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Expected 1 item, got %d", cache.Len())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Edge Cases: Capacity and Eviction Order
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_EvictOldestInserted(t *testing.T) {
+	cache := NewFIFOCache[string, int](3)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	cache.Set("key3", 3)
+	cache.Set("key4", 4) // Should evict key1
+
+	if _, exists := cache.Get("key1"); exists {
+		t.Error("key1 should have been evicted")
+	}
+	for key, expected := range map[string]int{"key2": 2, "key3": 3, "key4": 4} {
+		if val, exists := cache.Get(key); !exists || val != expected {
+			t.Errorf("Expected %s=%d, got %d, %v", key, expected, val, exists)
+		}
+	}
+}
+
+func TestFIFOCache_GetDoesNotRefresh(t *testing.T) {
+	cache := NewFIFOCache[string, int](3)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	cache.Set("key3", 3)
+
+	// Accessing key1 doesn't protect it from eviction
+	for i := 0; i < 5; i++ {
+		cache.Get("key1")
+	}
+
+	cache.Set("key4", 4)
+
+	if _, exists := cache.Get("key1"); exists {
+		t.Error("key1 should have been evicted despite being accessed")
+	}
+}
+
+func TestFIFOCache_OverwriteKeepsPosition(t *testing.T) {
+	cache := NewFIFOCache[string, int](2)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	cache.Set("key1", 10) // Still the oldest insertion
+	cache.Set("key3", 3)  // Should evict key1
+
+	if _, exists := cache.Get("key1"); exists {
+		t.Error("key1 should have been evicted as the oldest insertion")
+	}
+	if val, exists := cache.Get("key2"); !exists || val != 2 {
+		t.Errorf("Expected key2=2, got %d, %v", val, exists)
+	}
+}
+
+func TestFIFOCache_SequentialEviction(t *testing.T) {
+	cache := NewFIFOCache[int, int](3)
+
+	for i := 0; i < 10; i++ {
+		cache.Set(i, i*10)
+	}
+
+	if keys := cache.Keys(); !slices.Equal(keys, []int{9, 8, 7}) {
+		t.Errorf("Expected keys [9 8 7] from newest to oldest, got %v", keys)
+	}
+	if values := cache.Values(); !slices.Equal(values, []int{90, 80, 70}) {
+		t.Errorf("Expected values [90 80 70], got %v", values)
+	}
+}
+
+func TestFIFOCache_CapacityOne(t *testing.T) {
+	cache := NewFIFOCache[string, int](1)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+
+	if _, exists := cache.Get("key1"); exists {
+		t.Error("key1 should have been evicted")
+	}
+	if val, exists := cache.Get("key2"); !exists || val != 2 {
+		t.Errorf("Expected key2=2, got %d, %v", val, exists)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Edge Cases: Delete Operations
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_Delete(t *testing.T) {
+	cache := NewFIFOCache[string, int](3)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+
+	if !cache.Delete("key1") {
+		t.Error("Delete should return true for existing key")
+	}
+	if cache.Delete("key1") {
+		t.Error("Delete should return false for missing key")
+	}
+	if _, exists := cache.Get("key1"); exists {
+		t.Error("key1 should not exist after delete")
+	}
+
+	// Deleting frees a slot, so no eviction happens here
+	cache.Set("key3", 3)
+	cache.Set("key4", 4)
+	if val, exists := cache.Get("key2"); !exists || val != 2 {
+		t.Errorf("Expected key2=2, got %d, %v", val, exists)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Edge Cases: Flush and Clear Operations
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_Flush_OverCapacity(t *testing.T) {
+	cache := NewFIFOCache[string, int](5)
+
+	for i := 0; i < 5; i++ {
+		cache.Set(string(rune('a'+i)), i)
+	}
+
+	cache.capacity = 2
+	cache.Flush()
+
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"e", "d"}) {
+		t.Errorf("Flush should keep the newest items [e d], got %v", keys)
+	}
+}
+
+func TestFIFOCache_Flush_ZeroCapacity(t *testing.T) {
+	cache := NewFIFOCache[string, int](0)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+
+	// Flush with zero capacity removes all items, like LRUCache
+	cache.Flush()
+
+	if cache.Len() != 0 {
+		t.Errorf("Expected empty cache after flush, got %d items", cache.Len())
+	}
+
+	cache.Set("key3", 3)
+	if val, exists := cache.Get("key3"); !exists || val != 3 {
+		t.Error("Cache should be functional after Flush")
+	}
+}
+
+func TestFIFOCache_Clear(t *testing.T) {
+	cache := NewFIFOCache[string, int](3)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	cache.Clear()
+
+	if cache.Len() != 0 {
+		t.Errorf("Expected empty cache after clear, got %d items", cache.Len())
+	}
+
+	cache.Set("key3", 3)
+	if val, exists := cache.Get("key3"); !exists || val != 3 {
+		t.Error("Cache should be functional after Clear")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Concurrency
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_ConcurrentAccess(t *testing.T) {
+	cache := NewFIFOCache[int, int](64)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 16; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				key := (worker*31 + i) % 128
+				switch i % 10 {
+				case 0:
+					cache.Delete(key)
+				case 1:
+					cache.Flush()
+				case 2, 3, 4:
+					cache.Set(key, i)
+				default:
+					cache.Get(key)
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	if cache.Len() > 64 {
+		t.Errorf("Cache should not exceed capacity, got %d items", cache.Len())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Resize
+// ----------------------------------------------------------------------------
+
+func TestFIFOCache_Resize_Shrink(t *testing.T) {
+	cache := NewFIFOCache[int, int](5)
+	var evicted []int
+	cache.OnEvict(func(key int, _ int, reason EvictReason) {
+		if reason == Capacity {
+			evicted = append(evicted, key)
+		}
+	})
+
+	for i := 0; i < 5; i++ {
+		cache.Set(i, i)
+	}
+	// Accessing doesn't matter, the oldest insertions are evicted
+	cache.Get(0)
+	cache.Get(1)
+
+	cache.Resize(3)
+
+	if keys := cache.Keys(); !slices.Equal(keys, []int{4, 3, 2}) {
+		t.Errorf("Expected survivors %v, got %v", []int{4, 3, 2}, keys)
+	}
+	if len(evicted) != 2 {
+		t.Errorf("Expected 2 Capacity evictions, got %v", evicted)
+	}
+
+	// The new capacity is enforced on later inserts
+	cache.Set(10, 10)
+	if cache.Len() != 3 {
+		t.Errorf("Expected 3 items after insert, got %d", cache.Len())
+	}
+}
+
+func TestFIFOCache_Resize_Grow(t *testing.T) {
+	cache := NewFIFOCache[int, int](2)
+
+	cache.Set(1, 1)
+	cache.Set(2, 2)
+	cache.Resize(4)
+	cache.Set(3, 3)
+	cache.Set(4, 4)
+
+	if cache.Len() != 4 {
+		t.Errorf("Expected 4 items after growing, got %d", cache.Len())
+	}
+
+	cache.Resize(0)
+	for i := 5; i < 20; i++ {
+		cache.Set(i, i)
+	}
+	if cache.Len() != 19 {
+		t.Errorf("Expected unlimited capacity after Resize(0), got %d items", cache.Len())
+	}
+}
+
+func TestFIFOCache_Resize_Negative(t *testing.T) {
+	cache := NewFIFOCache[int, int](2)
+
+	cache.Set(1, 1)
+	cache.Set(2, 2)
+	cache.Resize(-1)
+	cache.Set(3, 3)
+
+	if cache.Len() != 2 || cache.Contains(1) {
+		t.Errorf("Expected a negative capacity to be ignored, got %v", cache.Keys())
+	}
+}
+
+func TestFIFOCache_Contains(t *testing.T) {
+	cache := NewFIFOCache[string, int](2)
+
+	if cache.Contains("missing") {
+		t.Error("Contains should not find a missing key")
+	}
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	if !cache.Contains("a") || !cache.Contains("b") {
+		t.Errorf("Expected a and b to be cached, got %v", cache.Keys())
+	}
+
+	// a is still the oldest item, so it is evicted next
+	cache.Set("c", 3)
+
+	if cache.Contains("a") {
+		t.Error("a should have been evicted first")
+	}
+	if stats := cache.Stats(); stats.Hits != 0 || stats.Misses != 0 {
+		t.Errorf("Contains should not be counted, got %+v", stats)
+	}
+}
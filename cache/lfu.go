@@ -1,6 +1,11 @@
 package cache
 
 import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/0x626f/go-kit/abstract"
 	"github.com/0x626f/go-kit/linkedlist"
 	"github.com/0x626f/go-kit/types"
 	"github.com/0x626f/go-kit/utils"
@@ -14,17 +19,29 @@ import (
 // its frequency counter is incremented. Items with lower frequency counts are
 // evicted first when the cache reaches capacity.
 //
+// Items may expire after a time to live, set per item with SetWithTTL or for all items
+// with the WithDefaultTTL option. Expired items are treated as absent and evicted lazily
+// on access, or proactively by the janitor started with StartJanitor.
+//
+// Frequencies may decay over time with the WithDecay option, so items that were popular
+// long ago don't outlive recently popular ones.
+//
+// LFUCache is safe for concurrent use by multiple goroutines.
+//
 // Type parameters:
 //   - K: The type of keys (must be comparable)
 //   - D: The type of data stored
 //
 // Time complexity:
-//   - Set: O(1)
+//   - Set: O(1), or O(m) when an item is evicted, where m is the number of frequency buckets
 //   - Get: O(1)
 //   - Delete: O(1)
-//   - Flush: O(n log n) due to sorting
+//   - Flush: O(k + b·m), see Flush
 type LFUCache[K comparable, D any] struct {
-	// capacity is the maximum number of unique frequency buckets
+	// mutex guards all cache state; Get moves items between buckets, so reads lock exclusively too
+	mutex sync.Mutex
+
+	// capacity is the maximum number of items
 	capacity int
 
 	// frequencies is a linked list of frequency buckets
@@ -36,6 +53,24 @@ type LFUCache[K comparable, D any] struct {
 
 	// spot maps keys to their frequency bucket nodes for O(1) lookup
 	spot PrimaryCache[K, *linkedlist.LinkedNode[*types.Pair[uint, PrimaryCache[K, D]]]]
+
+	// expiry tracks the expiration deadlines of items
+	expiry expiry[K]
+
+	// janitor periodically evicts expired items once started
+	janitor janitor
+
+	// evictions queues removed items for the eviction callback
+	evictions evictions[K, D]
+
+	// statistics counts hits, misses, inserts, replaces and evictions
+	statistics statistics
+
+	// flights deduplicates concurrent GetOrCompute computations per key
+	flights flightGroup[K, D]
+
+	// decayed is the last time the frequencies were decayed (see WithDecay)
+	decayed time.Time
 }
 
 // NewLFUCache creates and initializes a new LFU cache with the specified capacity.
@@ -45,7 +80,8 @@ type LFUCache[K comparable, D any] struct {
 //   - D: The type of data stored
 //
 // Parameters:
-//   - capacity: Maximum number of frequency buckets the cache can maintain
+//   - capacity: Maximum number of items. Set doesn't limit a cache of capacity 0, while Flush empties it.
+//   - opts: Optional settings such as WithDefaultTTL, WithClock and WithDecay
 //
 // Returns:
 //   - A pointer to the newly created LFUCache
@@ -56,12 +92,16 @@ type LFUCache[K comparable, D any] struct {
 //	cache.Set("counter", 1)
 //	cache.Get("counter") // Increases frequency
 //	cache.Get("counter") // Increases frequency again
-func NewLFUCache[K comparable, D any](capacity int) *LFUCache[K, D] {
+func NewLFUCache[K comparable, D any](capacity int, opts ...Option) *LFUCache[K, D] {
+	expiry := newExpiry[K](opts)
+
 	return &LFUCache[K, D]{
 		capacity:    capacity,
 		frequencies: linkedlist.NewLinkedList[*types.Pair[uint, PrimaryCache[K, D]]](),
 		data:        make(PrimaryCache[uint, *linkedlist.LinkedNode[*types.Pair[uint, PrimaryCache[K, D]]]]),
 		spot:        make(PrimaryCache[K, *linkedlist.LinkedNode[*types.Pair[uint, PrimaryCache[K, D]]]]),
+		expiry:      expiry,
+		decayed:     expiry.clock(),
 	}
 }
 
@@ -82,27 +122,93 @@ func (cache *LFUCache[K, D]) record(freq uint) *linkedlist.LinkedNode[*types.Pai
 	return cache.data[freq]
 }
 
-// Set adds a new item to the cache with an initial frequency of 1.
-// If the key already exists, this method does nothing (existing value is preserved).
+// promote is an internal method that moves a key to the next frequency bucket,
+// storing item as its value.
+//
+// Parameters:
+//   - key: The key to promote
+//   - node: The frequency bucket node currently holding the key
+//   - item: The value to store in the next bucket
+func (cache *LFUCache[K, D]) promote(key K, node *linkedlist.LinkedNode[*types.Pair[uint, PrimaryCache[K, D]]], item D) {
+	nextNode := cache.record(node.Data.First + 1)
+	nextNode.Data.Second[key] = item
+
+	delete(node.Data.Second, key)
+	cache.spot[key] = nextNode
+}
+
+// Set adds an item to the cache or updates the value of an existing key.
 //
-// New items are added to the frequency bucket for count 1.
+// New items are added to the frequency bucket for count 1. If the cache is at capacity,
+// a least frequently used item is evicted first to make room, with reason Capacity.
+// Updating an existing key counts as an access and increments its frequency.
+// The item expires after the default TTL, if one was configured with WithDefaultTTL.
 //
 // Parameters:
 //   - key: The key to associate with the data
 //   - item: The data to cache
 //
-// Time complexity: O(1)
+// Time complexity: O(1), or O(m) when an item is evicted, where m is the number of frequency buckets
 func (cache *LFUCache[K, D]) Set(key K, item D) {
-	if _, exists := cache.spot[key]; !exists {
-		node := cache.record(1)
-		node.Data.Second[key] = item
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, cache.expiry.defaultTTL)
+}
+
+// SetWithTTL adds or updates an item in the cache like Set, with its own time to live.
+// A ttl of 0 or less means the item never expires.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - ttl: The time after which the item expires
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	cache := cache.NewLFUCache[string, string](100)
+//	cache.SetWithTTL("token", "abc", time.Minute)
+func (cache *LFUCache[K, D]) SetWithTTL(key K, item D, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, ttl)
+}
+
+// set is an internal method that stores an item and its deadline.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) set(key K, item D, ttl time.Duration) {
+	cache.decay()
+	cache.expiry.track(key, ttl)
 
-		cache.spot[key] = node
+	if node, exists := cache.spot[key]; exists {
+		cache.evict(key, node.Data.Second[key], Replaced)
+		cache.promote(key, node, item)
+		return
 	}
+
+	// evict before inserting, so the new item doesn't replace itself as the least frequently used one
+	if cache.capacity > 0 && len(cache.spot) >= cache.capacity {
+		if bucket := cache.leastFrequent(); bucket != nil {
+			for retired := range bucket.Second {
+				cache.remove(retired, cache.spot[retired], Capacity)
+				break
+			}
+		}
+	}
+
+	node := cache.record(1)
+	node.Data.Second[key] = item
+
+	cache.spot[key] = node
+	cache.statistics.inserts.Add(1)
 }
 
 // Get retrieves an item from the cache and increments its access frequency.
 // The item is moved to the next frequency bucket (frequency + 1).
+// An expired item is evicted and reported as not found.
 //
 // Parameters:
 //   - key: The key of the item to retrieve
@@ -113,20 +219,151 @@ func (cache *LFUCache[K, D]) Set(key K, item D) {
 //
 // Time complexity: O(1)
 func (cache *LFUCache[K, D]) Get(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.get(key)
+}
+
+// get is an internal method implementing Get. The caller must hold the mutex.
+func (cache *LFUCache[K, D]) get(key K) (D, bool) {
+	cache.decay()
+
 	node, exists := cache.spot[key]
 
 	if !exists {
+		cache.statistics.misses.Add(1)
 		return utils.Zero[D](), false
 	}
 
-	nextNode := cache.record(node.Data.First + 1)
+	if cache.expiry.expired(key) {
+		cache.remove(key, node, Expired)
+		cache.statistics.misses.Add(1)
+		return utils.Zero[D](), false
+	}
 
-	nextNode.Data.Second[key] = node.Data.Second[key]
+	item := node.Data.Second[key]
+	cache.promote(key, node, item)
 
-	delete(node.Data.Second, key)
-	cache.spot[key] = nextNode
+	cache.statistics.hits.Add(1)
+	return item, true
+}
+
+// GetWithExpiry retrieves an item from the cache like Get, along with the moment it expires,
+// e.g. to compute the max-age of a cached HTTP response. Like Get, it increments the access frequency of the item.
+// Items stored without a time to live never expire and are returned with the zero time.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data, its expiration time (zero if it never expires) and true if found
+//   - A zero value, the zero time and false if the key is not in the cache
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	if page, expiresAt, ok := pages.GetWithExpiry(url); ok && !expiresAt.IsZero() {
+//	    writer.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(time.Until(expiresAt).Seconds())))
+//	}
+func (cache *LFUCache[K, D]) GetWithExpiry(key K) (D, time.Time, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	item, exists := cache.get(key)
+	if !exists {
+		return item, time.Time{}, false
+	}
+
+	return item, cache.expiry.deadline(key), true
+}
+
+// GetOrCompute returns the cached value for key or, on a miss, calls compute and stores its result.
+// Concurrent callers missing the same key share a single compute call: one caller runs it
+// and the others wait for its result. Errors are returned to all waiting callers and not cached.
+//
+// The compute function runs without holding the cache lock, so it may use the cache.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//   - compute: The function producing the value on a miss
+//
+// Returns:
+//   - The cached or computed value and nil on success
+//   - A zero value and the error returned by compute, or ErrComputePanicked to waiters if it panicked
+//
+// Example:
+//
+//	user, err := users.GetOrCompute(id, func() (*User, error) {
+//	    return db.LoadUser(id)
+//	})
+func (cache *LFUCache[K, D]) GetOrCompute(key K, compute func() (D, error)) (D, error) {
+	if item, exists := cache.Get(key); exists {
+		return item, nil
+	}
+
+	return cache.flights.do(key, func() (D, error) {
+		// A computation that finished just before this one started may have stored the value
+		if item, exists := cache.Peek(key); exists {
+			return item, nil
+		}
+
+		item, err := compute()
+		if err != nil {
+			return utils.Zero[D](), err
+		}
+
+		cache.Set(key, item)
+		return item, nil
+	})
+}
+
+// Peek retrieves an item from the cache without affecting its frequency.
+// Unlike Get, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found and not expired
+//   - A zero value and false otherwise
+//
+// Time complexity: O(1)
+func (cache *LFUCache[K, D]) Peek(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 
-	return nextNode.Data.Second[key], true
+	if node, exists := cache.spot[key]; exists && !cache.expiry.expired(key) {
+		return node.Data.Second[key], true
+	}
+
+	return utils.Zero[D](), false
+}
+
+// Contains reports whether the cache holds an item for key without affecting its frequency.
+// Unlike Peek, it doesn't return the value; like Peek, it doesn't evict expired items
+// and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key to look up
+//
+// Returns:
+//   - true if the item is cached and not expired, false otherwise
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	if !sessions.Contains(id) {
+//	    sessions.Set(id, newSession())
+//	}
+func (cache *LFUCache[K, D]) Contains(key K) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	_, exists := cache.spot[key]
+	return exists && !cache.expiry.expired(key)
 }
 
 // Delete removes an item from the cache by its key.
@@ -135,52 +372,271 @@ func (cache *LFUCache[K, D]) Get(key K) (D, bool) {
 //   - key: The key of the item to remove
 //
 // Returns:
-//   - true (always returns true, even if key was not found)
+//   - true if the item was found and deleted
+//   - false if the key was not in the cache
 //
 // Time complexity: O(1)
 func (cache *LFUCache[K, D]) Delete(key K) bool {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.delete(key)
+}
+
+// delete is an internal method removing key and reporting whether it was present.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) delete(key K) bool {
 	node, exists := cache.spot[key]
 
 	if !exists {
-		return true
+		return false
 	}
 
-	delete(node.Data.Second, key)
-	delete(cache.spot, key)
+	cache.remove(key, node, Deleted)
 
 	return true
 }
 
-// Flush removes frequency buckets when the cache exceeds its capacity.
-// It sorts frequency buckets by frequency count (highest first) and keeps
-// only the top 'capacity' buckets, removing items in lower frequency buckets.
+// SetMany adds or updates all items of the map like Set, holding the lock once for the whole batch,
+// so other goroutines observe either none or all of the items.
+// Items are stored in the unspecified iteration order of the map.
+//
+// Parameters:
+//   - items: The key-value pairs to cache
+//
+// Time complexity: O(n) where n is the number of items
+//
+// Example:
+//
+//	cache := cache.NewLFUCache[string, int](100)
+//	cache.SetMany(map[string]int{"a": 1, "b": 2})
+func (cache *LFUCache[K, D]) SetMany(items map[K]D) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for key, item := range items {
+		cache.set(key, item, cache.expiry.defaultTTL)
+	}
+}
+
+// GetMany retrieves several items like Get, holding the lock once for the whole batch.
+// The access frequency of every found item is incremented.
+//
+// Parameters:
+//   - keys: The keys of the items to retrieve
+//
+// Returns:
+//   - A map holding only the keys found in the cache and their values
+//
+// Time complexity: O(n) where n is the number of keys
+//
+// Example:
+//
+//	found := cache.GetMany([]string{"a", "b", "missing"})
+//	// found == map[string]int{"a": 1, "b": 2}
+func (cache *LFUCache[K, D]) GetMany(keys []K) map[K]D {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	found := make(map[K]D, len(keys))
+	for _, key := range keys {
+		if item, exists := cache.get(key); exists {
+			found[key] = item
+		}
+	}
+
+	return found
+}
+
+// DeleteMany removes several items like Delete, holding the lock once for the whole batch.
+//
+// Parameters:
+//   - keys: The keys of the items to remove
+//
+// Returns:
+//   - The number of items that were found and deleted
+//
+// Time complexity: O(n) where n is the number of keys
+func (cache *LFUCache[K, D]) DeleteMany(keys []K) int {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	deleted := 0
+	for _, key := range keys {
+		if cache.delete(key) {
+			deleted++
+		}
+	}
+
+	return deleted
+}
+
+// remove is an internal method that evicts key from its frequency bucket node for the given reason.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) remove(key K, node *linkedlist.LinkedNode[*types.Pair[uint, PrimaryCache[K, D]]], reason EvictReason) {
+	cache.evict(key, node.Data.Second[key], reason)
+	delete(node.Data.Second, key)
+	delete(cache.spot, key)
+	cache.expiry.forget(key)
+}
+
+// OnEvict registers callback to be called whenever an item leaves the cache or has its value replaced,
+// replacing any previously registered callback. Pass nil to unregister.
 //
-// The flush operation performs the following steps:
-//  1. Checks if the number of frequency buckets exceeds capacity
-//  2. Sorts frequency buckets by frequency (descending - highest first)
-//  3. Keeps only the first 'capacity' buckets
-//  4. Removes all items from buckets beyond capacity
+// The callback is invoked synchronously by the operation that removed the item, after the cache
+// lock has been released, so it may safely call methods of the same cache.
 //
-// This method is useful for periodic cleanup when the number of frequency
-// buckets has grown beyond the desired capacity limit.
+// Parameters:
+//   - callback: The function receiving the key, the removed value and the reason
+//
+// Example:
+//
+//	cache := cache.NewLFUCache[string, *Conn](100)
+//	cache.OnEvict(func(key string, conn *Conn, reason cache.EvictReason) {
+//	    conn.Close()
+//	})
+func (cache *LFUCache[K, D]) OnEvict(callback EvictCallback[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.evictions.callback = callback
+}
+
+// unlock is an internal method that releases the mutex and then reports
+// the evictions recorded while it was held.
+func (cache *LFUCache[K, D]) unlock() {
+	callback, pending := cache.evictions.take()
+	cache.mutex.Unlock()
+
+	notify(callback, pending)
+}
+
+// evict is an internal method that counts an item removed for the given reason
+// and queues it for the eviction callback. The caller must hold the mutex.
+func (cache *LFUCache[K, D]) evict(key K, item D, reason EvictReason) {
+	cache.statistics.count(reason)
+	cache.evictions.record(key, item, reason)
+}
+
+// Flush evicts the least frequently used items while the cache holds more items than its capacity,
+// reporting them to the eviction callback with reason Capacity.
+// Items of the same frequency are evicted in no particular order.
+// A cache of capacity 0 keeps no items.
 //
-// Time complexity: O(n log n) where n is the number of frequency buckets
+// Time complexity: O(k + b·m) where k is the number of evicted items, b the number of emptied
+// frequency buckets and m the number of frequency buckets
 func (cache *LFUCache[K, D]) Flush() {
-	if cache.frequencies.Size() > cache.capacity {
-		cache.frequencies.Sort(func(arg0, arg1 *types.Pair[uint, PrimaryCache[K, D]]) int {
-			return int(arg1.First) - int(arg0.First)
-		})
-		cache.frequencies.ForEach(func(index int, data *types.Pair[uint, PrimaryCache[K, D]]) bool {
-			if (index + 1) > cache.capacity {
-				for key := range data.Second {
-					delete(cache.spot, key)
-				}
-				delete(cache.data, data.First)
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.flush()
+}
+
+// flush is an internal method that removes the items beyond capacity.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) flush() {
+	cache.decay()
+
+	for len(cache.spot) > cache.capacity {
+		bucket := cache.leastFrequent()
+		if bucket == nil {
+			return
+		}
+
+		for key := range bucket.Second {
+			if len(cache.spot) <= cache.capacity {
+				break
 			}
-			return true
-		})
-		cache.frequencies.Shrink(cache.capacity)
+			cache.remove(key, cache.spot[key], Capacity)
+		}
+	}
+}
+
+// leastFrequent is an internal method that returns the non-empty frequency bucket with the lowest frequency,
+// dropping the empty buckets it finds on the way. The caller must hold the mutex.
+//
+// Returns:
+//   - The bucket holding the least frequently used items, or nil if the cache is empty
+//
+// Time complexity: O(m) where m is the number of frequency buckets
+func (cache *LFUCache[K, D]) leastFrequent() *types.Pair[uint, PrimaryCache[K, D]] {
+	var least *types.Pair[uint, PrimaryCache[K, D]]
+
+	for frequency, node := range cache.data {
+		if len(node.Data.Second) == 0 {
+			cache.frequencies.Remove(node)
+			delete(cache.data, frequency)
+			continue
+		}
+
+		if least == nil || node.Data.First < least.First {
+			least = node.Data
+		}
+	}
+
+	return least
+}
+
+// decay is an internal method that multiplies all frequencies by the decay factor once for every
+// decay interval passed since the last decay, merging the buckets that end up with the same frequency.
+// It does nothing unless decay was enabled with WithDecay. The caller must hold the mutex.
+//
+// Time complexity: O(n) when a decay is due, O(1) otherwise
+func (cache *LFUCache[K, D]) decay() {
+	interval := cache.expiry.decayInterval
+	if interval <= 0 {
+		return
+	}
+
+	elapsed := cache.expiry.clock().Sub(cache.decayed)
+	if elapsed < interval {
+		return
 	}
+
+	periods := elapsed / interval
+	cache.decayed = cache.decayed.Add(periods * interval)
+	factor := math.Pow(cache.expiry.decayFactor, float64(periods))
+
+	buckets := cache.frequencies.ToSlice()
+	cache.frequencies.DeleteAll()
+	clear(cache.data)
+
+	for _, bucket := range buckets {
+		frequency := max(uint(float64(bucket.First)*factor), 1)
+
+		node := cache.record(frequency)
+		for key, item := range bucket.Second {
+			node.Data.Second[key] = item
+			cache.spot[key] = node
+		}
+	}
+}
+
+// Resize changes the capacity of the cache, which is the maximum number of items.
+// A negative capacity is ignored.
+// When shrinking, the least frequently used items are evicted immediately down to the new capacity,
+// reporting them to the eviction callback with reason Capacity.
+// When growing, more items are simply allowed.
+//
+// Parameters:
+//   - capacity: The new maximum number of items
+//
+// Time complexity: O(k + b·m), like Flush
+//
+// Example:
+//
+//	cache := cache.NewLFUCache[string, int](100)
+//	cache.Resize(10)
+func (cache *LFUCache[K, D]) Resize(capacity int) {
+	if capacity < 0 {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.capacity = capacity
+	cache.flush()
 }
 
 // Clear removes all items from the cache, resetting it to an empty state.
@@ -200,7 +656,250 @@ func (cache *LFUCache[K, D]) Flush() {
 //
 // Time complexity: O(n + m) where n is the number of items and m is the number of frequency buckets
 func (cache *LFUCache[K, D]) Clear() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.clear()
+}
+
+// clear is an internal method that removes all items, reporting them with reason Cleared.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) clear() {
+	for bucket := range cache.frequencies.Values() {
+		for key, item := range bucket.Second {
+			cache.evict(key, item, Cleared)
+		}
+	}
+
 	cache.frequencies.DeleteAll()
 	clear(cache.data)
 	clear(cache.spot)
+	cache.expiry.reset()
+}
+
+// Len returns the number of items in the cache, not counting expired items.
+//
+// Time complexity: O(e) where e is the number of items with a TTL
+func (cache *LFUCache[K, D]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return len(cache.spot) - cache.expiry.countExpired()
+}
+
+// Keys returns the keys of the items in the cache, in ForEach order.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// Time complexity: O(n)
+func (cache *LFUCache[K, D]) Keys() []K {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	keys := make([]K, 0, len(cache.spot))
+	cache.forEach(func(key K, _ D) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// Values returns the values of the items in the cache, in ForEach order.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// Time complexity: O(n)
+func (cache *LFUCache[K, D]) Values() []D {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	values := make([]D, 0, len(cache.spot))
+	cache.forEach(func(_ K, item D) bool {
+		values = append(values, item)
+		return true
+	})
+
+	return values
+}
+
+// Snapshot returns the live items of the cache with their access frequencies, from the most
+// to the least frequently used, which is the reverse of the eviction order, so that Restore
+// can rebuild the cache in another process.
+// Expired items are skipped, and frequency is not affected.
+//
+// Returns:
+//   - The entries with their frequencies and expiration deadlines
+//
+// Time complexity: O(n log n)
+//
+// Example:
+//
+//	data, err := cache.MarshalEntries(lfu.Snapshot())
+func (cache *LFUCache[K, D]) Snapshot() []Entry[K, D] {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.decay()
+
+	entries := make([]Entry[K, D], 0, len(cache.spot))
+	for bucket := range cache.frequencies.Values() {
+		for key, item := range bucket.Second {
+			if cache.expiry.expired(key) {
+				continue
+			}
+			entries = append(entries, Entry[K, D]{
+				Key:       key,
+				Value:     item,
+				Frequency: bucket.First,
+				ExpiresAt: cache.expiry.deadline(key),
+			})
+		}
+	}
+
+	sortByFrequency(entries)
+
+	return entries
+}
+
+// Restore replaces the contents of the cache with entries, placing every item in the bucket
+// of its recorded frequency (a frequency of 0 counts as 1).
+// Current items are removed and reported to the eviction callback with reason Cleared.
+// When there are more entries than the capacity, only the most frequently used ones are restored,
+// as Flush would keep them. Expired entries and repeated keys are skipped too.
+// Restored items aren't counted as inserts in the statistics.
+//
+// Parameters:
+//   - entries: The entries to load, usually returned by Snapshot in a previous process
+//
+// Time complexity: O(n + m log m) where n is the number of items and m is the number of entries
+//
+// Example:
+//
+//	entries, err := cache.UnmarshalEntries[string, int](data)
+//	if err == nil {
+//	    lfu := cache.NewLFUCache[string, int](100)
+//	    lfu.Restore(entries)
+//	}
+func (cache *LFUCache[K, D]) Restore(entries []Entry[K, D]) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.clear()
+
+	now := cache.expiry.clock()
+	restored := make([]Entry[K, D], 0, len(entries))
+	seen := make(map[K]struct{}, len(entries))
+	for _, entry := range entries {
+		if _, exists := seen[entry.Key]; exists || entry.expired(now) {
+			continue
+		}
+		seen[entry.Key] = struct{}{}
+
+		entry.Frequency = max(entry.Frequency, 1)
+		restored = append(restored, entry)
+	}
+
+	sortByFrequency(restored)
+
+	for _, entry := range restored {
+		if len(cache.spot) >= cache.capacity {
+			break
+		}
+
+		node := cache.record(entry.Frequency)
+		node.Data.Second[entry.Key] = entry.Value
+		cache.spot[entry.Key] = node
+		cache.expiry.restore(entry.Key, entry.ExpiresAt)
+	}
+}
+
+// ForEach calls receiver for every item in the cache until it returns false.
+// Items are visited in no particular order.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// The receiver is invoked while the cache is locked and must not call methods of the same cache,
+// otherwise it deadlocks.
+//
+// Parameters:
+//   - receiver: A function called with each key and value; return false to stop
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	cache.ForEach(func(key string, value int) bool {
+//	    fmt.Println(key, value)
+//	    return true
+//	})
+func (cache *LFUCache[K, D]) ForEach(receiver abstract.IndexedReceiver[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.forEach(receiver)
+}
+
+// forEach is an internal method that iterates live items bucket by bucket.
+// The caller must hold the mutex.
+func (cache *LFUCache[K, D]) forEach(receiver abstract.IndexedReceiver[K, D]) {
+	for bucket := range cache.frequencies.Values() {
+		for key, item := range bucket.Second {
+			if cache.expiry.expired(key) {
+				continue
+			}
+			if !receiver(key, item) {
+				return
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the usage counters of the cache.
+//
+// Returns:
+//   - The counters and the current number of live items
+//
+// Example:
+//
+//	stats := cache.Stats()
+//	fmt.Printf("hit ratio %.2f over %d items\n", stats.HitRatio(), stats.Len)
+func (cache *LFUCache[K, D]) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.statistics.snapshot(len(cache.spot) - cache.expiry.countExpired())
+}
+
+// ResetStats sets all usage counters of the cache to zero.
+func (cache *LFUCache[K, D]) ResetStats() {
+	cache.statistics.reset()
+}
+
+// StartJanitor starts a background goroutine that evicts expired items every interval.
+// Calling it again replaces the running janitor; a non-positive interval just stops it.
+// Call StopJanitor when the cache is no longer used, otherwise the goroutine keeps it alive.
+//
+// Parameters:
+//   - interval: The time between two sweeps
+//
+// Example:
+//
+//	cache := cache.NewLFUCache[string, int](100, cache.WithDefaultTTL(time.Minute))
+//	cache.StartJanitor(10 * time.Second)
+//	defer cache.StopJanitor()
+func (cache *LFUCache[K, D]) StartJanitor(interval time.Duration) {
+	cache.janitor.start(interval, cache.deleteExpired)
+}
+
+// StopJanitor stops the background goroutine started by StartJanitor, if any.
+func (cache *LFUCache[K, D]) StopJanitor() {
+	cache.janitor.halt()
+}
+
+// deleteExpired is an internal method that evicts all expired items.
+func (cache *LFUCache[K, D]) deleteExpired() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for _, key := range cache.expiry.collect() {
+		cache.remove(key, cache.spot[key], Expired)
+	}
 }
@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"slices"
+	"sync"
 	"testing"
+	"time"
 )
 
 // ============================================================================
@@ -62,15 +65,32 @@ func TestLFUCache_Set_DuplicateKey(t *testing.T) {
 	cache := NewLFUCache[string, int](10)
 
 	cache.Set("key1", 100)
-	cache.Set("key1", 200) // Should not update
+	cache.Set("key1", 200) // Should update
 
 	val, exists := cache.Get("key1")
 	if !exists {
 		t.Error("Expected key1 to exist")
 	}
-	// Should have original value since duplicate set doesn't update
-	if val != 100 {
-		t.Errorf("Expected original value 100, got %d", val)
+	if val != 200 {
+		t.Errorf("Expected updated value 200, got %d", val)
+	}
+}
+
+func TestLFUCache_Set_OverwriteIncrementsFrequency(t *testing.T) {
+	cache := NewLFUCache[string, int](2)
+
+	cache.Set("hot", 1)
+	cache.Set("hot", 2)
+	cache.Set("cold", 3)
+
+	// "hot" is at frequency 2 and "cold" at frequency 1, so making room evicts only "cold"
+	cache.Set("new", 4)
+
+	if val, exists := cache.Get("hot"); !exists || val != 2 {
+		t.Errorf("Expected hot=2 to survive flush, got %d, %v", val, exists)
+	}
+	if _, exists := cache.Get("cold"); exists {
+		t.Error("cold should have been flushed")
 	}
 }
 
@@ -175,8 +195,8 @@ func TestLFUCache_Delete_NonExistentKey(t *testing.T) {
 	cache := NewLFUCache[string, int](10)
 
 	deleted := cache.Delete("nonexistent")
-	if !deleted {
-		t.Error("Delete should return true even for non-existent key")
+	if deleted {
+		t.Error("Delete should return false for non-existent key")
 	}
 }
 
@@ -378,7 +398,7 @@ func TestLFUCache_Refresh_WithinCapacity(t *testing.T) {
 
 	cache.Flush()
 
-	// With capacity 10 and only 3 frequency buckets, refresh shouldn't affect anything
+	// With capacity 10 and only 3 items, refresh shouldn't affect anything
 	if _, exists := cache.Get("key1"); !exists {
 		t.Error("key1 should exist")
 	}
@@ -391,9 +411,9 @@ func TestLFUCache_Refresh_WithinCapacity(t *testing.T) {
 }
 
 func TestLFUCache_Refresh_ExceedsCapacity(t *testing.T) {
-	cache := NewLFUCache[string, int](2)
+	cache := NewLFUCache[string, int](3)
 
-	// Create 3 different frequency buckets
+	// Create 3 items at different frequencies
 	cache.Set("key1", 1) // freq 1
 	cache.Set("key2", 2) // freq 1
 	cache.Set("key3", 3) // freq 1
@@ -406,11 +426,10 @@ func TestLFUCache_Refresh_ExceedsCapacity(t *testing.T) {
 	cache.Get("key2")
 
 	// Now we have 3 frequency buckets: freq 1 (key3), freq 2 (key1), freq 3 (key2)
-	// Capacity is 2, so refresh keeps 2 HIGHEST frequency buckets
-	// Sort descending: freq 3, freq 2, freq 1
-	// Keep first 2: freq 3 (key2), freq 2 (key1)
+	// Capacity drops to 2, so refresh keeps the 2 most frequent items: key2, key1
 	// Remove: freq 1 (key3)
 
+	cache.capacity = 2
 	cache.Flush()
 
 	// High frequency items should be kept
@@ -434,7 +453,7 @@ func TestLFUCache_Refresh_ExceedsCapacity(t *testing.T) {
 }
 
 func TestLFUCache_Refresh_SortsAndShrinks(t *testing.T) {
-	cache := NewLFUCache[int, string](3)
+	cache := NewLFUCache[int, string](5)
 
 	// Create multiple frequency levels
 	cache.Set(1, "one")   // freq 1
@@ -454,10 +473,10 @@ func TestLFUCache_Refresh_SortsAndShrinks(t *testing.T) {
 	cache.Get(3) // freq 2
 
 	// Frequency buckets: freq 1 (keys 4,5), freq 2 (key 3), freq 3 (key 1), freq 4 (key 2)
-	// Sort descending: freq 4, freq 3, freq 2, freq 1
-	// Keep first 3: freq 4 (key 2), freq 3 (key 1), freq 2 (key 3)
+	// Capacity drops to 3, so keep the 3 most frequent items: key 2, key 1, key 3
 	// Remove: freq 1 (keys 4, 5)
 
+	cache.capacity = 3
 	cache.Flush()
 
 	// High frequency items should be kept
@@ -497,9 +516,7 @@ func TestLFUCache_Refresh_ZeroCapacity(t *testing.T) {
 	cache.Get("key2")
 	cache.Get("key2")
 
-	// With 0 capacity, Flush removes ALL frequency buckets
-	// Because frequencies.Size() > 0, and capacity is 0
-	// So index+1 > 0 is always true, deleting all buckets
+	// With 0 capacity, Flush removes ALL items
 	cache.Flush()
 
 	// All keys should be removed with 0 capacity
@@ -515,7 +532,7 @@ func TestLFUCache_Refresh_ZeroCapacity(t *testing.T) {
 }
 
 func TestLFUCache_Refresh_CapacityOne(t *testing.T) {
-	cache := NewLFUCache[string, int](1)
+	cache := NewLFUCache[string, int](2)
 
 	cache.Set("key1", 1) // freq 1
 	cache.Set("key2", 2) // freq 1
@@ -526,10 +543,10 @@ func TestLFUCache_Refresh_CapacityOne(t *testing.T) {
 	cache.Get("key1") // freq 3
 
 	// Frequency buckets: freq 2 (key2), freq 3 (key1)
-	// Sort descending: freq 3, freq 2
-	// Keep first 1: freq 3 (key1)
+	// Capacity drops to 1, so keep the most frequent item: key1
 	// Remove: freq 2 (key2)
 
+	cache.capacity = 1
 	cache.Flush()
 
 	// Highest frequency item should be kept
@@ -550,7 +567,7 @@ func TestLFUCache_Refresh_CapacityOne(t *testing.T) {
 }
 
 func TestLFUCache_Refresh_MultipleKeysPerFrequency(t *testing.T) {
-	cache := NewLFUCache[int, string](2)
+	cache := NewLFUCache[int, string](10)
 
 	// Create many keys at same frequencies
 	for i := 1; i <= 10; i++ {
@@ -569,10 +586,10 @@ func TestLFUCache_Refresh_MultipleKeysPerFrequency(t *testing.T) {
 	}
 
 	// Frequency buckets: freq 1 (keys 6-10), freq 2 (keys 4-5), freq 3 (keys 1-3)
-	// Sort descending: freq 3, freq 2, freq 1
-	// Keep first 2: freq 3 (keys 1-3), freq 2 (keys 4-5)
+	// Capacity drops to 5, so keep the 5 most frequent items: freq 3 (keys 1-3), freq 2 (keys 4-5)
 	// Remove: freq 1 (keys 6-10)
 
+	cache.capacity = 5
 	cache.Flush()
 
 	// High frequency items should be kept (freq 3)
@@ -719,8 +736,8 @@ func TestLFUCache_EmptyCache_Delete(t *testing.T) {
 	cache := NewLFUCache[string, int](10)
 
 	deleted := cache.Delete("anything")
-	if !deleted {
-		t.Error("Delete on empty cache should return true")
+	if deleted {
+		t.Error("Delete on empty cache should return false")
 	}
 }
 
@@ -736,7 +753,7 @@ func TestLFUCache_EmptyCache_Refresh(t *testing.T) {
 // ----------------------------------------------------------------------------
 
 func TestLFUCache_ManyKeys(t *testing.T) {
-	cache := NewLFUCache[int, int](10)
+	cache := NewLFUCache[int, int](100)
 
 	// Add many keys
 	for i := 0; i < 100; i++ {
@@ -786,13 +803,13 @@ func TestLFUCache_RepeatedSetGet(t *testing.T) {
 		cache.Get(key)
 	}
 
-	// Should have the first value (subsequent Sets don't update)
+	// Should have the last value
 	val, exists := cache.Get("key")
 	if !exists {
 		t.Error("key should exist")
 	}
-	if val != 0 {
-		t.Errorf("Expected first value 0, got %d", val)
+	if val != 49 {
+		t.Errorf("Expected last value 49, got %d", val)
 	}
 }
 
@@ -1116,3 +1133,377 @@ func TestLFUCache_Clear_WithLargeDataset(t *testing.T) {
 		t.Error("Cache should be functional after Clear")
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Concurrency
+// ----------------------------------------------------------------------------
+
+func TestLFUCache_ConcurrentAccess(t *testing.T) {
+	cache := NewLFUCache[int, int](64)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 16; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				key := (worker*31 + i) % 128
+				switch i % 10 {
+				case 0:
+					cache.Delete(key)
+				case 1:
+					if worker == 0 && i%500 == 1 {
+						cache.Clear()
+					} else {
+						cache.Flush()
+					}
+				case 2, 3, 4:
+					cache.Set(key, i)
+				default:
+					if val, exists := cache.Get(key); exists && val < 0 {
+						t.Errorf("Unexpected value %d for key %d", val, key)
+					}
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	cache.Set(-1, 42)
+	if val, exists := cache.Get(-1); !exists || val != 42 {
+		t.Errorf("Cache should still work after concurrent access, got %d, %v", val, exists)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Introspection
+// ----------------------------------------------------------------------------
+
+func TestLFUCache_Introspection(t *testing.T) {
+	cache := NewLFUCache[string, int](5)
+
+	if cache.Len() != 0 || len(cache.Keys()) != 0 || len(cache.Values()) != 0 {
+		t.Error("Empty cache should have no items")
+	}
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Get("a")
+
+	if cache.Len() != 3 {
+		t.Errorf("Expected Len 3, got %d", cache.Len())
+	}
+
+	keys := cache.Keys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected keys [a b c], got %v", keys)
+	}
+
+	values := cache.Values()
+	slices.Sort(values)
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("Expected values [1 2 3], got %v", values)
+	}
+
+	items := make(map[string]int)
+	cache.ForEach(func(key string, value int) bool {
+		items[key] = value
+		return true
+	})
+	if len(items) != 3 || items["a"] != 1 || items["b"] != 2 || items["c"] != 3 {
+		t.Errorf("ForEach visited unexpected items %v", items)
+	}
+
+	count := 0
+	cache.ForEach(func(string, int) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("ForEach should stop early, visited %d items", count)
+	}
+
+	// Introspection must not change frequencies: a is still the only item at frequency 2
+	if node := cache.spot["a"]; node.Data.First != 2 {
+		t.Errorf("Expected frequency 2 for a, got %d", node.Data.First)
+	}
+	if node := cache.spot["b"]; node.Data.First != 1 {
+		t.Errorf("Expected frequency 1 for b, got %d", node.Data.First)
+	}
+}
+
+func TestLFUCache_Introspection_SkipsExpired(t *testing.T) {
+	now := time.Now()
+	cache := NewLFUCache[string, int](5, WithClock(func() time.Time { return now }))
+
+	cache.SetWithTTL("expiring", 1, time.Second)
+	cache.Set("kept", 2)
+	now = now.Add(time.Second)
+
+	if cache.Len() != 1 {
+		t.Errorf("Expected Len 1, got %d", cache.Len())
+	}
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"kept"}) {
+		t.Errorf("Expected keys [kept], got %v", keys)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Peek
+// ----------------------------------------------------------------------------
+
+func TestLFUCache_Peek(t *testing.T) {
+	cache := NewLFUCache[string, int](2)
+
+	if _, exists := cache.Peek("missing"); exists {
+		t.Error("Peek should not find a missing key")
+	}
+
+	cache.Set("hot", 1)
+	cache.Get("hot")
+	cache.Set("cold", 2)
+
+	for i := 0; i < 5; i++ {
+		if val, exists := cache.Peek("cold"); !exists || val != 2 {
+			t.Errorf("Expected cold=2, got %d, %v", val, exists)
+		}
+	}
+
+	// cold is still at frequency 1, so it is evicted before hot
+	cache.Resize(1)
+
+	if _, exists := cache.Peek("cold"); exists {
+		t.Error("cold should have been flushed since Peek doesn't bump frequency")
+	}
+	if val, exists := cache.Peek("hot"); !exists || val != 1 {
+		t.Errorf("Expected hot=1, got %d, %v", val, exists)
+	}
+}
+
+func TestLFUCache_Peek_Expired(t *testing.T) {
+	now := time.Now()
+	cache := NewLFUCache[string, int](2, WithClock(func() time.Time { return now }))
+
+	cache.SetWithTTL("a", 1, time.Second)
+	now = now.Add(time.Second)
+
+	if _, exists := cache.Peek("a"); exists {
+		t.Error("Peek should not return an expired item")
+	}
+}
+
+func TestLFUCache_Contains(t *testing.T) {
+	now := time.Now()
+	cache := NewLFUCache[string, int](2, WithClock(func() time.Time { return now }))
+
+	if cache.Contains("missing") {
+		t.Error("Contains should not find a missing key")
+	}
+
+	cache.Set("hot", 1)
+	cache.Get("hot")
+	cache.Set("cold", 2)
+
+	for i := 0; i < 5; i++ {
+		if !cache.Contains("cold") {
+			t.Error("Expected cold to be cached")
+		}
+	}
+
+	// cold is still at frequency 1, so it is evicted before hot
+	cache.Resize(1)
+
+	if cache.Contains("cold") {
+		t.Error("cold should have been flushed since Contains doesn't bump frequency")
+	}
+	if !cache.Contains("hot") {
+		t.Error("Expected hot to be cached")
+	}
+
+	cache.SetWithTTL("hot", 1, time.Second)
+	now = now.Add(time.Second)
+
+	if cache.Contains("hot") {
+		t.Error("Contains should not report an expired item")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Resize
+// ----------------------------------------------------------------------------
+
+func TestLFUCache_Resize_Shrink(t *testing.T) {
+	cache := NewLFUCache[string, int](5)
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, reason EvictReason) {
+		if reason == Capacity {
+			evicted = append(evicted, key)
+		}
+	})
+
+	// once at frequency 1, twice at 2, thrice at 3
+	cache.Set("once", 1)
+	cache.Set("twice", 2)
+	cache.Get("twice")
+	cache.Set("thrice", 3)
+	cache.Get("thrice")
+	cache.Get("thrice")
+
+	cache.Resize(2)
+
+	keys := cache.Keys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"thrice", "twice"}) {
+		t.Errorf("Expected survivors [thrice twice], got %v", keys)
+	}
+	if !slices.Equal(evicted, []string{"once"}) {
+		t.Errorf("Expected once to be evicted, got %v", evicted)
+	}
+}
+
+func TestLFUCache_Resize_ShrinkSameFrequency(t *testing.T) {
+	cache := NewLFUCache[int, int](10)
+	evicted := 0
+	cache.OnEvict(func(_ int, _ int, reason EvictReason) {
+		if reason == Capacity {
+			evicted++
+		}
+	})
+
+	// every item stays at frequency 1, so they all share a single bucket
+	for i := 0; i < 6; i++ {
+		cache.Set(i, i)
+	}
+
+	cache.Resize(2)
+
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 items after Resize(2), got %d", cache.Len())
+	}
+	if evicted != 4 {
+		t.Errorf("Expected 4 Capacity evictions, got %d", evicted)
+	}
+}
+
+func TestLFUCache_Resize_Grow(t *testing.T) {
+	cache := NewLFUCache[string, int](1)
+
+	cache.Resize(3)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("b")
+	cache.Set("c", 3)
+	cache.Get("c")
+	cache.Get("c")
+	cache.Flush()
+
+	if cache.Len() != 3 {
+		t.Errorf("Expected 3 items to survive a flush after growing, got %d", cache.Len())
+	}
+}
+
+func TestLFUCache_Resize_Negative(t *testing.T) {
+	cache := NewLFUCache[string, int](1)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("b")
+
+	cache.Resize(-1)
+	cache.Flush()
+
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"b"}) {
+		t.Errorf("Expected a negative capacity to be ignored, got %v", keys)
+	}
+}
+
+// ============================================================================
+// Frequency Decay
+// ============================================================================
+
+// makeHot sets key and reads it until its frequency reaches hits + 1.
+func makeHot(cache *LFUCache[string, int], key string, hits int) {
+	cache.Set(key, 0)
+	for i := 0; i < hits; i++ {
+		cache.Get(key)
+	}
+}
+
+func TestLFUCache_WithDecay_NewlyHotKeySurvives(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewLFUCache[string, int](2, WithClock(clock.Now), WithDecay(time.Minute, 0.5))
+
+	makeHot(cache, "old", 99)
+
+	// five halvings bring "old" from 100 down to 3
+	clock.Advance(5 * time.Minute)
+
+	makeHot(cache, "new", 9)
+	cache.Resize(1)
+
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"new"}) {
+		t.Errorf("Expected the newly hot key to survive, got %v", keys)
+	}
+}
+
+func TestLFUCache_WithoutDecay_StaleKeySurvives(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewLFUCache[string, int](2, WithClock(clock.Now))
+
+	makeHot(cache, "old", 99)
+	clock.Advance(5 * time.Minute)
+	makeHot(cache, "new", 9)
+	cache.Resize(1)
+
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"old"}) {
+		t.Errorf("Expected the stale key to survive without decay, got %v", keys)
+	}
+}
+
+func TestLFUCache_WithDecay_MergesBuckets(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewLFUCache[string, int](10, WithClock(clock.Now), WithDecay(time.Minute, 0.5))
+
+	makeHot(cache, "a", 3) // 4 -> 2
+	makeHot(cache, "b", 4) // 5 -> 2
+	makeHot(cache, "c", 0) // 1 -> 1
+
+	clock.Advance(90 * time.Second)
+
+	frequencies := map[string]uint{}
+	for _, entry := range cache.Snapshot() {
+		frequencies[entry.Key] = entry.Frequency
+	}
+	if frequencies["a"] != 2 || frequencies["b"] != 2 || frequencies["c"] != 1 {
+		t.Errorf("Unexpected decayed frequencies %v", frequencies)
+	}
+	if cache.frequencies.Size() != 2 || cache.spot["a"] != cache.spot["b"] {
+		t.Errorf("Expected a and b to share a single bucket, got %d buckets", cache.frequencies.Size())
+	}
+
+	// the remaining 30 seconds count towards the next decay
+	clock.Advance(30 * time.Second)
+	cache.Get("c")
+
+	for _, entry := range cache.Snapshot() {
+		if expected := map[string]uint{"a": 1, "b": 1, "c": 2}[entry.Key]; entry.Frequency != expected {
+			t.Errorf("Expected %s at frequency %d, got %d", entry.Key, expected, entry.Frequency)
+		}
+	}
+}
+
+func TestLFUCache_WithDecay_InvalidSettings(t *testing.T) {
+	for _, opt := range []Option{WithDecay(0, 0.5), WithDecay(time.Minute, 0), WithDecay(time.Minute, 1)} {
+		clock := newFakeClock()
+		cache := NewLFUCache[string, int](10, WithClock(clock.Now), opt)
+
+		makeHot(cache, "a", 3)
+		clock.Advance(time.Hour)
+
+		if snapshot := cache.Snapshot(); snapshot[0].Frequency != 4 {
+			t.Errorf("Expected decay to be disabled, got frequency %d", snapshot[0].Frequency)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"errors"
+
+	"github.com/0x626f/go-kit/utils"
+)
+
+// ErrNotFound is returned by a Loader to report that a key doesn't exist in the backing store.
+// LoadingCache.Get reports it as a miss rather than as an error.
+var ErrNotFound = errors.New("cache: not found")
+
+// Loader fetches the value of a key from a backing store such as a database.
+// It returns ErrNotFound (or an error wrapping it) if the key doesn't exist.
+//
+// Type parameters:
+//   - K: The type of keys
+//   - D: The type of the loaded values
+type Loader[K comparable, D any] func(key K) (D, error)
+
+// LoadingCache is a read-through cache: Get transparently calls its Loader on a miss
+// and stores the result. Concurrent misses on the same key share a single load:
+// one caller runs the loader and the others wait for its result.
+//
+// Loader errors and keys reported as not found are not cached, so the next Get loads again.
+//
+// LoadingCache is safe for concurrent use by multiple goroutines.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+type LoadingCache[K comparable, D any] struct {
+	// cache stores the loaded values
+	cache Cache[K, D]
+	// loader fetches values on a miss
+	loader Loader[K, D]
+	// flights deduplicates concurrent loads of the same key
+	flights flightGroup[K, D]
+}
+
+// NewLoadingCache creates a read-through cache storing loaded values in an LRU cache.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - capacity: The capacity of the underlying cache
+//   - loader: The function fetching values on a miss
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
+//
+// Returns:
+//   - A pointer to the newly created LoadingCache
+//
+// Example:
+//
+//	users := cache.NewLoadingCache[int, *User](1000, func(id int) (*User, error) {
+//	    user, err := db.LoadUser(id)
+//	    if errors.Is(err, sql.ErrNoRows) {
+//	        return nil, cache.ErrNotFound
+//	    }
+//	    return user, err
+//	})
+//
+//	user, found, err := users.Get(42)
+func NewLoadingCache[K comparable, D any](capacity int, loader Loader[K, D], opts ...Option) *LoadingCache[K, D] {
+	return NewLoadingCacheWithPolicy[K, D](PolicyLRU, capacity, loader, opts...)
+}
+
+// NewLoadingCacheWithPolicy creates a read-through cache storing loaded values in a cache
+// with the eviction strategy selected by policy (see NewCache).
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - policy: The eviction strategy of the underlying cache
+//   - capacity: The capacity of the underlying cache
+//   - loader: The function fetching values on a miss
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
+//
+// Returns:
+//   - A pointer to the newly created LoadingCache
+//
+// Example:
+//
+//	products := cache.NewLoadingCacheWithPolicy[string, *Product](cache.PolicyLFU, 500, db.LoadProduct)
+func NewLoadingCacheWithPolicy[K comparable, D any](policy Policy, capacity int, loader Loader[K, D], opts ...Option) *LoadingCache[K, D] {
+	return &LoadingCache[K, D]{
+		cache:  NewCache[K, D](policy, capacity, opts...),
+		loader: loader,
+	}
+}
+
+// Get returns the cached value for key or, on a miss, loads it with the Loader and stores it.
+//
+// The loader runs without holding the cache lock, so it may use the cache.
+// If the loader panics, the calling goroutine panics and the callers waiting on it get ErrComputePanicked.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached or loaded value, true and nil on success
+//   - A zero value, false and nil if the loader reports ErrNotFound
+//   - A zero value, false and the loader error if loading failed
+//
+// Example:
+//
+//	user, found, err := users.Get(42)
+//	switch {
+//	case err != nil:
+//	    return err // the database failed
+//	case !found:
+//	    return ErrNoSuchUser
+//	}
+func (cache *LoadingCache[K, D]) Get(key K) (D, bool, error) {
+	if item, exists := cache.cache.Get(key); exists {
+		return item, true, nil
+	}
+
+	item, err := cache.flights.do(key, func() (D, error) {
+		// A load that finished just before this one started may have stored the value
+		if item, exists := cache.cache.Get(key); exists {
+			return item, nil
+		}
+
+		item, err := cache.loader(key)
+		if err != nil {
+			return utils.Zero[D](), err
+		}
+
+		cache.cache.Set(key, item)
+		return item, nil
+	})
+
+	if errors.Is(err, ErrNotFound) {
+		return utils.Zero[D](), false, nil
+	}
+	if err != nil {
+		return utils.Zero[D](), false, err
+	}
+	return item, true, nil
+}
+
+// Set stores a value in the cache, e.g. after writing it to the backing store.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+func (cache *LoadingCache[K, D]) Set(key K, item D) {
+	cache.cache.Set(key, item)
+}
+
+// Delete removes a value from the cache, so the next Get loads it again.
+//
+// Parameters:
+//   - key: The key of the item to remove
+//
+// Returns:
+//   - The result of Delete on the underlying cache
+func (cache *LoadingCache[K, D]) Delete(key K) bool {
+	return cache.cache.Delete(key)
+}
+
+// Clear removes all items from the cache.
+func (cache *LoadingCache[K, D]) Clear() {
+	cache.cache.Clear()
+}
+
+// Flush enforces the capacity of the underlying cache.
+func (cache *LoadingCache[K, D]) Flush() {
+	cache.cache.Flush()
+}
+
+// Len returns the number of items in the cache, not counting expired items.
+func (cache *LoadingCache[K, D]) Len() int {
+	return cache.cache.Len()
+}
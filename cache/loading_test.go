@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Loading Cache
+// ============================================================================
+
+func TestLoadingCache_Sequential(t *testing.T) {
+	for _, policy := range []Policy{PolicyLRU, PolicyLFU, PolicyFIFO} {
+		t.Run(policy.String(), func(t *testing.T) {
+			var loads atomic.Int32
+			cache := NewLoadingCacheWithPolicy[int, string](policy, 10, func(key int) (string, error) {
+				loads.Add(1)
+				return fmt.Sprint("value-", key), nil
+			})
+
+			for i := 0; i < 3; i++ {
+				for key := 0; key < 5; key++ {
+					val, found, err := cache.Get(key)
+					if err != nil || !found || val != fmt.Sprint("value-", key) {
+						t.Errorf("Expected value-%d, got %q, %v, %v", key, val, found, err)
+					}
+				}
+			}
+
+			if loads.Load() != 5 {
+				t.Errorf("Expected 5 loads, got %d", loads.Load())
+			}
+			if cache.Len() != 5 {
+				t.Errorf("Expected 5 cached items, got %d", cache.Len())
+			}
+
+			cache.Delete(0)
+			cache.Get(0)
+			if loads.Load() != 6 {
+				t.Errorf("Expected a deleted key to be loaded again, got %d loads", loads.Load())
+			}
+
+			cache.Set(100, "preset")
+			if val, found, _ := cache.Get(100); !found || val != "preset" || loads.Load() != 6 {
+				t.Errorf("Expected the preset value without a load, got %q after %d loads", val, loads.Load())
+			}
+		})
+	}
+}
+
+func TestLoadingCache_NotFoundAndErrors(t *testing.T) {
+	failure := errors.New("database unavailable")
+	var loads atomic.Int32
+	cache := NewLoadingCache[string, int](10, func(key string) (int, error) {
+		loads.Add(1)
+		switch key {
+		case "missing":
+			return 0, fmt.Errorf("user %s: %w", key, ErrNotFound)
+		case "broken":
+			return 1, failure
+		default:
+			return 42, nil
+		}
+	})
+
+	val, found, err := cache.Get("missing")
+	if err != nil || found || val != 0 {
+		t.Errorf("Expected a miss without error, got %d, %v, %v", val, found, err)
+	}
+
+	val, found, err = cache.Get("broken")
+	if !errors.Is(err, failure) || found || val != 0 {
+		t.Errorf("Expected the loader error, got %d, %v, %v", val, found, err)
+	}
+
+	cache.Get("missing")
+	cache.Get("broken")
+	if loads.Load() != 4 {
+		t.Errorf("Misses and errors should not be cached, got %d loads", loads.Load())
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Expected an empty cache, got %d items", cache.Len())
+	}
+}
+
+func TestLoadingCache_ConcurrentMissesLoadOnce(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+
+	cache := NewLoadingCache[string, int](10, func(key string) (int, error) {
+		loads.Add(1)
+		<-release
+		return len(key), nil
+	})
+
+	const callers = 32
+	var started, wg sync.WaitGroup
+	results := make([]int, callers)
+
+	started.Add(callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			started.Done()
+			results[i], _, _ = cache.Get("key")
+		}(i)
+	}
+
+	started.Wait()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if loads.Load() != 1 {
+		t.Errorf("Expected exactly one load, got %d", loads.Load())
+	}
+	for i, result := range results {
+		if result != 3 {
+			t.Errorf("Caller %d expected 3, got %d", i, result)
+		}
+	}
+}
+
+func TestLoadingCache_ConcurrentKeys(t *testing.T) {
+	var loads atomic.Int32
+	cache := NewLoadingCache[int, int](100, func(key int) (int, error) {
+		loads.Add(1)
+		return key * 2, nil
+	})
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := i % 50
+				if val, found, err := cache.Get(key); err != nil || !found || val != key*2 {
+					t.Errorf("Expected %d, got %d, %v, %v", key*2, val, found, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if loads.Load() != 50 {
+		t.Errorf("Expected each of the 50 keys to load once, got %d loads", loads.Load())
+	}
+}
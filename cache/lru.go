@@ -1,6 +1,10 @@
 package cache
 
 import (
+	"sync"
+	"time"
+
+	"github.com/0x626f/go-kit/abstract"
 	"github.com/0x626f/go-kit/linkedlist"
 	"github.com/0x626f/go-kit/types"
 	"github.com/0x626f/go-kit/utils"
@@ -12,6 +16,12 @@ import (
 // The cache maintains access order using a linked list, where the most recently accessed
 // items are at the front and least recently accessed items are at the back.
 //
+// Items may expire after a time to live, set per item with SetWithTTL or for all items
+// with the WithDefaultTTL option. Expired items are treated as absent and evicted lazily
+// on access, or proactively by the janitor started with StartJanitor.
+//
+// LRUCache is safe for concurrent use by multiple goroutines.
+//
 // Type parameters:
 //   - K: The type of keys (must be comparable)
 //   - D: The type of data stored
@@ -21,6 +31,9 @@ import (
 //   - Get: O(1)
 //   - Delete: O(1)
 type LRUCache[K comparable, D any] struct {
+	// mutex guards all cache state; Get reorders the recency list, so reads lock exclusively too
+	mutex sync.Mutex
+
 	// capacity is the maximum number of items the cache can hold
 	// A capacity of 0 means unlimited
 	capacity int
@@ -32,6 +45,21 @@ type LRUCache[K comparable, D any] struct {
 	// data maps keys to their corresponding nodes in the linked list
 	// for O(1) lookup and access
 	data PrimaryCache[K, *linkedlist.LinkedNode[*types.Pair[K, D]]]
+
+	// expiry tracks the expiration deadlines of items
+	expiry expiry[K]
+
+	// janitor periodically evicts expired items once started
+	janitor janitor
+
+	// evictions queues removed items for the eviction callback
+	evictions evictions[K, D]
+
+	// statistics counts hits, misses, inserts, replaces and evictions
+	statistics statistics
+
+	// flights deduplicates concurrent GetOrCompute computations per key
+	flights flightGroup[K, D]
 }
 
 // NewLRUCache creates and initializes a new LRU cache with the specified capacity.
@@ -42,6 +70,7 @@ type LRUCache[K comparable, D any] struct {
 //
 // Parameters:
 //   - capacity: Maximum number of items the cache can hold. Use 0 for unlimited capacity.
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
 //
 // Returns:
 //   - A pointer to the newly created LRUCache
@@ -51,17 +80,19 @@ type LRUCache[K comparable, D any] struct {
 //	cache := cache.NewLRUCache[string, int](100)
 //	cache.Set("user:123", 42)
 //	value, found := cache.Get("user:123")
-func NewLRUCache[K comparable, D any](capacity int) *LRUCache[K, D] {
+func NewLRUCache[K comparable, D any](capacity int, opts ...Option) *LRUCache[K, D] {
 	return &LRUCache[K, D]{
 		capacity: capacity,
 		recent:   linkedlist.NewLinkedList[*types.Pair[K, D]](),
 		data:     make(map[K]*linkedlist.LinkedNode[*types.Pair[K, D]]),
+		expiry:   newExpiry[K](opts),
 	}
 }
 
 // Set adds or updates an item in the cache.
-// If the key already exists, this method does nothing (existing value is preserved).
+// If the key already exists, its value is replaced and it is marked as most recently used.
 // If the cache is at capacity, the least recently used item is evicted to make room.
+// The item expires after the default TTL, if one was configured with WithDefaultTTL.
 //
 // The newly added item is placed at the front of the access list (most recently used position).
 //
@@ -71,21 +102,107 @@ func NewLRUCache[K comparable, D any](capacity int) *LRUCache[K, D] {
 //
 // Time complexity: O(1)
 func (cache *LRUCache[K, D]) Set(key K, item D) {
-	if _, exists := cache.data[key]; exists {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, cache.expiry.defaultTTL)
+}
+
+// SetWithTTL adds or updates an item in the cache like Set, with its own time to live.
+// A ttl of 0 or less means the item never expires.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - ttl: The time after which the item expires
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	cache := cache.NewLRUCache[string, string](100)
+//	cache.SetWithTTL("token", "abc", time.Minute)
+func (cache *LRUCache[K, D]) SetWithTTL(key K, item D, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, ttl)
+}
+
+// set is an internal method that stores an item and its deadline, evicting the least
+// recently used item when the cache is over capacity. The caller must hold the mutex.
+func (cache *LRUCache[K, D]) set(key K, item D, ttl time.Duration) {
+	cache.expiry.track(key, ttl)
+
+	if node, exists := cache.data[key]; exists {
+		cache.evict(key, node.Data.Second, Replaced)
+		node.Data.Second = item
+		cache.recent.MoveToFront(node)
 		return
 	}
 
 	node := cache.recent.InsertFront(&types.Pair[K, D]{First: key, Second: item})
 	cache.data[key] = node
+	cache.statistics.inserts.Add(1)
 
 	if cache.capacity != 0 && cache.recent.Size() > cache.capacity {
 		retired := cache.recent.PopRight()
 		delete(cache.data, retired.First)
+		cache.expiry.forget(retired.First)
+		cache.evict(retired.First, retired.Second, Capacity)
 	}
 }
 
+// remove is an internal method that evicts the item stored in node for the given reason.
+// The caller must hold the mutex.
+func (cache *LRUCache[K, D]) remove(key K, node *linkedlist.LinkedNode[*types.Pair[K, D]], reason EvictReason) {
+	cache.recent.Remove(node)
+	delete(cache.data, key)
+	cache.expiry.forget(key)
+	cache.evict(key, node.Data.Second, reason)
+}
+
+// OnEvict registers callback to be called whenever an item leaves the cache or has its value replaced,
+// replacing any previously registered callback. Pass nil to unregister.
+//
+// The callback is invoked synchronously by the operation that removed the item, after the cache
+// lock has been released, so it may safely call methods of the same cache.
+//
+// Parameters:
+//   - callback: The function receiving the key, the removed value and the reason
+//
+// Example:
+//
+//	cache := cache.NewLRUCache[string, *Conn](100)
+//	cache.OnEvict(func(key string, conn *Conn, reason cache.EvictReason) {
+//	    conn.Close()
+//	})
+func (cache *LRUCache[K, D]) OnEvict(callback EvictCallback[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.evictions.callback = callback
+}
+
+// unlock is an internal method that releases the mutex and then reports
+// the evictions recorded while it was held.
+func (cache *LRUCache[K, D]) unlock() {
+	callback, pending := cache.evictions.take()
+	cache.mutex.Unlock()
+
+	notify(callback, pending)
+}
+
+// evict is an internal method that counts an item removed for the given reason
+// and queues it for the eviction callback. The caller must hold the mutex.
+func (cache *LRUCache[K, D]) evict(key K, item D, reason EvictReason) {
+	cache.statistics.count(reason)
+	cache.evictions.record(key, item, reason)
+}
+
 // Get retrieves an item from the cache by its key.
 // Accessing an item moves it to the front of the access list (marks it as most recently used).
+// An expired item is evicted and reported as not found.
 //
 // Parameters:
 //   - key: The key of the item to retrieve
@@ -96,14 +213,147 @@ func (cache *LRUCache[K, D]) Set(key K, item D) {
 //
 // Time complexity: O(1)
 func (cache *LRUCache[K, D]) Get(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.get(key)
+}
+
+// get is an internal method implementing Get. The caller must hold the mutex.
+func (cache *LRUCache[K, D]) get(key K) (D, bool) {
 	if node, exists := cache.data[key]; exists {
+		if cache.expiry.expired(key) {
+			cache.remove(key, node, Expired)
+			cache.statistics.misses.Add(1)
+			return utils.Zero[D](), false
+		}
+
 		cache.recent.MoveToFront(node)
+		cache.statistics.hits.Add(1)
 		return node.Data.Second, true
 	}
 
+	cache.statistics.misses.Add(1)
 	return utils.Zero[D](), false
 }
 
+// GetWithExpiry retrieves an item from the cache like Get, along with the moment it expires,
+// e.g. to compute the max-age of a cached HTTP response. Like Get, it marks the item as recently used.
+// Items stored without a time to live never expire and are returned with the zero time.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data, its expiration time (zero if it never expires) and true if found
+//   - A zero value, the zero time and false if the key is not in the cache
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	if page, expiresAt, ok := pages.GetWithExpiry(url); ok && !expiresAt.IsZero() {
+//	    writer.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(time.Until(expiresAt).Seconds())))
+//	}
+func (cache *LRUCache[K, D]) GetWithExpiry(key K) (D, time.Time, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	item, exists := cache.get(key)
+	if !exists {
+		return item, time.Time{}, false
+	}
+
+	return item, cache.expiry.deadline(key), true
+}
+
+// GetOrCompute returns the cached value for key or, on a miss, calls compute and stores its result.
+// Concurrent callers missing the same key share a single compute call: one caller runs it
+// and the others wait for its result. Errors are returned to all waiting callers and not cached.
+//
+// The compute function runs without holding the cache lock, so it may use the cache.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//   - compute: The function producing the value on a miss
+//
+// Returns:
+//   - The cached or computed value and nil on success
+//   - A zero value and the error returned by compute, or ErrComputePanicked to waiters if it panicked
+//
+// Example:
+//
+//	user, err := users.GetOrCompute(id, func() (*User, error) {
+//	    return db.LoadUser(id)
+//	})
+func (cache *LRUCache[K, D]) GetOrCompute(key K, compute func() (D, error)) (D, error) {
+	if item, exists := cache.Get(key); exists {
+		return item, nil
+	}
+
+	return cache.flights.do(key, func() (D, error) {
+		// A computation that finished just before this one started may have stored the value
+		if item, exists := cache.Peek(key); exists {
+			return item, nil
+		}
+
+		item, err := compute()
+		if err != nil {
+			return utils.Zero[D](), err
+		}
+
+		cache.Set(key, item)
+		return item, nil
+	})
+}
+
+// Peek retrieves an item from the cache without affecting its recency.
+// Unlike Get, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found and not expired
+//   - A zero value and false otherwise
+//
+// Time complexity: O(1)
+func (cache *LRUCache[K, D]) Peek(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if node, exists := cache.data[key]; exists && !cache.expiry.expired(key) {
+		return node.Data.Second, true
+	}
+
+	return utils.Zero[D](), false
+}
+
+// Contains reports whether the cache holds an item for key without affecting its recency.
+// Unlike Peek, it doesn't return the value; like Peek, it doesn't evict expired items
+// and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key to look up
+//
+// Returns:
+//   - true if the item is cached and not expired, false otherwise
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	if !sessions.Contains(id) {
+//	    sessions.Set(id, newSession())
+//	}
+func (cache *LRUCache[K, D]) Contains(key K) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	_, exists := cache.data[key]
+	return exists && !cache.expiry.expired(key)
+}
+
 // Delete removes an item from the cache by its key.
 //
 // Parameters:
@@ -115,14 +365,96 @@ func (cache *LRUCache[K, D]) Get(key K) (D, bool) {
 //
 // Time complexity: O(1)
 func (cache *LRUCache[K, D]) Delete(key K) bool {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.delete(key)
+}
+
+// delete is an internal method removing key and reporting whether it was present.
+// The caller must hold the mutex.
+func (cache *LRUCache[K, D]) delete(key K) bool {
 	if node, exists := cache.data[key]; exists {
-		cache.recent.Remove(node)
-		delete(cache.data, key)
+		cache.remove(key, node, Deleted)
 		return true
 	}
 	return false
 }
 
+// SetMany adds or updates all items of the map like Set, holding the lock once for the whole batch,
+// so other goroutines observe either none or all of the items.
+// Items are stored in the unspecified iteration order of the map.
+//
+// Parameters:
+//   - items: The key-value pairs to cache
+//
+// Time complexity: O(n) where n is the number of items
+//
+// Example:
+//
+//	cache := cache.NewLRUCache[string, int](100)
+//	cache.SetMany(map[string]int{"a": 1, "b": 2})
+func (cache *LRUCache[K, D]) SetMany(items map[K]D) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for key, item := range items {
+		cache.set(key, item, cache.expiry.defaultTTL)
+	}
+}
+
+// GetMany retrieves several items like Get, holding the lock once for the whole batch.
+// Every found item is marked as most recently used, in the order of keys.
+//
+// Parameters:
+//   - keys: The keys of the items to retrieve
+//
+// Returns:
+//   - A map holding only the keys found in the cache and their values
+//
+// Time complexity: O(n) where n is the number of keys
+//
+// Example:
+//
+//	found := cache.GetMany([]string{"a", "b", "missing"})
+//	// found == map[string]int{"a": 1, "b": 2}
+func (cache *LRUCache[K, D]) GetMany(keys []K) map[K]D {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	found := make(map[K]D, len(keys))
+	for _, key := range keys {
+		if item, exists := cache.get(key); exists {
+			found[key] = item
+		}
+	}
+
+	return found
+}
+
+// DeleteMany removes several items like Delete, holding the lock once for the whole batch.
+//
+// Parameters:
+//   - keys: The keys of the items to remove
+//
+// Returns:
+//   - The number of items that were found and deleted
+//
+// Time complexity: O(n) where n is the number of keys
+func (cache *LRUCache[K, D]) DeleteMany(keys []K) int {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	deleted := 0
+	for _, key := range keys {
+		if cache.delete(key) {
+			deleted++
+		}
+	}
+
+	return deleted
+}
+
 // Flush removes items from the cache when the number of items exceeds capacity.
 // It keeps only the most recently accessed items up to the cache's capacity limit.
 // Items are removed from the back of the access list (least recently used).
@@ -143,11 +475,21 @@ func (cache *LRUCache[K, D]) Delete(key K) bool {
 //
 // Time complexity: O(n) where n is the number of items to remove
 func (cache *LRUCache[K, D]) Flush() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.flush()
+}
 
+// flush is an internal method that removes the items beyond capacity.
+// The caller must hold the mutex.
+func (cache *LRUCache[K, D]) flush() {
 	if cache.recent.Size() > cache.capacity {
 		cache.recent.ForEach(func(index int, data *types.Pair[K, D]) bool {
 			if (index + 1) > cache.capacity {
 				delete(cache.data, data.First)
+				cache.expiry.forget(data.First)
+				cache.evict(data.First, data.Second, Capacity)
 			}
 			return true
 		})
@@ -155,6 +497,35 @@ func (cache *LRUCache[K, D]) Flush() {
 	}
 }
 
+// Resize changes the capacity of the cache. A capacity of 0 means unlimited, like in NewLRUCache,
+// and a negative capacity is ignored.
+// When shrinking, the least recently used items are evicted immediately down to the new capacity,
+// reporting them to the eviction callback with reason Capacity.
+// When growing, more items are simply allowed.
+//
+// Parameters:
+//   - capacity: The new maximum number of items, or 0 for unlimited
+//
+// Time complexity: O(n) where n is the number of items
+//
+// Example:
+//
+//	cache := cache.NewLRUCache[string, int](100)
+//	cache.Resize(10) // keeps at most 10 items
+func (cache *LRUCache[K, D]) Resize(capacity int) {
+	if capacity < 0 {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.capacity = capacity
+	if capacity != 0 {
+		cache.flush()
+	}
+}
+
 // Clear removes all items from the cache, resetting it to an empty state.
 // This includes clearing the access order list and the key-to-node mapping.
 //
@@ -171,6 +542,218 @@ func (cache *LRUCache[K, D]) Flush() {
 //
 // Time complexity: O(n) where n is the number of items in the cache
 func (cache *LRUCache[K, D]) Clear() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.clear()
+}
+
+// clear is an internal method that removes all items, reporting them with reason Cleared.
+// The caller must hold the mutex.
+func (cache *LRUCache[K, D]) clear() {
+	for pair := range cache.recent.Values() {
+		cache.evict(pair.First, pair.Second, Cleared)
+	}
+
 	cache.recent.DeleteAll()
 	clear(cache.data)
+	cache.expiry.reset()
+}
+
+// Len returns the number of items in the cache, not counting expired items.
+//
+// Time complexity: O(e) where e is the number of items with a TTL
+func (cache *LRUCache[K, D]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return len(cache.data) - cache.expiry.countExpired()
+}
+
+// Keys returns the keys of the items in the cache, in ForEach order.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// Time complexity: O(n)
+func (cache *LRUCache[K, D]) Keys() []K {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	keys := make([]K, 0, len(cache.data))
+	cache.forEach(func(key K, _ D) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// Values returns the values of the items in the cache, in ForEach order.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// Time complexity: O(n)
+func (cache *LRUCache[K, D]) Values() []D {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	values := make([]D, 0, len(cache.data))
+	cache.forEach(func(_ K, item D) bool {
+		values = append(values, item)
+		return true
+	})
+
+	return values
+}
+
+// Snapshot returns the live items of the cache from the most to the least recently used, which is the reverse
+// of the eviction order, so that Restore can rebuild the cache in another process.
+// Expired items are skipped, and recency is not affected.
+//
+// Returns:
+//   - The entries with their expiration deadlines
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	data, err := cache.MarshalEntries(lru.Snapshot())
+func (cache *LRUCache[K, D]) Snapshot() []Entry[K, D] {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entries := make([]Entry[K, D], 0, len(cache.data))
+	cache.forEach(func(key K, item D) bool {
+		entries = append(entries, Entry[K, D]{Key: key, Value: item, ExpiresAt: cache.expiry.deadline(key)})
+		return true
+	})
+
+	return entries
+}
+
+// Restore replaces the contents of the cache with entries in the order returned by Snapshot.
+// Current items are removed and reported to the eviction callback with reason Cleared.
+// When there are more entries than the capacity, only the leading ones are restored,
+// so the least recently used entries are dropped. Expired entries and repeated keys are skipped too.
+// Restored items aren't counted as inserts in the statistics.
+//
+// Parameters:
+//   - entries: The entries to load, usually returned by Snapshot in a previous process
+//
+// Time complexity: O(n + m) where n is the number of items and m is the number of entries
+//
+// Example:
+//
+//	entries, err := cache.UnmarshalEntries[string, int](data)
+//	if err == nil {
+//	    lru := cache.NewLRUCache[string, int](100)
+//	    lru.Restore(entries)
+//	}
+func (cache *LRUCache[K, D]) Restore(entries []Entry[K, D]) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.clear()
+
+	now := cache.expiry.clock()
+	for _, entry := range entries {
+		if cache.capacity != 0 && cache.recent.Size() >= cache.capacity {
+			break
+		}
+
+		if _, exists := cache.data[entry.Key]; exists || entry.expired(now) {
+			continue
+		}
+
+		cache.data[entry.Key] = cache.recent.Insert(&types.Pair[K, D]{First: entry.Key, Second: entry.Value})
+		cache.expiry.restore(entry.Key, entry.ExpiresAt)
+	}
+}
+
+// ForEach calls receiver for every item in the cache until it returns false.
+// Items are visited from the most to the least recently used.
+// Expired items are skipped, and recency/frequency is not affected.
+//
+// The receiver is invoked while the cache is locked and must not call methods of the same cache,
+// otherwise it deadlocks.
+//
+// Parameters:
+//   - receiver: A function called with each key and value; return false to stop
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	cache.ForEach(func(key string, value int) bool {
+//	    fmt.Println(key, value)
+//	    return true
+//	})
+func (cache *LRUCache[K, D]) ForEach(receiver abstract.IndexedReceiver[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.forEach(receiver)
+}
+
+// forEach is an internal method that iterates live items from the most to the least recently used.
+// The caller must hold the mutex.
+func (cache *LRUCache[K, D]) forEach(receiver abstract.IndexedReceiver[K, D]) {
+	for pair := range cache.recent.Values() {
+		if cache.expiry.expired(pair.First) {
+			continue
+		}
+		if !receiver(pair.First, pair.Second) {
+			return
+		}
+	}
+}
+
+// Stats returns a snapshot of the usage counters of the cache.
+//
+// Returns:
+//   - The counters and the current number of live items
+//
+// Example:
+//
+//	stats := cache.Stats()
+//	fmt.Printf("hit ratio %.2f over %d items\n", stats.HitRatio(), stats.Len)
+func (cache *LRUCache[K, D]) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.statistics.snapshot(len(cache.data) - cache.expiry.countExpired())
+}
+
+// ResetStats sets all usage counters of the cache to zero.
+func (cache *LRUCache[K, D]) ResetStats() {
+	cache.statistics.reset()
+}
+
+// StartJanitor starts a background goroutine that evicts expired items every interval.
+// Calling it again replaces the running janitor; a non-positive interval just stops it.
+// Call StopJanitor when the cache is no longer used, otherwise the goroutine keeps it alive.
+//
+// Parameters:
+//   - interval: The time between two sweeps
+//
+// Example:
+//
+//	cache := cache.NewLRUCache[string, int](100, cache.WithDefaultTTL(time.Minute))
+//	cache.StartJanitor(10 * time.Second)
+//	defer cache.StopJanitor()
+func (cache *LRUCache[K, D]) StartJanitor(interval time.Duration) {
+	cache.janitor.start(interval, cache.deleteExpired)
+}
+
+// StopJanitor stops the background goroutine started by StartJanitor, if any.
+func (cache *LRUCache[K, D]) StopJanitor() {
+	cache.janitor.halt()
+}
+
+// deleteExpired is an internal method that evicts all expired items.
+func (cache *LRUCache[K, D]) deleteExpired() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for _, key := range cache.expiry.collect() {
+		cache.remove(key, cache.data[key], Expired)
+	}
 }
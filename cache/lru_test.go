@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"slices"
+	"sync"
 	"testing"
+	"time"
 )
 
 // ============================================================================
@@ -69,14 +72,33 @@ func TestLRUCache_Set_DuplicateKey(t *testing.T) {
 	cache := NewLRUCache[string, int](5)
 
 	cache.Set("key1", 100)
-	cache.Set("key1", 200) // Should not update
+	cache.Set("key1", 200) // Should update
 
 	val, exists := cache.Get("key1")
 	if !exists {
 		t.Error("Expected key1 to exist")
 	}
-	if val != 100 {
-		t.Errorf("Expected original value 100, got %d (duplicate set should not update)", val)
+	if val != 200 {
+		t.Errorf("Expected updated value 200, got %d", val)
+	}
+}
+
+func TestLRUCache_Set_OverwriteRefreshesRecency(t *testing.T) {
+	cache := NewLRUCache[string, int](2)
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	cache.Set("key1", 10) // key1 becomes most recently used
+	cache.Set("key3", 3)  // evicts key2
+
+	if _, exists := cache.Get("key2"); exists {
+		t.Error("key2 should have been evicted")
+	}
+	if val, exists := cache.Get("key1"); !exists || val != 10 {
+		t.Errorf("Expected key1=10, got %d, %v", val, exists)
+	}
+	if val, exists := cache.Get("key3"); !exists || val != 3 {
+		t.Errorf("Expected key3=3, got %d, %v", val, exists)
 	}
 }
 
@@ -1154,3 +1176,259 @@ func TestLRUCache_ValuePreservation_DuringEviction(t *testing.T) {
 		t.Error("Value for 5 should be preserved")
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Concurrency
+// ----------------------------------------------------------------------------
+
+func TestLRUCache_ConcurrentAccess(t *testing.T) {
+	cache := NewLRUCache[int, int](64)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 16; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				key := (worker*31 + i) % 128
+				switch i % 10 {
+				case 0:
+					cache.Delete(key)
+				case 1:
+					if worker == 0 && i%500 == 1 {
+						cache.Clear()
+					} else {
+						cache.Flush()
+					}
+				case 2, 3, 4:
+					cache.Set(key, i)
+				default:
+					if val, exists := cache.Get(key); exists && val < 0 {
+						t.Errorf("Unexpected value %d for key %d", val, key)
+					}
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	cache.Set(-1, 42)
+	if val, exists := cache.Get(-1); !exists || val != 42 {
+		t.Errorf("Cache should still work after concurrent access, got %d, %v", val, exists)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Introspection
+// ----------------------------------------------------------------------------
+
+func TestLRUCache_Introspection(t *testing.T) {
+	cache := NewLRUCache[string, int](5)
+
+	if cache.Len() != 0 || len(cache.Keys()) != 0 || len(cache.Values()) != 0 {
+		t.Error("Empty cache should have no items")
+	}
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Get("a")
+
+	if cache.Len() != 3 {
+		t.Errorf("Expected Len 3, got %d", cache.Len())
+	}
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"a", "c", "b"}) {
+		t.Errorf("Expected keys in recency order [a c b], got %v", keys)
+	}
+	if values := cache.Values(); !slices.Equal(values, []int{1, 3, 2}) {
+		t.Errorf("Expected values in recency order [1 3 2], got %v", values)
+	}
+
+	var visited []string
+	cache.ForEach(func(key string, _ int) bool {
+		visited = append(visited, key)
+		return len(visited) < 2
+	})
+	if !slices.Equal(visited, []string{"a", "c"}) {
+		t.Errorf("ForEach should stop early, visited %v", visited)
+	}
+
+	// Introspection must not change recency: b is still the least recently used
+	cache.Set("d", 4)
+	cache.Set("e", 5)
+	cache.Set("f", 6)
+	if _, exists := cache.Get("b"); exists {
+		t.Error("b should have been evicted as least recently used")
+	}
+}
+
+func TestLRUCache_Introspection_SkipsExpired(t *testing.T) {
+	now := time.Now()
+	cache := NewLRUCache[string, int](5, WithClock(func() time.Time { return now }))
+
+	cache.SetWithTTL("expiring", 1, time.Second)
+	cache.Set("kept", 2)
+	now = now.Add(time.Second)
+
+	if cache.Len() != 1 {
+		t.Errorf("Expected Len 1, got %d", cache.Len())
+	}
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"kept"}) {
+		t.Errorf("Expected keys [kept], got %v", keys)
+	}
+	if values := cache.Values(); !slices.Equal(values, []int{2}) {
+		t.Errorf("Expected values [2], got %v", values)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Peek
+// ----------------------------------------------------------------------------
+
+func TestLRUCache_Peek(t *testing.T) {
+	cache := NewLRUCache[string, int](2)
+
+	if _, exists := cache.Peek("missing"); exists {
+		t.Error("Peek should not find a missing key")
+	}
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	if val, exists := cache.Peek("a"); !exists || val != 1 {
+		t.Errorf("Expected a=1, got %d, %v", val, exists)
+	}
+
+	// a is still the least recently used, so it is evicted next
+	cache.Set("c", 3)
+
+	if _, exists := cache.Peek("a"); exists {
+		t.Error("a should have been evicted since Peek doesn't refresh recency")
+	}
+	if val, exists := cache.Peek("b"); !exists || val != 2 {
+		t.Errorf("Expected b=2, got %d, %v", val, exists)
+	}
+	if stats := cache.Stats(); stats.Hits != 0 || stats.Misses != 0 {
+		t.Errorf("Peek should not be counted, got %+v", stats)
+	}
+}
+
+func TestLRUCache_Peek_Expired(t *testing.T) {
+	now := time.Now()
+	cache := NewLRUCache[string, int](2, WithClock(func() time.Time { return now }))
+
+	cache.SetWithTTL("a", 1, time.Second)
+	now = now.Add(time.Second)
+
+	if _, exists := cache.Peek("a"); exists {
+		t.Error("Peek should not return an expired item")
+	}
+}
+
+func TestLRUCache_Contains(t *testing.T) {
+	now := time.Now()
+	cache := NewLRUCache[string, int](2, WithClock(func() time.Time { return now }))
+
+	if cache.Contains("missing") {
+		t.Error("Contains should not find a missing key")
+	}
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	if !cache.Contains("a") {
+		t.Error("Expected a to be cached")
+	}
+
+	// a is still the least recently used, so it is evicted next
+	cache.Set("c", 3)
+
+	if cache.Contains("a") {
+		t.Error("a should have been evicted since Contains doesn't refresh recency")
+	}
+	if !cache.Contains("b") || !cache.Contains("c") {
+		t.Errorf("Expected b and c to be cached, got %v", cache.Keys())
+	}
+	if stats := cache.Stats(); stats.Hits != 0 || stats.Misses != 0 {
+		t.Errorf("Contains should not be counted, got %+v", stats)
+	}
+
+	cache.SetWithTTL("d", 4, time.Second)
+	now = now.Add(time.Second)
+
+	if cache.Contains("d") {
+		t.Error("Contains should not report an expired item")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Resize
+// ----------------------------------------------------------------------------
+
+func TestLRUCache_Resize_Shrink(t *testing.T) {
+	cache := NewLRUCache[int, int](5)
+	var evicted []int
+	cache.OnEvict(func(key int, _ int, reason EvictReason) {
+		if reason == Capacity {
+			evicted = append(evicted, key)
+		}
+	})
+
+	for i := 0; i < 5; i++ {
+		cache.Set(i, i)
+	}
+	// Access 1, 4 and 3 so that 0 and 2 are the least recently used
+	cache.Get(1)
+	cache.Get(4)
+	cache.Get(3)
+
+	cache.Resize(3)
+
+	if keys := cache.Keys(); !slices.Equal(keys, []int{3, 4, 1}) {
+		t.Errorf("Expected survivors %v, got %v", []int{3, 4, 1}, keys)
+	}
+	if len(evicted) != 2 {
+		t.Errorf("Expected 2 Capacity evictions, got %v", evicted)
+	}
+
+	// The new capacity is enforced on later inserts
+	cache.Set(10, 10)
+	if cache.Len() != 3 {
+		t.Errorf("Expected 3 items after insert, got %d", cache.Len())
+	}
+}
+
+func TestLRUCache_Resize_Grow(t *testing.T) {
+	cache := NewLRUCache[int, int](2)
+
+	cache.Set(1, 1)
+	cache.Set(2, 2)
+	cache.Resize(4)
+	cache.Set(3, 3)
+	cache.Set(4, 4)
+
+	if cache.Len() != 4 {
+		t.Errorf("Expected 4 items after growing, got %d", cache.Len())
+	}
+
+	cache.Resize(0)
+	for i := 5; i < 20; i++ {
+		cache.Set(i, i)
+	}
+	if cache.Len() != 19 {
+		t.Errorf("Expected unlimited capacity after Resize(0), got %d items", cache.Len())
+	}
+}
+
+func TestLRUCache_Resize_Negative(t *testing.T) {
+	cache := NewLRUCache[int, int](2)
+
+	cache.Set(1, 1)
+	cache.Set(2, 2)
+	cache.Resize(-1)
+	cache.Set(3, 3)
+
+	if cache.Len() != 2 || cache.Contains(1) {
+		t.Errorf("Expected a negative capacity to be ignored, got %v", cache.Keys())
+	}
+}
@@ -0,0 +1,62 @@
+package cache
+
+import "github.com/0x626f/go-kit/utils"
+
+// NopCache is a Cache that stores nothing: Set does nothing, Get always misses and Len is always zero.
+// It lets caching be turned off by configuration without changing call sites,
+// e.g. NewCache returns one for a negative capacity.
+//
+// NopCache is safe for concurrent use by multiple goroutines.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+type NopCache[K comparable, D any] struct{}
+
+// NewNopCache creates a cache that stores nothing.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Returns:
+//   - A pointer to the NopCache
+//
+// Example:
+//
+//	var sessions cache.Cache[string, *Session] = cache.NewNopCache[string, *Session]()
+//	sessions.Set("id", session)
+//	_, found := sessions.Get("id") // false
+func NewNopCache[K comparable, D any]() *NopCache[K, D] {
+	return &NopCache[K, D]{}
+}
+
+// Set discards the value.
+func (cache *NopCache[K, D]) Set(key K, item D) {}
+
+// Get always misses.
+//
+// Returns:
+//   - A zero value and false
+func (cache *NopCache[K, D]) Get(key K) (D, bool) {
+	return utils.Zero[D](), false
+}
+
+// Delete does nothing.
+//
+// Returns:
+//   - false, as the cache never holds the key
+func (cache *NopCache[K, D]) Delete(key K) bool {
+	return false
+}
+
+// Clear does nothing.
+func (cache *NopCache[K, D]) Clear() {}
+
+// Flush does nothing.
+func (cache *NopCache[K, D]) Flush() {}
+
+// Len always returns 0.
+func (cache *NopCache[K, D]) Len() int {
+	return 0
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+)
+
+// ============================================================================
+// Nop Cache
+// ============================================================================
+
+var _ Cache[string, int] = (*NopCache[string, int])(nil)
+
+func TestNopCache_Operations(t *testing.T) {
+	cache := NewNopCache[string, int]()
+
+	cache.Set("key", 42)
+	if val, exists := cache.Get("key"); exists || val != 0 {
+		t.Errorf("Get should always miss, got %d, %v", val, exists)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Len should always be 0, got %d", cache.Len())
+	}
+	if cache.Delete("key") {
+		t.Error("Delete should report the key as absent")
+	}
+
+	cache.Flush()
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Errorf("Len should stay 0, got %d", cache.Len())
+	}
+}
+
+func TestNopCache_Concurrent(t *testing.T) {
+	cache := NewNopCache[int, int]()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				cache.Set(i, worker)
+				cache.Get(i)
+				cache.Delete(i)
+			}
+		}(worker)
+	}
+	wg.Wait()
+}
+
+func TestNewCache_NegativeCapacity(t *testing.T) {
+	for _, policy := range []Policy{PolicyLRU, PolicyLFU, PolicyFIFO} {
+		cache := NewCache[string, int](policy, -1)
+		if _, ok := cache.(*NopCache[string, int]); !ok {
+			t.Errorf("%s: expected a NopCache for a negative capacity, got %T", policy.String(), cache)
+		}
+	}
+
+	loading := NewLoadingCache[string, int](-1, func(key string) (int, error) { return len(key), nil })
+	if val, found, err := loading.Get("key"); err != nil || !found || val != 3 || loading.Len() != 0 {
+		t.Errorf("Expected a loading cache without storage, got %d, %v, %v, len %d", val, found, err, loading.Len())
+	}
+}
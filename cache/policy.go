@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policy selects the eviction strategy of a cache created by NewCache.
+// It implements encoding.TextUnmarshaler, so it can be read from configuration,
+// e.g. a struct field tagged `env:"CACHE_POLICY"` holding "lru", "lfu" or "fifo".
+type Policy uint8
+
+const (
+	// PolicyLRU evicts the least recently used item (LRUCache). It is the zero value.
+	PolicyLRU Policy = iota
+
+	// PolicyLFU evicts the least frequently used items (LFUCache).
+	PolicyLFU
+
+	// PolicyFIFO evicts the oldest inserted item (FIFOCache).
+	PolicyFIFO
+)
+
+// String returns the name of the policy.
+func (policy Policy) String() string {
+	switch policy {
+	case PolicyLRU:
+		return "LRU"
+	case PolicyLFU:
+		return "LFU"
+	case PolicyFIFO:
+		return "FIFO"
+	default:
+		return fmt.Sprintf("Policy(%d)", uint8(policy))
+	}
+}
+
+// ParsePolicy converts a policy name to a Policy. The name is case-insensitive.
+//
+// Parameters:
+//   - name: One of "lru", "lfu" or "fifo"
+//
+// Returns:
+//   - The matching Policy and nil, or PolicyLRU and an error if the name is unknown
+//
+// Example:
+//
+//	policy, err := cache.ParsePolicy("fifo") // PolicyFIFO, nil
+func ParsePolicy(name string) (Policy, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "LRU":
+		return PolicyLRU, nil
+	case "LFU":
+		return PolicyLFU, nil
+	case "FIFO":
+		return PolicyFIFO, nil
+	default:
+		return PolicyLRU, fmt.Errorf("unknown cache policy %q", name)
+	}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// This allows Policy to be parsed from configuration values.
+func (policy *Policy) UnmarshalText(text []byte) error {
+	parsed, err := ParsePolicy(string(text))
+	if err != nil {
+		return err
+	}
+
+	*policy = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (policy Policy) MarshalText() ([]byte, error) {
+	return []byte(policy.String()), nil
+}
+
+// NewCache creates a cache with the eviction strategy selected by policy.
+// Unknown policies fall back to PolicyLRU. A negative capacity disables caching
+// and returns a NopCache, so callers can keep using the cache unconditionally.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - policy: The eviction strategy
+//   - capacity: The capacity passed to the selected cache constructor (negative for a NopCache)
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
+//
+// Returns:
+//   - The newly created cache
+//
+// Example:
+//
+//	type Config struct {
+//	    CachePolicy cache.Policy `env:"CACHE_POLICY" default:"lru"`
+//	}
+//
+//	sessions := cache.NewCache[string, *Session](config.CachePolicy, 1000)
+func NewCache[K comparable, D any](policy Policy, capacity int, opts ...Option) Cache[K, D] {
+	if capacity < 0 {
+		return NewNopCache[K, D]()
+	}
+
+	switch policy {
+	case PolicyLFU:
+		return NewLFUCache[K, D](capacity, opts...)
+	case PolicyFIFO:
+		return NewFIFOCache[K, D](capacity, opts...)
+	default:
+		return NewLRUCache[K, D](capacity, opts...)
+	}
+}
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"testing"
+)
+
+// ============================================================================
+// Policy Selection
+// ============================================================================
+
+var (
+	_ Cache[string, int] = (*LRUCache[string, int])(nil)
+	_ Cache[string, int] = (*LFUCache[string, int])(nil)
+	_ Cache[string, int] = (*FIFOCache[string, int])(nil)
+)
+
+func TestNewCache_Policies(t *testing.T) {
+	if _, ok := NewCache[string, int](PolicyLRU, 10).(*LRUCache[string, int]); !ok {
+		t.Error("PolicyLRU should create an LRUCache")
+	}
+	if _, ok := NewCache[string, int](PolicyLFU, 10).(*LFUCache[string, int]); !ok {
+		t.Error("PolicyLFU should create an LFUCache")
+	}
+	if _, ok := NewCache[string, int](PolicyFIFO, 10).(*FIFOCache[string, int]); !ok {
+		t.Error("PolicyFIFO should create a FIFOCache")
+	}
+	if _, ok := NewCache[string, int](Policy(42), 10).(*LRUCache[string, int]); !ok {
+		t.Error("Unknown policies should fall back to an LRUCache")
+	}
+}
+
+func TestNewCache_Interface(t *testing.T) {
+	for _, policy := range []Policy{PolicyLRU, PolicyLFU, PolicyFIFO} {
+		t.Run(policy.String(), func(t *testing.T) {
+			cache := NewCache[string, int](policy, 10)
+
+			cache.Set("a", 1)
+			cache.Set("b", 2)
+			if val, exists := cache.Get("a"); !exists || val != 1 {
+				t.Errorf("Expected a=1, got %d, %v", val, exists)
+			}
+			if cache.Len() != 2 {
+				t.Errorf("Expected Len 2, got %d", cache.Len())
+			}
+
+			cache.Delete("a")
+			if cache.Len() != 1 {
+				t.Errorf("Expected Len 1 after delete, got %d", cache.Len())
+			}
+
+			cache.Clear()
+			if cache.Len() != 0 {
+				t.Errorf("Expected Len 0 after clear, got %d", cache.Len())
+			}
+		})
+	}
+}
+
+func TestNewCache_Capacity(t *testing.T) {
+	for _, policy := range []Policy{PolicyLRU, PolicyLFU, PolicyFIFO} {
+		t.Run(policy.String(), func(t *testing.T) {
+			cache := NewCache[string, int](policy, 2)
+
+			for i, key := range []string{"a", "b", "c", "d", "e", "f"} {
+				cache.Set(key, i)
+			}
+
+			if cache.Len() != 2 {
+				t.Errorf("Expected Len 2 after 6 sets, got %d", cache.Len())
+			}
+			if cache.Delete("missing") {
+				t.Error("Delete should return false for a missing key")
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Policy
+		fails    bool
+	}{
+		{"lru", PolicyLRU, false},
+		{"LFU", PolicyLFU, false},
+		{" Fifo ", PolicyFIFO, false},
+		{"random", PolicyLRU, true},
+		{"", PolicyLRU, true},
+	}
+
+	for _, test := range tests {
+		policy, err := ParsePolicy(test.name)
+		if (err != nil) != test.fails {
+			t.Errorf("ParsePolicy(%q) error = %v, expected failure %v", test.name, err, test.fails)
+		}
+		if policy != test.expected {
+			t.Errorf("ParsePolicy(%q) = %v, expected %v", test.name, policy, test.expected)
+		}
+	}
+}
+
+func TestPolicy_Text(t *testing.T) {
+	var policy Policy
+	if err := policy.UnmarshalText([]byte("fifo")); err != nil || policy != PolicyFIFO {
+		t.Errorf("Expected PolicyFIFO, got %v, %v", policy, err)
+	}
+	if err := policy.UnmarshalText([]byte("unknown")); err == nil || policy != PolicyFIFO {
+		t.Errorf("Invalid text should fail and keep the policy, got %v, %v", policy, err)
+	}
+
+	text, _ := PolicyLFU.MarshalText()
+	if string(text) != "LFU" {
+		t.Errorf("Expected LFU, got %s", text)
+	}
+	if Policy(9).String() != "Policy(9)" {
+		t.Errorf("Unexpected name for unknown policy: %s", Policy(9).String())
+	}
+}
@@ -0,0 +1,244 @@
+package cache
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"runtime"
+)
+
+// Hasher maps a key to a 64-bit hash used to pick the shard of a ShardedCache.
+//
+// Type parameters:
+//   - K: The type of keys
+type Hasher[K comparable] func(key K) uint64
+
+// ShardedCache spreads keys over independent sub-caches, each with its own lock,
+// so concurrent operations on different keys rarely contend.
+// Each shard enforces its capacity on its own, so the eviction policy is applied per shard.
+//
+// ShardedCache implements Cache and is safe for concurrent use by multiple goroutines.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+type ShardedCache[K comparable, D any] struct {
+	// shards are the independent sub-caches
+	shards []Cache[K, D]
+	// hasher picks the shard of a key
+	hasher Hasher[K]
+}
+
+// NewShardedCache creates a sharded cache hashing keys with FNV-1a (see DefaultHasher).
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - policy: The eviction strategy of every shard
+//   - shards: The number of shards; 0 or less uses runtime.GOMAXPROCS(0)
+//   - capacityPerShard: The capacity passed to every shard
+//   - opts: Optional settings applied to every shard, such as WithDefaultTTL
+//
+// Returns:
+//   - A pointer to the newly created ShardedCache
+//
+// Example:
+//
+//	sessions := cache.NewShardedCache[string, *Session](cache.PolicyLRU, 16, 1000)
+//	sessions.Set("id", session)
+func NewShardedCache[K comparable, D any](policy Policy, shards, capacityPerShard int, opts ...Option) *ShardedCache[K, D] {
+	return NewShardedCacheWithHasher[K, D](DefaultHasher[K], policy, shards, capacityPerShard, opts...)
+}
+
+// NewShardedCacheWithHasher creates a sharded cache picking shards with a custom hash function.
+// A nil hasher uses DefaultHasher.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - hasher: The function hashing keys
+//   - policy: The eviction strategy of every shard
+//   - shards: The number of shards; 0 or less uses runtime.GOMAXPROCS(0)
+//   - capacityPerShard: The capacity passed to every shard
+//   - opts: Optional settings applied to every shard, such as WithDefaultTTL
+//
+// Returns:
+//   - A pointer to the newly created ShardedCache
+//
+// Example:
+//
+//	byID := cache.NewShardedCacheWithHasher[uint64, *User](
+//	    func(id uint64) uint64 { return id },
+//	    cache.PolicyLFU, 8, 1000,
+//	)
+func NewShardedCacheWithHasher[K comparable, D any](hasher Hasher[K], policy Policy, shards, capacityPerShard int, opts ...Option) *ShardedCache[K, D] {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+
+	if hasher == nil {
+		hasher = DefaultHasher[K]
+	}
+
+	cache := &ShardedCache[K, D]{
+		shards: make([]Cache[K, D], shards),
+		hasher: hasher,
+	}
+
+	for i := range cache.shards {
+		cache.shards[i] = NewCache[K, D](policy, capacityPerShard, opts...)
+	}
+
+	return cache
+}
+
+// shard returns the sub-cache responsible for key.
+func (cache *ShardedCache[K, D]) shard(key K) Cache[K, D] {
+	return cache.shards[cache.hasher(key)%uint64(len(cache.shards))]
+}
+
+// Set stores a value in the shard responsible for key.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+func (cache *ShardedCache[K, D]) Set(key K, item D) {
+	cache.shard(key).Set(key, item)
+}
+
+// Get retrieves a value from the shard responsible for key.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found
+//   - A zero value and false if the key is not in the cache
+func (cache *ShardedCache[K, D]) Get(key K) (D, bool) {
+	return cache.shard(key).Get(key)
+}
+
+// Delete removes a value from the shard responsible for key.
+//
+// Parameters:
+//   - key: The key of the item to remove
+//
+// Returns:
+//   - The result of Delete on the shard
+func (cache *ShardedCache[K, D]) Delete(key K) bool {
+	return cache.shard(key).Delete(key)
+}
+
+// Clear removes all items from every shard.
+// Shards are cleared one after another, so the operation isn't atomic across shards.
+func (cache *ShardedCache[K, D]) Clear() {
+	for _, shard := range cache.shards {
+		shard.Clear()
+	}
+}
+
+// Flush enforces the capacity of every shard.
+func (cache *ShardedCache[K, D]) Flush() {
+	for _, shard := range cache.shards {
+		shard.Flush()
+	}
+}
+
+// Len returns the total number of items in all shards, not counting expired items.
+func (cache *ShardedCache[K, D]) Len() int {
+	length := 0
+	for _, shard := range cache.shards {
+		length += shard.Len()
+	}
+	return length
+}
+
+// Shards returns the number of shards.
+func (cache *ShardedCache[K, D]) Shards() int {
+	return len(cache.shards)
+}
+
+// DefaultHasher hashes a key with 64-bit FNV-1a over its string or byte form.
+// Strings and integers are hashed without allocating; other keys use their fmt.Stringer
+// implementation or, failing that, their fmt.Sprint representation.
+//
+// Type parameters:
+//   - K: The type of keys
+//
+// Parameters:
+//   - key: The key to hash
+//
+// Returns:
+//   - The 64-bit hash of key
+func DefaultHasher[K comparable](key K) uint64 {
+	var buffer [8]byte
+
+	switch value := any(&key).(type) {
+	case *string:
+		return fnvString(*value)
+	case *int:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *int8:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *int16:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *int32:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *int64:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *uint:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *uint8:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *uint16:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *uint32:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *uint64:
+		binary.LittleEndian.PutUint64(buffer[:], *value)
+	case *uintptr:
+		binary.LittleEndian.PutUint64(buffer[:], uint64(*value))
+	case *float32:
+		binary.LittleEndian.PutUint64(buffer[:], math.Float64bits(float64(*value)))
+	case *float64:
+		binary.LittleEndian.PutUint64(buffer[:], math.Float64bits(*value))
+	default:
+		if stringer, ok := any(key).(fmt.Stringer); ok {
+			return fnvString(stringer.String())
+		}
+		return fnvString(fmt.Sprint(key))
+	}
+
+	return fnvBytes(buffer[:])
+}
+
+const (
+	// fnvOffset is the 64-bit FNV offset basis
+	fnvOffset uint64 = 14695981039346656037
+	// fnvPrime is the 64-bit FNV prime
+	fnvPrime uint64 = 1099511628211
+)
+
+// fnvString hashes a string with 64-bit FNV-1a.
+func fnvString(value string) uint64 {
+	hash := fnvOffset
+	for i := 0; i < len(value); i++ {
+		hash ^= uint64(value[i])
+		hash *= fnvPrime
+	}
+	return hash
+}
+
+// fnvBytes hashes a byte slice with 64-bit FNV-1a.
+func fnvBytes(value []byte) uint64 {
+	hash := fnvOffset
+	for _, b := range value {
+		hash ^= uint64(b)
+		hash *= fnvPrime
+	}
+	return hash
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// benchmarkKeys is the number of distinct keys used by the parallel benchmarks.
+const benchmarkKeys = 1 << 14
+
+// benchmarkParallel runs a mixed read/write workload (one Set per four Gets)
+// over GOMAXPROCS goroutines.
+func benchmarkParallel(b *testing.B, cache Cache[string, int]) {
+	keys := make([]string, benchmarkKeys)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		cache.Set(keys[i], i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var seed atomic.Int64
+	b.RunParallel(func(pb *testing.PB) {
+		// Start every goroutine at a different key so they don't walk the shards in lockstep.
+		i := int(seed.Add(1)) * (benchmarkKeys / 64)
+		for pb.Next() {
+			key := keys[(i*7919)&(benchmarkKeys-1)]
+			if i%5 == 0 {
+				cache.Set(key, i)
+			} else {
+				cache.Get(key)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkCache_Parallel_SingleLock(b *testing.B) {
+	benchmarkParallel(b, NewLRUCache[string, int](benchmarkKeys))
+}
+
+func BenchmarkCache_Parallel_Sharded(b *testing.B) {
+	benchmarkParallel(b, NewShardedCache[string, int](PolicyLRU, 16, benchmarkKeys/16))
+}
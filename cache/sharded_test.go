@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// ============================================================================
+// Sharded Cache
+// ============================================================================
+
+var _ Cache[string, int] = (*ShardedCache[string, int])(nil)
+
+func TestShardedCache_Basic(t *testing.T) {
+	for _, policy := range []Policy{PolicyLRU, PolicyLFU, PolicyFIFO} {
+		t.Run(policy.String(), func(t *testing.T) {
+			cache := NewShardedCache[string, int](policy, 4, 100)
+
+			for i := 0; i < 50; i++ {
+				cache.Set(strconv.Itoa(i), i)
+			}
+			if cache.Len() != 50 {
+				t.Errorf("Expected 50 items, got %d", cache.Len())
+			}
+
+			for i := 0; i < 50; i++ {
+				if val, exists := cache.Get(strconv.Itoa(i)); !exists || val != i {
+					t.Errorf("Expected %d=%d, got %d, %v", i, i, val, exists)
+				}
+			}
+
+			cache.Delete("7")
+			if _, exists := cache.Get("7"); exists {
+				t.Error("Deleted key should not exist")
+			}
+
+			cache.Clear()
+			if cache.Len() != 0 {
+				t.Errorf("Expected empty cache after Clear, got %d", cache.Len())
+			}
+		})
+	}
+}
+
+func TestShardedCache_DefaultShards(t *testing.T) {
+	cache := NewShardedCache[string, int](PolicyLRU, 0, 10)
+	if cache.Shards() < 1 {
+		t.Errorf("Expected at least one shard, got %d", cache.Shards())
+	}
+}
+
+func TestShardedCache_CapacityPerShard(t *testing.T) {
+	// Route every key to shard (key % 2) to make capacity per shard observable.
+	cache := NewShardedCacheWithHasher[int, int](func(key int) uint64 { return uint64(key) }, PolicyLRU, 2, 2)
+
+	for i := 0; i < 10; i++ {
+		cache.Set(i, i)
+	}
+
+	if cache.Len() != 4 {
+		t.Errorf("Expected 2 items per shard, got %d in total", cache.Len())
+	}
+	for _, key := range []int{6, 7, 8, 9} {
+		if _, exists := cache.Get(key); !exists {
+			t.Errorf("Expected recent key %d to survive", key)
+		}
+	}
+}
+
+func TestShardedCache_NilHasher(t *testing.T) {
+	cache := NewShardedCacheWithHasher[string, int](nil, PolicyLRU, 4, 10)
+	cache.Set("a", 1)
+	if val, exists := cache.Get("a"); !exists || val != 1 {
+		t.Errorf("Expected a=1, got %d, %v", val, exists)
+	}
+}
+
+func TestShardedCache_Concurrent(t *testing.T) {
+	cache := NewShardedCache[int, int](PolicyLRU, 8, 1000)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := g*1000 + i
+				cache.Set(key, key)
+				cache.Get(key)
+				if i%10 == 0 {
+					cache.Delete(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if cache.Len() != 8*900 {
+		t.Errorf("Expected %d items, got %d", 8*900, cache.Len())
+	}
+}
+
+type stringerKey struct{ id int }
+
+func (key stringerKey) String() string { return strconv.Itoa(key.id) }
+
+func TestDefaultHasher(t *testing.T) {
+	if DefaultHasher("abc") != DefaultHasher("abc") {
+		t.Error("Equal strings should hash equally")
+	}
+	if DefaultHasher("abc") == DefaultHasher("abd") {
+		t.Error("Different strings should hash differently")
+	}
+	// 64-bit FNV-1a of the empty string is the offset basis.
+	if DefaultHasher("") != 14695981039346656037 {
+		t.Errorf("Unexpected hash of empty string: %d", DefaultHasher(""))
+	}
+	// 64-bit FNV-1a test vector for "a".
+	if DefaultHasher("a") != 0xaf63dc4c8601ec8c {
+		t.Errorf("Unexpected hash of \"a\": %x", DefaultHasher("a"))
+	}
+	if DefaultHasher(1) == DefaultHasher(2) {
+		t.Error("Different integers should hash differently")
+	}
+	if DefaultHasher(stringerKey{1}) != DefaultHasher("1") {
+		t.Error("Stringer keys should hash their string form")
+	}
+	if DefaultHasher([2]int{1, 2}) != DefaultHasher("[1 2]") {
+		t.Error("Other keys should hash their fmt.Sprint form")
+	}
+}
@@ -0,0 +1,584 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/0x626f/go-kit/abstract"
+	"github.com/0x626f/go-kit/linkedlist"
+	"github.com/0x626f/go-kit/utils"
+)
+
+// defaultProtectedRatio is the share of the capacity given to the protected segment
+// of a SegmentedLRUCache created with a ratio outside (0, 1).
+const defaultProtectedRatio = 0.8
+
+// segmentedEntry is an item of a SegmentedLRUCache along with the segment holding it.
+type segmentedEntry[K comparable, D any] struct {
+	key   K
+	value D
+	// protected is set while the item is in the protected segment
+	protected bool
+}
+
+// SegmentedLRUCache implements a Segmented LRU (SLRU) cache eviction policy.
+// Items are split between two segments, each kept in access order by a linked list:
+// new items enter the probationary segment, and a second access promotes an item to the protected
+// segment. When the protected segment is full, its least recently used item is demoted back to the front
+// of the probationary segment. When the cache is full, the least recently used probationary item is evicted.
+//
+// Unlike LRUCache, a scan over many items accessed once (e.g. a batch job reading every key)
+// only cycles through the probationary segment and doesn't evict the frequently accessed items.
+//
+// Items may expire after a time to live, set per item with SetWithTTL or for all items
+// with the WithDefaultTTL option. Expired items are treated as absent and evicted lazily
+// on access, or proactively by the janitor started with StartJanitor.
+//
+// SegmentedLRUCache is safe for concurrent use by multiple goroutines.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Time complexity:
+//   - Set: O(1)
+//   - Get: O(1)
+//   - Delete: O(1)
+type SegmentedLRUCache[K comparable, D any] struct {
+	// mutex guards all cache state; Get moves items between segments, so reads lock exclusively too
+	mutex sync.Mutex
+
+	// capacity is the maximum number of items the cache can hold
+	// A capacity of 0 means unlimited
+	capacity int
+
+	// protectedRatio is the share of the capacity reserved for the protected segment
+	protectedRatio float64
+
+	// protectedCapacity is the maximum number of items in the protected segment
+	protectedCapacity int
+
+	// probation holds the items accessed once, most recently used at the front
+	probation *linkedlist.LinkedList[*segmentedEntry[K, D]]
+
+	// protected holds the items accessed more than once, most recently used at the front
+	protected *linkedlist.LinkedList[*segmentedEntry[K, D]]
+
+	// data maps keys to their nodes in either segment
+	data PrimaryCache[K, *linkedlist.LinkedNode[*segmentedEntry[K, D]]]
+
+	// expiry tracks the expiration deadlines of items
+	expiry expiry[K]
+
+	// janitor periodically evicts expired items once started
+	janitor janitor
+
+	// evictions queues removed items for the eviction callback
+	evictions evictions[K, D]
+
+	// statistics counts hits, misses, inserts, replaces and evictions
+	statistics statistics
+
+	// flights deduplicates concurrent GetOrCompute computations per key
+	flights flightGroup[K, D]
+}
+
+// NewSegmentedLRUCache creates and initializes a new Segmented LRU cache with the specified capacity.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - capacity: Maximum number of items the cache can hold. Use 0 for unlimited capacity.
+//   - protectedRatio: The share of the capacity reserved for the protected segment, in (0, 1);
+//     other values use 0.8. The probationary segment always keeps at least one slot.
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
+//
+// Returns:
+//   - A pointer to the newly created SegmentedLRUCache
+//
+// Example:
+//
+//	cache := cache.NewSegmentedLRUCache[string, int](100, 0.8) // 80 protected, 20 probationary items
+//	cache.Set("user:123", 42)
+//	value, found := cache.Get("user:123") // promotes the item to the protected segment
+func NewSegmentedLRUCache[K comparable, D any](capacity int, protectedRatio float64, opts ...Option) *SegmentedLRUCache[K, D] {
+	if protectedRatio <= 0 || protectedRatio >= 1 {
+		protectedRatio = defaultProtectedRatio
+	}
+
+	return &SegmentedLRUCache[K, D]{
+		capacity:          capacity,
+		protectedRatio:    protectedRatio,
+		protectedCapacity: protectedShare(capacity, protectedRatio),
+		probation:         linkedlist.NewLinkedList[*segmentedEntry[K, D]](),
+		protected:         linkedlist.NewLinkedList[*segmentedEntry[K, D]](),
+		data:              make(map[K]*linkedlist.LinkedNode[*segmentedEntry[K, D]]),
+		expiry:            newExpiry[K](opts),
+	}
+}
+
+// protectedShare returns the size of the protected segment of a cache holding capacity items,
+// leaving at least one slot to the probationary segment.
+//
+// Parameters:
+//   - capacity: The maximum number of items of the cache
+//   - ratio: The share of the capacity reserved for the protected segment
+//
+// Returns:
+//   - The maximum number of protected items
+func protectedShare(capacity int, ratio float64) int {
+	protected := int(float64(capacity) * ratio)
+	if capacity > 0 {
+		protected = min(protected, capacity-1)
+	}
+	return protected
+}
+
+// Set adds or updates an item in the cache.
+// A new item enters the probationary segment; updating an existing item counts as an access.
+// If the cache is at capacity, the least recently used probationary item is evicted to make room.
+// The item expires after the default TTL, if one was configured with WithDefaultTTL.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) Set(key K, item D) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, cache.expiry.defaultTTL)
+}
+
+// SetWithTTL adds or updates an item in the cache like Set, with its own time to live.
+// A ttl of 0 or less means the item never expires.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - ttl: The time after which the item expires
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	cache := cache.NewSegmentedLRUCache[string, string](100, 0.8)
+//	cache.SetWithTTL("token", "abc", time.Minute)
+func (cache *SegmentedLRUCache[K, D]) SetWithTTL(key K, item D, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, ttl)
+}
+
+// set is an internal method that stores an item and its deadline, evicting the least
+// recently used probationary item when the cache is over capacity. The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) set(key K, item D, ttl time.Duration) {
+	cache.expiry.track(key, ttl)
+
+	if node, exists := cache.data[key]; exists {
+		cache.evict(key, node.Data.value, Replaced)
+		node.Data.value = item
+		cache.touch(node)
+		return
+	}
+
+	cache.data[key] = cache.probation.InsertFront(&segmentedEntry[K, D]{key: key, value: item})
+	cache.statistics.inserts.Add(1)
+
+	cache.flush()
+}
+
+// touch is an internal method that records an access to the item stored in node:
+// a probationary item is promoted to the protected segment, demoting the least recently used
+// protected item if the segment is full, and a protected item becomes its most recently used one.
+// The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) touch(node *linkedlist.LinkedNode[*segmentedEntry[K, D]]) {
+	entry := node.Data
+
+	if entry.protected {
+		cache.protected.MoveToFront(node)
+		return
+	}
+
+	if cache.capacity != 0 && cache.protectedCapacity == 0 {
+		cache.probation.MoveToFront(node)
+		return
+	}
+
+	cache.probation.Remove(node)
+	entry.protected = true
+	cache.data[entry.key] = cache.protected.InsertFront(entry)
+
+	if cache.capacity != 0 && cache.protected.Size() > cache.protectedCapacity {
+		demoted := cache.protected.PopRight()
+		demoted.protected = false
+		cache.data[demoted.key] = cache.probation.InsertFront(demoted)
+	}
+}
+
+// segment returns the list holding entry.
+func (cache *SegmentedLRUCache[K, D]) segment(entry *segmentedEntry[K, D]) *linkedlist.LinkedList[*segmentedEntry[K, D]] {
+	if entry.protected {
+		return cache.protected
+	}
+	return cache.probation
+}
+
+// remove is an internal method that evicts the item stored in node for the given reason.
+// The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) remove(key K, node *linkedlist.LinkedNode[*segmentedEntry[K, D]], reason EvictReason) {
+	entry := node.Data
+
+	cache.segment(entry).Remove(node)
+	delete(cache.data, key)
+	cache.expiry.forget(key)
+	cache.evict(key, entry.value, reason)
+}
+
+// OnEvict registers callback to be called whenever an item leaves the cache or has its value replaced,
+// replacing any previously registered callback. Pass nil to unregister.
+//
+// The callback is invoked synchronously by the operation that removed the item, after the cache
+// lock has been released, so it may safely call methods of the same cache.
+//
+// Parameters:
+//   - callback: The function receiving the key, the removed value and the reason
+func (cache *SegmentedLRUCache[K, D]) OnEvict(callback EvictCallback[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.evictions.callback = callback
+}
+
+// unlock is an internal method that releases the mutex and then reports
+// the evictions recorded while it was held.
+func (cache *SegmentedLRUCache[K, D]) unlock() {
+	callback, pending := cache.evictions.take()
+	cache.mutex.Unlock()
+
+	notify(callback, pending)
+}
+
+// evict is an internal method that counts an item removed for the given reason
+// and queues it for the eviction callback. The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) evict(key K, item D, reason EvictReason) {
+	cache.statistics.count(reason)
+	cache.evictions.record(key, item, reason)
+}
+
+// Get retrieves an item from the cache by its key.
+// Accessing a probationary item promotes it to the protected segment,
+// accessing a protected item marks it as most recently used.
+// An expired item is evicted and reported as not found.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found
+//   - A zero value and false if the key is not in the cache
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) Get(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.get(key)
+}
+
+// get is an internal method implementing Get. The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) get(key K) (D, bool) {
+	if node, exists := cache.data[key]; exists {
+		if cache.expiry.expired(key) {
+			cache.remove(key, node, Expired)
+			cache.statistics.misses.Add(1)
+			return utils.Zero[D](), false
+		}
+
+		item := node.Data.value
+		cache.touch(node)
+		cache.statistics.hits.Add(1)
+		return item, true
+	}
+
+	cache.statistics.misses.Add(1)
+	return utils.Zero[D](), false
+}
+
+// GetWithExpiry retrieves an item from the cache like Get, along with the moment it expires.
+// Like Get, it counts as an access to the item.
+// Items stored without a time to live never expire and are returned with the zero time.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data, its expiration time (zero if it never expires) and true if found
+//   - A zero value, the zero time and false if the key is not in the cache
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) GetWithExpiry(key K) (D, time.Time, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	item, exists := cache.get(key)
+	if !exists {
+		return item, time.Time{}, false
+	}
+
+	return item, cache.expiry.deadline(key), true
+}
+
+// GetOrCompute returns the cached value for key or, on a miss, calls compute and stores its result.
+// Concurrent callers missing the same key share a single compute call: one caller runs it
+// and the others wait for its result. Errors are returned to all waiting callers and not cached.
+//
+// The compute function runs without holding the cache lock, so it may use the cache.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//   - compute: The function producing the value on a miss
+//
+// Returns:
+//   - The cached or computed value and nil on success
+//   - A zero value and the error returned by compute, or ErrComputePanicked to waiters if it panicked
+func (cache *SegmentedLRUCache[K, D]) GetOrCompute(key K, compute func() (D, error)) (D, error) {
+	if item, exists := cache.Get(key); exists {
+		return item, nil
+	}
+
+	return cache.flights.do(key, func() (D, error) {
+		// A computation that finished just before this one started may have stored the value
+		if item, exists := cache.Peek(key); exists {
+			return item, nil
+		}
+
+		item, err := compute()
+		if err != nil {
+			return utils.Zero[D](), err
+		}
+
+		cache.Set(key, item)
+		return item, nil
+	})
+}
+
+// Peek retrieves an item from the cache without counting as an access.
+// Unlike Get, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found and not expired
+//   - A zero value and false otherwise
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) Peek(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if node, exists := cache.data[key]; exists && !cache.expiry.expired(key) {
+		return node.Data.value, true
+	}
+
+	return utils.Zero[D](), false
+}
+
+// Contains reports whether the cache holds an item for key without counting as an access.
+// Like Peek, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key to look up
+//
+// Returns:
+//   - true if the item is cached and not expired, false otherwise
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) Contains(key K) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	_, exists := cache.data[key]
+	return exists && !cache.expiry.expired(key)
+}
+
+// Delete removes an item from the cache by its key.
+//
+// Parameters:
+//   - key: The key of the item to remove
+//
+// Returns:
+//   - true if the item was found and deleted
+//   - false if the key was not in the cache
+//
+// Time complexity: O(1)
+func (cache *SegmentedLRUCache[K, D]) Delete(key K) bool {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	if node, exists := cache.data[key]; exists {
+		cache.remove(key, node, Deleted)
+		return true
+	}
+	return false
+}
+
+// Flush evicts the least recently used probationary items while the cache exceeds its capacity.
+// Set keeps the cache within capacity, so this is only needed for symmetry with the other caches.
+//
+// Time complexity: O(n) where n is the number of items to remove
+func (cache *SegmentedLRUCache[K, D]) Flush() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.flush()
+}
+
+// flush is an internal method that evicts probationary items beyond capacity.
+// The caller must hold the mutex.
+func (cache *SegmentedLRUCache[K, D]) flush() {
+	for cache.capacity != 0 && len(cache.data) > cache.capacity && cache.probation.Size() > 0 {
+		retired := cache.probation.PopRight()
+		delete(cache.data, retired.key)
+		cache.expiry.forget(retired.key)
+		cache.evict(retired.key, retired.value, Capacity)
+	}
+}
+
+// Resize changes the capacity of the cache, keeping the share of the protected segment.
+// A capacity of 0 means unlimited, like in NewSegmentedLRUCache, and a negative capacity is ignored.
+// When shrinking, the least recently used protected items beyond the new protected capacity are demoted
+// to the probationary segment, then the least recently used probationary items are evicted immediately
+// down to the new capacity, reporting them to the eviction callback with reason Capacity.
+//
+// Parameters:
+//   - capacity: The new maximum number of items, or 0 for unlimited
+//
+// Time complexity: O(k) where k is the number of demoted and evicted items
+//
+// Example:
+//
+//	cache := cache.NewSegmentedLRUCache[string, int](100, 0.8)
+//	cache.Resize(10) // keeps at most 10 items, 8 of them protected
+func (cache *SegmentedLRUCache[K, D]) Resize(capacity int) {
+	if capacity < 0 {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.capacity = capacity
+	cache.protectedCapacity = protectedShare(capacity, cache.protectedRatio)
+
+	for capacity != 0 && cache.protected.Size() > cache.protectedCapacity {
+		demoted := cache.protected.PopRight()
+		demoted.protected = false
+		cache.data[demoted.key] = cache.probation.InsertFront(demoted)
+	}
+
+	cache.flush()
+}
+
+// Clear removes all items from the cache, reporting them to the eviction callback with reason Cleared.
+// The capacity and the segment split remain unchanged.
+//
+// Time complexity: O(n) where n is the number of items in the cache
+func (cache *SegmentedLRUCache[K, D]) Clear() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for _, segment := range []*linkedlist.LinkedList[*segmentedEntry[K, D]]{cache.protected, cache.probation} {
+		for entry := range segment.Values() {
+			cache.evict(entry.key, entry.value, Cleared)
+		}
+		segment.DeleteAll()
+	}
+
+	clear(cache.data)
+	cache.expiry.reset()
+}
+
+// Len returns the number of items in the cache, not counting expired items.
+//
+// Time complexity: O(e) where e is the number of items with a TTL
+func (cache *SegmentedLRUCache[K, D]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return len(cache.data) - cache.expiry.countExpired()
+}
+
+// ForEach calls receiver for every item in the cache until it returns false.
+// Protected items are visited first, then probationary items, each from the most to the least
+// recently used, which is the reverse of the eviction order.
+// Expired items are skipped, and segments are not affected.
+//
+// The receiver is invoked while the cache is locked and must not call methods of the same cache,
+// otherwise it deadlocks.
+//
+// Parameters:
+//   - receiver: A function called with each key and value; return false to stop
+//
+// Time complexity: O(n)
+func (cache *SegmentedLRUCache[K, D]) ForEach(receiver abstract.IndexedReceiver[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for _, segment := range []*linkedlist.LinkedList[*segmentedEntry[K, D]]{cache.protected, cache.probation} {
+		for entry := range segment.Values() {
+			if cache.expiry.expired(entry.key) {
+				continue
+			}
+			if !receiver(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the usage counters of the cache.
+//
+// Returns:
+//   - The counters and the current number of live items
+func (cache *SegmentedLRUCache[K, D]) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.statistics.snapshot(len(cache.data) - cache.expiry.countExpired())
+}
+
+// ResetStats sets all usage counters of the cache to zero.
+func (cache *SegmentedLRUCache[K, D]) ResetStats() {
+	cache.statistics.reset()
+}
+
+// StartJanitor starts a background goroutine that evicts expired items every interval.
+// Calling it again replaces the running janitor; a non-positive interval just stops it.
+// Call StopJanitor when the cache is no longer used, otherwise the goroutine keeps it alive.
+//
+// Parameters:
+//   - interval: The time between two sweeps
+func (cache *SegmentedLRUCache[K, D]) StartJanitor(interval time.Duration) {
+	cache.janitor.start(interval, cache.deleteExpired)
+}
+
+// StopJanitor stops the background goroutine started by StartJanitor, if any.
+func (cache *SegmentedLRUCache[K, D]) StopJanitor() {
+	cache.janitor.halt()
+}
+
+// deleteExpired is an internal method that evicts all expired items.
+func (cache *SegmentedLRUCache[K, D]) deleteExpired() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for _, key := range cache.expiry.collect() {
+		cache.remove(key, cache.data[key], Expired)
+	}
+}
@@ -0,0 +1,301 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// ============================================================================
+// SEGMENTED LRU CACHE
+// ============================================================================
+
+// ----------------------------------------------------------------------------
+// Construction
+// ----------------------------------------------------------------------------
+
+func TestSegmentedLRUCache_NewCache_Ratio(t *testing.T) {
+	tests := []struct {
+		name      string
+		capacity  int
+		ratio     float64
+		protected int
+	}{
+		{"Ratio", 10, 0.5, 5},
+		{"Default", 10, 0, 8},
+		{"OutOfRange", 10, 1.5, 8},
+		{"KeepsProbationarySlot", 2, 0.99, 1},
+		{"CapacityOne", 1, 0.8, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewSegmentedLRUCache[string, int](tt.capacity, tt.ratio)
+			if cache.protectedCapacity != tt.protected {
+				t.Errorf("Expected a protected capacity of %d, got %d", tt.protected, cache.protectedCapacity)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Segments
+// ----------------------------------------------------------------------------
+
+func TestSegmentedLRUCache_SecondHitPromotes(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](4, 0.5)
+
+	cache.Set("a", 1)
+	if cache.data["a"].Data.protected {
+		t.Fatal("Expected a new item to be probationary")
+	}
+
+	if val, exists := cache.Get("a"); !exists || val != 1 {
+		t.Fatalf("Expected a = 1, got %d, %v", val, exists)
+	}
+	if !cache.data["a"].Data.protected || cache.protected.Size() != 1 || cache.probation.Size() != 0 {
+		t.Error("Expected the second access to promote the item to the protected segment")
+	}
+}
+
+func TestSegmentedLRUCache_EvictsProbationaryTail(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](3, 0.5)
+
+	cache.Set("hot", 0)
+	cache.Get("hot")
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	if cache.Contains("a") {
+		t.Error("Expected the least recently used probationary item to be evicted")
+	}
+	for _, key := range []string{"hot", "b", "c"} {
+		if !cache.Contains(key) {
+			t.Errorf("Expected %s to be cached", key)
+		}
+	}
+	if cache.Len() != 3 {
+		t.Errorf("Expected 3 items, got %d", cache.Len())
+	}
+}
+
+func TestSegmentedLRUCache_DemotesProtectedTail(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](4, 0.5)
+
+	for _, key := range []string{"a", "b", "c"} {
+		cache.Set(key, 0)
+		cache.Get(key)
+	}
+
+	if cache.protected.Size() != 2 || cache.data["a"].Data.protected {
+		t.Fatal("Expected the least recently used protected item to be demoted")
+	}
+	if cache.probation.Size() != 1 || !cache.Contains("a") {
+		t.Fatal("Expected the demoted item to stay cached in the probationary segment")
+	}
+
+	// the demoted item gets a second chance before newer probationary items
+	cache.Set("d", 0)
+	cache.Set("e", 0)
+	if cache.Contains("a") {
+		t.Error("Expected the demoted item to be evicted first once it is the probationary tail")
+	}
+	if !cache.Contains("d") || !cache.Contains("e") {
+		t.Error("Expected the new items to be cached")
+	}
+}
+
+func TestSegmentedLRUCache_Set_ExistingCountsAsAccess(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](4, 0.5)
+
+	cache.Set("a", 1)
+	cache.Set("a", 2)
+
+	if val, _ := cache.Peek("a"); val != 2 {
+		t.Errorf("Expected the updated value 2, got %d", val)
+	}
+	if !cache.data["a"].Data.protected {
+		t.Error("Expected an update to promote the item")
+	}
+}
+
+func TestSegmentedLRUCache_CapacityOne(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](1, 0.8)
+
+	cache.Set("a", 1)
+	cache.Get("a")
+	cache.Set("b", 2)
+
+	if cache.Contains("a") || !cache.Contains("b") || cache.Len() != 1 {
+		t.Error("Expected a single probationary slot holding the latest item")
+	}
+}
+
+func TestSegmentedLRUCache_ZeroCapacity(t *testing.T) {
+	cache := NewSegmentedLRUCache[int, int](0, 0.8)
+
+	for i := 0; i < 100; i++ {
+		cache.Set(i, i)
+		cache.Get(i)
+	}
+
+	if cache.Len() != 100 {
+		t.Errorf("Expected unlimited capacity, got %d items", cache.Len())
+	}
+}
+
+func TestSegmentedLRUCache_Resize(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](10, 0.5)
+
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, reason EvictReason) {
+		if reason == Capacity {
+			evicted = append(evicted, key)
+		}
+	})
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		cache.Set(key, 0)
+		cache.Get(key)
+	}
+	cache.Set("e", 0)
+	cache.Set("f", 0)
+
+	// 2 protected slots: a and b are demoted, then the probationary tail is evicted down to 4 items
+	cache.Resize(4)
+
+	if cache.protected.Size() != 2 || !cache.data["c"].Data.protected || !cache.data["d"].Data.protected {
+		t.Errorf("Expected c and d to stay protected, got %d protected items", cache.protected.Size())
+	}
+	if !slices.Equal(evicted, []string{"e", "f"}) || !cache.Contains("a") || !cache.Contains("b") {
+		t.Errorf("Expected the probationary items e and f to be evicted before the demoted ones, got %v", evicted)
+	}
+
+	cache.Resize(-1)
+	cache.Set("g", 0)
+	if cache.Len() != 4 {
+		t.Errorf("Expected a negative capacity to be ignored, got %d items", cache.Len())
+	}
+
+	cache.Resize(0)
+	for i := 0; i < 20; i++ {
+		cache.Set(fmt.Sprint(i), i)
+	}
+	if cache.Len() != 24 {
+		t.Errorf("Expected unlimited capacity after Resize(0), got %d items", cache.Len())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Scan Resistance
+// ----------------------------------------------------------------------------
+
+func TestSegmentedLRUCache_ScanResistance(t *testing.T) {
+	const capacity = 10
+	hot := []string{"h0", "h1", "h2", "h3", "h4", "h5", "h6"}
+
+	lru := NewLRUCache[string, int](capacity)
+	slru := NewSegmentedLRUCache[string, int](capacity, 0.8)
+
+	for _, cache := range []Cache[string, int]{lru, slru} {
+		// the working set is accessed repeatedly
+		for round := 0; round < 3; round++ {
+			for _, key := range hot {
+				if _, exists := cache.Get(key); !exists {
+					cache.Set(key, round)
+				}
+			}
+		}
+
+		// a scan reads many keys once
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("scan-%d", i)
+			if _, exists := cache.Get(key); !exists {
+				cache.Set(key, i)
+			}
+		}
+	}
+
+	for _, key := range hot {
+		if lru.Contains(key) {
+			t.Errorf("Expected the plain LRU to lose %s to the scan", key)
+		}
+		if !slru.Contains(key) {
+			t.Errorf("Expected the segmented LRU to keep %s through the scan", key)
+		}
+	}
+
+	stats := slru.Stats()
+	if stats.Len != capacity {
+		t.Errorf("Expected %d items, got %d", capacity, stats.Len)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Common Operations
+// ----------------------------------------------------------------------------
+
+func TestSegmentedLRUCache_DeleteAndClear(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](4, 0.5)
+
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, reason EvictReason) {
+		evicted = append(evicted, fmt.Sprintf("%s:%v", key, reason))
+	})
+
+	cache.Set("a", 1)
+	cache.Get("a")
+	cache.Set("b", 2)
+
+	if !cache.Delete("a") || cache.Delete("a") {
+		t.Error("Expected Delete to report the removal once")
+	}
+
+	cache.Set("c", 3)
+	cache.Clear()
+
+	if cache.Len() != 0 || cache.protected.Size() != 0 || cache.probation.Size() != 0 {
+		t.Error("Expected Clear to empty both segments")
+	}
+
+	expected := []string{
+		fmt.Sprintf("a:%v", Deleted),
+		fmt.Sprintf("c:%v", Cleared),
+		fmt.Sprintf("b:%v", Cleared),
+	}
+	if !slices.Equal(evicted, expected) {
+		t.Errorf("Expected evictions %v, got %v", expected, evicted)
+	}
+}
+
+func TestSegmentedLRUCache_ForEach(t *testing.T) {
+	cache := NewSegmentedLRUCache[string, int](4, 0.5)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Get("a")
+	cache.Set("c", 3)
+
+	var keys []string
+	cache.ForEach(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	if expected := []string{"a", "c", "b"}; !slices.Equal(keys, expected) {
+		t.Errorf("Expected protected then probationary items %v, got %v", expected, keys)
+	}
+}
+
+func TestSegmentedLRUCache_ImplementsCache(t *testing.T) {
+	var cache Cache[string, int] = NewSegmentedLRUCache[string, int](2, 0.5)
+
+	value, err := cache.(*SegmentedLRUCache[string, int]).GetOrCompute("a", func() (int, error) { return 7, nil })
+	if err != nil || value != 7 {
+		t.Fatalf("Expected GetOrCompute to store 7, got %d, %v", value, err)
+	}
+	if val, exists := cache.Get("a"); !exists || val != 7 {
+		t.Errorf("Expected the computed value, got %d, %v", val, exists)
+	}
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Entry is a cached item exported by Snapshot and loaded back by Restore,
+// for example to warm up a cache after a restart.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+type Entry[K comparable, D any] struct {
+	// Key is the key of the item
+	Key K `json:"key"`
+	// Value is the cached data
+	Value D `json:"value"`
+	// Frequency is the access frequency of the item in an LFU cache, or 0 for other caches
+	Frequency uint `json:"frequency,omitempty"`
+	// ExpiresAt is the moment the item expires, or the zero time if it never expires
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// expired reports whether the entry has a deadline that isn't after now.
+func (entry Entry[K, D]) expired(now time.Time) bool {
+	return !entry.ExpiresAt.IsZero() && !now.Before(entry.ExpiresAt)
+}
+
+// sortByFrequency orders entries from the most to the least frequently used,
+// keeping the relative order of entries with the same frequency.
+func sortByFrequency[K comparable, D any](entries []Entry[K, D]) {
+	slices.SortStableFunc(entries, func(a, b Entry[K, D]) int {
+		return cmp.Compare(b.Frequency, a.Frequency)
+	})
+}
+
+// MarshalEntries encodes a snapshot as a JSON array.
+// Keys and values are encoded with encoding/json, so they must be serializable.
+//
+// Parameters:
+//   - entries: The snapshot returned by Snapshot
+//
+// Returns:
+//   - The JSON encoding of the entries
+//   - An error if a key or value can't be encoded
+//
+// Example:
+//
+//	data, err := cache.MarshalEntries(lru.Snapshot())
+//	if err == nil {
+//	    err = os.WriteFile("cache.json", data, 0o600)
+//	}
+func MarshalEntries[K comparable, D any](entries []Entry[K, D]) ([]byte, error) {
+	if entries == nil {
+		entries = []Entry[K, D]{}
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalEntries decodes a snapshot encoded by MarshalEntries.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - data: The JSON array of entries
+//
+// Returns:
+//   - The decoded entries, ready to be passed to Restore
+//   - An error if the data isn't a valid encoding of entries
+//
+// Example:
+//
+//	data, _ := os.ReadFile("cache.json")
+//	entries, err := cache.UnmarshalEntries[string, int](data)
+//	if err == nil {
+//	    lru.Restore(entries)
+//	}
+func UnmarshalEntries[K comparable, D any](data []byte) ([]Entry[K, D], error) {
+	var entries []Entry[K, D]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
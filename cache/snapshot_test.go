@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Snapshot and Restore
+// ============================================================================
+
+func TestLFUCache_SnapshotRestore_EvictionOrder(t *testing.T) {
+	original := NewLFUCache[string, int](3)
+	original.Set("a", 1)
+	original.Set("b", 2)
+	original.Set("c", 3)
+	for i := 0; i < 2; i++ {
+		original.Get("a")
+	}
+	original.Get("b")
+
+	data, err := MarshalEntries(original.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := UnmarshalEntries[string, int](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry[string, int]{{"a", 1, 3, time.Time{}}, {"b", 2, 2, time.Time{}}, {"c", 3, 1, time.Time{}}}
+	if !slices.Equal(entries, expected) {
+		t.Fatalf("Expected entries %v, got %v", expected, entries)
+	}
+
+	restored := NewLFUCache[string, int](3)
+	restored.Restore(entries)
+
+	recorder := &evictionRecorder{}
+	restored.OnEvict(recorder.callback)
+
+	restored.Resize(2)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"c", 3, Capacity}}) {
+		t.Errorf("Expected the least frequent item to be evicted first, got %v", events)
+	}
+
+	restored.Resize(1)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"b", 2, Capacity}}) {
+		t.Errorf("Expected b to be evicted next, got %v", events)
+	}
+
+	if val, exists := restored.Get("a"); !exists || val != 1 {
+		t.Errorf("Expected a=1 to survive, got %d, %v", val, exists)
+	}
+	if snapshot := restored.Snapshot(); len(snapshot) != 1 || snapshot[0].Frequency != 4 {
+		t.Errorf("Expected a to keep counting from its restored frequency, got %v", snapshot)
+	}
+}
+
+func TestLFUCache_Restore_Capacity(t *testing.T) {
+	cache := NewLFUCache[string, int](2)
+	cache.Set("old", 0)
+
+	recorder := &evictionRecorder{}
+	cache.OnEvict(recorder.callback)
+
+	cache.Restore([]Entry[string, int]{
+		{Key: "low", Value: 1, Frequency: 1},
+		{Key: "high", Value: 5, Frequency: 5},
+		{Key: "mid", Value: 3, Frequency: 3},
+		{Key: "high", Value: 6, Frequency: 6},
+	})
+
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"old", 0, Cleared}}) {
+		t.Errorf("Expected the previous contents to be cleared, got %v", events)
+	}
+
+	keys := cache.Keys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"high", "mid"}) {
+		t.Errorf("Expected the two most frequent buckets, got %v", keys)
+	}
+	if val, _ := cache.Peek("high"); val != 5 {
+		t.Errorf("Expected the first entry of a repeated key to win, got %d", val)
+	}
+}
+
+func TestLRUCache_SnapshotRestore_RecencyOrder(t *testing.T) {
+	original := NewLRUCache[string, int](3)
+	original.Set("a", 1)
+	original.Set("b", 2)
+	original.Set("c", 3)
+	original.Get("a")
+
+	entries := original.Snapshot()
+	if keys := entryKeys(entries); !slices.Equal(keys, []string{"a", "c", "b"}) {
+		t.Fatalf("Expected most recently used first, got %v", keys)
+	}
+
+	restored := NewLRUCache[string, int](3)
+	restored.Restore(entries)
+
+	recorder := &evictionRecorder{}
+	restored.OnEvict(recorder.callback)
+
+	restored.Set("d", 4)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"b", 2, Capacity}}) {
+		t.Errorf("Expected the least recently used item to be evicted, got %v", events)
+	}
+
+	smaller := NewLRUCache[string, int](2)
+	smaller.Restore(entries)
+	if keys := smaller.Keys(); !slices.Equal(keys, []string{"a", "c"}) {
+		t.Errorf("Expected the most recently used items to fit, got %v", keys)
+	}
+}
+
+func TestFIFOCache_SnapshotRestore_InsertionOrder(t *testing.T) {
+	original := NewFIFOCache[string, int](3)
+	original.Set("a", 1)
+	original.Set("b", 2)
+	original.Set("c", 3)
+
+	restored := NewFIFOCache[string, int](3)
+	restored.Restore(original.Snapshot())
+
+	if keys := restored.Keys(); !slices.Equal(keys, []string{"c", "b", "a"}) {
+		t.Errorf("Expected newest first, got %v", keys)
+	}
+
+	recorder := &evictionRecorder{}
+	restored.OnEvict(recorder.callback)
+
+	restored.Set("d", 4)
+	if events := recorder.take(); !slices.Equal(events, []evicted{{"a", 1, Capacity}}) {
+		t.Errorf("Expected the oldest item to be evicted, got %v", events)
+	}
+}
+
+func TestCache_SnapshotRestore_Expiration(t *testing.T) {
+	clock := newFakeClock()
+
+	original := NewLRUCache[string, int](10, WithClock(clock.Now))
+	original.SetWithTTL("short", 1, time.Minute)
+	original.SetWithTTL("long", 2, time.Hour)
+	original.Set("forever", 3)
+
+	entries := original.Snapshot()
+
+	clock.Advance(2 * time.Minute)
+
+	restored := NewLRUCache[string, int](10, WithClock(clock.Now))
+	restored.Restore(entries)
+
+	if _, exists := restored.Get("short"); exists {
+		t.Error("Expected the expired entry to be skipped")
+	}
+	if restored.Len() != 2 {
+		t.Errorf("Expected 2 items, got %d", restored.Len())
+	}
+
+	clock.Advance(time.Hour)
+
+	if _, exists := restored.Get("long"); exists {
+		t.Error("Expected the restored deadline to be kept")
+	}
+	if _, exists := restored.Get("forever"); !exists {
+		t.Error("Expected the entry without a deadline to never expire")
+	}
+}
+
+func TestMarshalEntries_Empty(t *testing.T) {
+	data, err := MarshalEntries[string, int](nil)
+	if err != nil || string(data) != "[]" {
+		t.Errorf("Expected [], got %s, %v", data, err)
+	}
+
+	if _, err := UnmarshalEntries[string, int]([]byte(`{"key": "a"}`)); err == nil {
+		t.Error("Expected an error for an object instead of an array")
+	}
+}
+
+func entryKeys(entries []Entry[string, int]) []string {
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	return keys
+}
@@ -0,0 +1,69 @@
+package cache
+
+import "sync/atomic"
+
+// CacheStats is a snapshot of the usage counters of a cache.
+type CacheStats struct {
+	// Hits is the number of Get calls that found a live item
+	Hits uint64
+	// Misses is the number of Get calls that found no item or an expired one
+	Misses uint64
+	// Inserts is the number of Set calls that stored a new key
+	Inserts uint64
+	// Replaces is the number of Set calls that overwrote an existing key
+	Replaces uint64
+	// Evictions is the number of items removed because of capacity limits or expiration
+	Evictions uint64
+	// Len is the number of live items at the time of the snapshot
+	Len int
+}
+
+// HitRatio returns the fraction of Get calls that were hits, or 0 if Get was never called.
+//
+// Returns:
+//   - A value between 0 and 1
+func (stats CacheStats) HitRatio() float64 {
+	lookups := stats.Hits + stats.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(lookups)
+}
+
+// statistics holds the usage counters of a cache.
+// Counters are atomic, so they can be read without the cache lock.
+type statistics struct {
+	hits, misses, inserts, replaces, evictions atomic.Uint64
+}
+
+// count updates the eviction counter for an item removed for the given reason.
+// Deletions and clears are requested by the caller and are not counted as evictions.
+func (statistics *statistics) count(reason EvictReason) {
+	switch reason {
+	case Capacity, Expired:
+		statistics.evictions.Add(1)
+	case Replaced:
+		statistics.replaces.Add(1)
+	}
+}
+
+// snapshot returns the current counters along with the given number of live items.
+func (statistics *statistics) snapshot(length int) CacheStats {
+	return CacheStats{
+		Hits:      statistics.hits.Load(),
+		Misses:    statistics.misses.Load(),
+		Inserts:   statistics.inserts.Load(),
+		Replaces:  statistics.replaces.Load(),
+		Evictions: statistics.evictions.Load(),
+		Len:       length,
+	}
+}
+
+// reset sets all counters to zero.
+func (statistics *statistics) reset() {
+	statistics.hits.Store(0)
+	statistics.misses.Store(0)
+	statistics.inserts.Store(0)
+	statistics.replaces.Store(0)
+	statistics.evictions.Store(0)
+}
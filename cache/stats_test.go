@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// Statistics
+// ============================================================================
+
+type statsCache interface {
+	evictingCache
+	Stats() CacheStats
+	ResetStats()
+}
+
+func TestCache_Stats_ScriptedSequence(t *testing.T) {
+	clock := newFakeClock()
+
+	caches := map[string]statsCache{
+		"LRU":  NewLRUCache[string, int](2, WithClock(clock.Now)),
+		"LFU":  NewLFUCache[string, int](2, WithClock(clock.Now)),
+		"FIFO": NewFIFOCache[string, int](2, WithClock(clock.Now)),
+	}
+
+	for name, cache := range caches {
+		t.Run(name, func(t *testing.T) {
+			if stats := cache.Stats(); stats != (CacheStats{}) || stats.HitRatio() != 0 {
+				t.Errorf("New cache should have zero stats, got %+v", stats)
+			}
+
+			cache.Set("a", 1)                     // insert
+			cache.Set("a", 2)                     // replace
+			cache.SetWithTTL("b", 3, time.Second) // insert
+			cache.Get("a")                        // hit
+			cache.Get("a")                        // hit
+			cache.Get("missing")                  // miss
+			clock.Advance(time.Second)
+			cache.Get("b")    // miss, expired eviction
+			cache.Delete("a") // deletion, not an eviction
+			cache.Set("c", 4) // insert
+			cache.Clear()     // clear, not an eviction
+			cache.Get("c")    // miss
+
+			expected := CacheStats{Hits: 2, Misses: 3, Inserts: 3, Replaces: 1, Evictions: 1, Len: 0}
+			if stats := cache.Stats(); stats != expected {
+				t.Errorf("Expected %+v, got %+v", expected, stats)
+			}
+			if ratio := cache.Stats().HitRatio(); ratio != 0.4 {
+				t.Errorf("Expected hit ratio 0.4, got %f", ratio)
+			}
+
+			cache.ResetStats()
+			cache.Set("d", 5)
+			if stats := cache.Stats(); stats != (CacheStats{Inserts: 1, Len: 1}) {
+				t.Errorf("Expected only the insert after reset, got %+v", stats)
+			}
+		})
+	}
+}
+
+func TestLRUCache_Stats_CapacityEvictions(t *testing.T) {
+	cache := NewLRUCache[int, int](3)
+
+	for i := 0; i < 10; i++ {
+		cache.Set(i, i)
+	}
+
+	if stats := cache.Stats(); stats.Evictions != 7 || stats.Inserts != 10 || stats.Len != 3 {
+		t.Errorf("Expected 7 evictions, 10 inserts and 3 items, got %+v", stats)
+	}
+}
+
+func TestCache_Stats_Concurrent(t *testing.T) {
+	cache := NewLRUCache[int, int](0)
+	cache.Set(0, 0)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				cache.Get(0)
+				cache.Get(-1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := cache.Stats(); stats.Hits != 8000 || stats.Misses != 8000 || stats.HitRatio() != 0.5 {
+		t.Errorf("Expected 8000 hits and misses, got %+v", stats)
+	}
+}
@@ -0,0 +1,564 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/0x626f/go-kit/abstract"
+	"github.com/0x626f/go-kit/linkedlist"
+	"github.com/0x626f/go-kit/utils"
+)
+
+// weightedEntry is an item of a WeightedLRUCache along with its weight.
+type weightedEntry[K comparable, D any] struct {
+	key    K
+	value  D
+	weight int
+}
+
+// WeightedLRUCache implements a Least Recently Used cache bounded by the total weight of its items
+// instead of their number. Every item has a weight, e.g. its size in bytes, set with SetWithWeight;
+// when the total weight exceeds the capacity, the least recently used items are evicted until it fits.
+// An item heavier than the whole capacity is rejected without evicting anything else: it is reported
+// to the eviction callback with reason Capacity right away, and any item stored under its key is removed.
+//
+// This is the model for memory-bounded caches of variable-length values, like HTTP responses or images.
+//
+// Items may expire after a time to live, set per item with SetWithTTL or for all items
+// with the WithDefaultTTL option. Expired items are treated as absent and evicted lazily
+// on access, or proactively by the janitor started with StartJanitor.
+//
+// WeightedLRUCache is safe for concurrent use by multiple goroutines.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Time complexity:
+//   - Set: O(1) amortized, O(k) where k is the number of evicted items
+//   - Get: O(1)
+//   - Delete: O(1)
+type WeightedLRUCache[K comparable, D any] struct {
+	// mutex guards all cache state; Get reorders the recency list, so reads lock exclusively too
+	mutex sync.Mutex
+
+	// capacity is the maximum total weight of the items
+	// A capacity of 0 means unlimited
+	capacity int
+
+	// weight is the total weight of the items
+	weight int
+
+	// recent is a linked list maintaining items in access order
+	// Most recently accessed items are at the front
+	recent *linkedlist.LinkedList[*weightedEntry[K, D]]
+
+	// data maps keys to their corresponding nodes in the linked list
+	data PrimaryCache[K, *linkedlist.LinkedNode[*weightedEntry[K, D]]]
+
+	// expiry tracks the expiration deadlines of items
+	expiry expiry[K]
+
+	// janitor periodically evicts expired items once started
+	janitor janitor
+
+	// evictions queues removed items for the eviction callback
+	evictions evictions[K, D]
+
+	// statistics counts hits, misses, inserts, replaces and evictions
+	statistics statistics
+
+	// flights deduplicates concurrent GetOrCompute computations per key
+	flights flightGroup[K, D]
+}
+
+// NewWeightedLRUCache creates and initializes a new weighted LRU cache with the specified weight budget.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - D: The type of data stored
+//
+// Parameters:
+//   - capacity: Maximum total weight of the items. Use 0 for unlimited capacity.
+//   - opts: Optional settings such as WithDefaultTTL and WithClock
+//
+// Returns:
+//   - A pointer to the newly created WeightedLRUCache
+//
+// Example:
+//
+//	pages := cache.NewWeightedLRUCache[string, []byte](64 << 20) // at most 64 MiB of pages
+//	pages.SetWithWeight(url, body, len(body))
+func NewWeightedLRUCache[K comparable, D any](capacity int, opts ...Option) *WeightedLRUCache[K, D] {
+	return &WeightedLRUCache[K, D]{
+		capacity: capacity,
+		recent:   linkedlist.NewLinkedList[*weightedEntry[K, D]](),
+		data:     make(map[K]*linkedlist.LinkedNode[*weightedEntry[K, D]]),
+		expiry:   newExpiry[K](opts),
+	}
+}
+
+// Set adds or updates an item with a weight of 1, like SetWithWeight.
+// The item expires after the default TTL, if one was configured with WithDefaultTTL.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//
+// Time complexity: O(1) amortized
+func (cache *WeightedLRUCache[K, D]) Set(key K, item D) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, 1, cache.expiry.defaultTTL)
+}
+
+// SetWithWeight adds or updates an item in the cache with the given weight and marks it as most recently used.
+// The least recently used items are then evicted until the total weight fits the capacity.
+// An item heavier than the capacity is rejected instead: it is reported as evicted with reason Capacity,
+// and the item previously stored under key, if any, is removed with reason Replaced.
+// The item expires after the default TTL, if one was configured with WithDefaultTTL.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - weight: The weight of the item, e.g. its size in bytes (negative weights count as 0)
+//
+// Time complexity: O(1) amortized
+//
+// Example:
+//
+//	cache := cache.NewWeightedLRUCache[string, string](1024)
+//	cache.SetWithWeight("greeting", "hello", len("hello"))
+func (cache *WeightedLRUCache[K, D]) SetWithWeight(key K, item D, weight int) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, weight, cache.expiry.defaultTTL)
+}
+
+// SetWithTTL adds or updates an item like Set, with its own time to live.
+// Updating an item keeps its weight, and new items get a weight of 1;
+// use SetWithWeightAndTTL to set both.
+// A ttl of 0 or less means the item never expires.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - ttl: The time after which the item expires
+//
+// Time complexity: O(1) amortized
+func (cache *WeightedLRUCache[K, D]) SetWithTTL(key K, item D, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	weight := 1
+	if node, exists := cache.data[key]; exists {
+		weight = node.Data.weight
+	}
+
+	cache.set(key, item, weight, ttl)
+}
+
+// SetWithWeightAndTTL adds or updates an item with the given weight like SetWithWeight,
+// with its own time to live. A ttl of 0 or less means the item never expires.
+//
+// Parameters:
+//   - key: The key to associate with the data
+//   - item: The data to cache
+//   - weight: The weight of the item (negative weights count as 0)
+//   - ttl: The time after which the item expires
+//
+// Time complexity: O(1) amortized
+//
+// Example:
+//
+//	pages.SetWithWeightAndTTL(url, body, len(body), time.Minute)
+func (cache *WeightedLRUCache[K, D]) SetWithWeightAndTTL(key K, item D, weight int, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.set(key, item, weight, ttl)
+}
+
+// set is an internal method that stores an item, its weight and its deadline, evicting the least
+// recently used items while the total weight exceeds the capacity. An item heavier than the capacity
+// is rejected before anything else is evicted. The caller must hold the mutex.
+func (cache *WeightedLRUCache[K, D]) set(key K, item D, weight int, ttl time.Duration) {
+	weight = max(weight, 0)
+
+	if cache.capacity != 0 && weight > cache.capacity {
+		if node, exists := cache.data[key]; exists {
+			cache.remove(key, node, Replaced)
+		}
+		cache.evict(key, item, Capacity)
+		return
+	}
+
+	cache.expiry.track(key, ttl)
+
+	if node, exists := cache.data[key]; exists {
+		cache.evict(key, node.Data.value, Replaced)
+		cache.weight += weight - node.Data.weight
+		node.Data.value, node.Data.weight = item, weight
+		cache.recent.MoveToFront(node)
+	} else {
+		cache.data[key] = cache.recent.InsertFront(&weightedEntry[K, D]{key: key, value: item, weight: weight})
+		cache.weight += weight
+		cache.statistics.inserts.Add(1)
+	}
+
+	cache.flush()
+}
+
+// remove is an internal method that evicts the item stored in node for the given reason.
+// The caller must hold the mutex.
+func (cache *WeightedLRUCache[K, D]) remove(key K, node *linkedlist.LinkedNode[*weightedEntry[K, D]], reason EvictReason) {
+	entry := node.Data
+
+	cache.recent.Remove(node)
+	delete(cache.data, key)
+	cache.weight -= entry.weight
+	cache.expiry.forget(key)
+	cache.evict(key, entry.value, reason)
+}
+
+// OnEvict registers callback to be called whenever an item leaves the cache or has its value replaced,
+// replacing any previously registered callback. Pass nil to unregister.
+//
+// The callback is invoked synchronously by the operation that removed the item, after the cache
+// lock has been released, so it may safely call methods of the same cache.
+//
+// Parameters:
+//   - callback: The function receiving the key, the removed value and the reason
+func (cache *WeightedLRUCache[K, D]) OnEvict(callback EvictCallback[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.evictions.callback = callback
+}
+
+// unlock is an internal method that releases the mutex and then reports
+// the evictions recorded while it was held.
+func (cache *WeightedLRUCache[K, D]) unlock() {
+	callback, pending := cache.evictions.take()
+	cache.mutex.Unlock()
+
+	notify(callback, pending)
+}
+
+// evict is an internal method that counts an item removed for the given reason
+// and queues it for the eviction callback. The caller must hold the mutex.
+func (cache *WeightedLRUCache[K, D]) evict(key K, item D, reason EvictReason) {
+	cache.statistics.count(reason)
+	cache.evictions.record(key, item, reason)
+}
+
+// Get retrieves an item from the cache by its key.
+// Accessing an item moves it to the front of the access list (marks it as most recently used).
+// An expired item is evicted and reported as not found.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found
+//   - A zero value and false if the key is not in the cache
+//
+// Time complexity: O(1)
+func (cache *WeightedLRUCache[K, D]) Get(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	return cache.get(key)
+}
+
+// get is an internal method implementing Get. The caller must hold the mutex.
+func (cache *WeightedLRUCache[K, D]) get(key K) (D, bool) {
+	if node, exists := cache.data[key]; exists {
+		if cache.expiry.expired(key) {
+			cache.remove(key, node, Expired)
+			cache.statistics.misses.Add(1)
+			return utils.Zero[D](), false
+		}
+
+		cache.recent.MoveToFront(node)
+		cache.statistics.hits.Add(1)
+		return node.Data.value, true
+	}
+
+	cache.statistics.misses.Add(1)
+	return utils.Zero[D](), false
+}
+
+// GetWithExpiry retrieves an item from the cache like Get, along with the moment it expires.
+// Like Get, it marks the item as recently used.
+// Items stored without a time to live never expire and are returned with the zero time.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data, its expiration time (zero if it never expires) and true if found
+//   - A zero value, the zero time and false if the key is not in the cache
+//
+// Time complexity: O(1)
+func (cache *WeightedLRUCache[K, D]) GetWithExpiry(key K) (D, time.Time, bool) {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	item, exists := cache.get(key)
+	if !exists {
+		return item, time.Time{}, false
+	}
+
+	return item, cache.expiry.deadline(key), true
+}
+
+// GetOrCompute returns the cached value for key or, on a miss, calls compute and stores its result
+// with a weight of 1. Concurrent callers missing the same key share a single compute call: one caller
+// runs it and the others wait for its result. Errors are returned to all waiting callers and not cached.
+//
+// The compute function runs without holding the cache lock, so it may use the cache.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//   - compute: The function producing the value on a miss
+//
+// Returns:
+//   - The cached or computed value and nil on success
+//   - A zero value and the error returned by compute, or ErrComputePanicked to waiters if it panicked
+func (cache *WeightedLRUCache[K, D]) GetOrCompute(key K, compute func() (D, error)) (D, error) {
+	if item, exists := cache.Get(key); exists {
+		return item, nil
+	}
+
+	return cache.flights.do(key, func() (D, error) {
+		// A computation that finished just before this one started may have stored the value
+		if item, exists := cache.Peek(key); exists {
+			return item, nil
+		}
+
+		item, err := compute()
+		if err != nil {
+			return utils.Zero[D](), err
+		}
+
+		cache.Set(key, item)
+		return item, nil
+	})
+}
+
+// Peek retrieves an item from the cache without affecting its recency.
+// Unlike Get, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key of the item to retrieve
+//
+// Returns:
+//   - The cached data and true if found and not expired
+//   - A zero value and false otherwise
+//
+// Time complexity: O(1)
+func (cache *WeightedLRUCache[K, D]) Peek(key K) (D, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if node, exists := cache.data[key]; exists && !cache.expiry.expired(key) {
+		return node.Data.value, true
+	}
+
+	return utils.Zero[D](), false
+}
+
+// Contains reports whether the cache holds an item for key without affecting its recency.
+// Like Peek, it doesn't evict expired items and isn't counted in the statistics.
+//
+// Parameters:
+//   - key: The key to look up
+//
+// Returns:
+//   - true if the item is cached and not expired, false otherwise
+//
+// Time complexity: O(1)
+func (cache *WeightedLRUCache[K, D]) Contains(key K) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	_, exists := cache.data[key]
+	return exists && !cache.expiry.expired(key)
+}
+
+// Delete removes an item from the cache by its key.
+//
+// Parameters:
+//   - key: The key of the item to remove
+//
+// Returns:
+//   - true if the item was found and deleted
+//   - false if the key was not in the cache
+//
+// Time complexity: O(1)
+func (cache *WeightedLRUCache[K, D]) Delete(key K) bool {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	if node, exists := cache.data[key]; exists {
+		cache.remove(key, node, Deleted)
+		return true
+	}
+	return false
+}
+
+// Flush evicts the least recently used items while the total weight exceeds the capacity.
+// SetWithWeight keeps the cache within capacity, so this is only needed after Resize.
+//
+// Time complexity: O(k) where k is the number of items to remove
+func (cache *WeightedLRUCache[K, D]) Flush() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.flush()
+}
+
+// flush is an internal method that evicts the least recently used items beyond capacity.
+// The caller must hold the mutex.
+func (cache *WeightedLRUCache[K, D]) flush() {
+	for cache.capacity != 0 && cache.weight > cache.capacity && cache.recent.Size() > 0 {
+		retired := cache.recent.PopRight()
+		delete(cache.data, retired.key)
+		cache.weight -= retired.weight
+		cache.expiry.forget(retired.key)
+		cache.evict(retired.key, retired.value, Capacity)
+	}
+}
+
+// Resize changes the weight budget of the cache. A capacity of 0 means unlimited, like in
+// NewWeightedLRUCache, and a negative capacity is ignored.
+// When shrinking, the least recently used items are evicted immediately until the total weight fits,
+// reporting them to the eviction callback with reason Capacity.
+//
+// Parameters:
+//   - capacity: The new maximum total weight, or 0 for unlimited
+//
+// Time complexity: O(k) where k is the number of evicted items
+func (cache *WeightedLRUCache[K, D]) Resize(capacity int) {
+	if capacity < 0 {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	cache.capacity = capacity
+	cache.flush()
+}
+
+// Clear removes all items from the cache, reporting them to the eviction callback with reason Cleared.
+// The capacity remains unchanged.
+//
+// Time complexity: O(n) where n is the number of items in the cache
+func (cache *WeightedLRUCache[K, D]) Clear() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for entry := range cache.recent.Values() {
+		cache.evict(entry.key, entry.value, Cleared)
+	}
+
+	cache.recent.DeleteAll()
+	clear(cache.data)
+	cache.weight = 0
+	cache.expiry.reset()
+}
+
+// Len returns the number of items in the cache, not counting expired items.
+//
+// Time complexity: O(e) where e is the number of items with a TTL
+func (cache *WeightedLRUCache[K, D]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return len(cache.data) - cache.expiry.countExpired()
+}
+
+// Weight returns the total weight of the items in the cache.
+// Expired items count until they are evicted.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	fmt.Printf("%d of %d bytes used\n", pages.Weight(), budget)
+func (cache *WeightedLRUCache[K, D]) Weight() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.weight
+}
+
+// ForEach calls receiver for every item in the cache until it returns false.
+// Items are visited from the most to the least recently used.
+// Expired items are skipped, and recency is not affected.
+//
+// The receiver is invoked while the cache is locked and must not call methods of the same cache,
+// otherwise it deadlocks.
+//
+// Parameters:
+//   - receiver: A function called with each key and value; return false to stop
+//
+// Time complexity: O(n)
+func (cache *WeightedLRUCache[K, D]) ForEach(receiver abstract.IndexedReceiver[K, D]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for entry := range cache.recent.Values() {
+		if cache.expiry.expired(entry.key) {
+			continue
+		}
+		if !receiver(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// Stats returns a snapshot of the usage counters of the cache.
+//
+// Returns:
+//   - The counters and the current number of live items
+func (cache *WeightedLRUCache[K, D]) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.statistics.snapshot(len(cache.data) - cache.expiry.countExpired())
+}
+
+// ResetStats sets all usage counters of the cache to zero.
+func (cache *WeightedLRUCache[K, D]) ResetStats() {
+	cache.statistics.reset()
+}
+
+// StartJanitor starts a background goroutine that evicts expired items every interval.
+// Calling it again replaces the running janitor; a non-positive interval just stops it.
+// Call StopJanitor when the cache is no longer used, otherwise the goroutine keeps it alive.
+//
+// Parameters:
+//   - interval: The time between two sweeps
+func (cache *WeightedLRUCache[K, D]) StartJanitor(interval time.Duration) {
+	cache.janitor.start(interval, cache.deleteExpired)
+}
+
+// StopJanitor stops the background goroutine started by StartJanitor, if any.
+func (cache *WeightedLRUCache[K, D]) StopJanitor() {
+	cache.janitor.halt()
+}
+
+// deleteExpired is an internal method that evicts all expired items.
+func (cache *WeightedLRUCache[K, D]) deleteExpired() {
+	cache.mutex.Lock()
+	defer cache.unlock()
+
+	for _, key := range cache.expiry.collect() {
+		cache.remove(key, cache.data[key], Expired)
+	}
+}
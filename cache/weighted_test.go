@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// WEIGHTED LRU CACHE
+// ============================================================================
+
+// ----------------------------------------------------------------------------
+// Weight Accounting
+// ----------------------------------------------------------------------------
+
+func TestWeightedLRUCache_Weight(t *testing.T) {
+	cache := NewWeightedLRUCache[string, string](100)
+
+	cache.SetWithWeight("a", "alpha", 5)
+	cache.SetWithWeight("b", "beta", 4)
+	cache.Set("c", "c")
+
+	if cache.Weight() != 10 {
+		t.Errorf("Expected a total weight of 10, got %d", cache.Weight())
+	}
+
+	cache.SetWithWeight("a", "alphabet", 8)
+	if cache.Weight() != 13 {
+		t.Errorf("Expected replacing an item to adjust the weight to 13, got %d", cache.Weight())
+	}
+
+	cache.Delete("b")
+	if cache.Weight() != 9 {
+		t.Errorf("Expected Delete to subtract the item weight, got %d", cache.Weight())
+	}
+
+	cache.SetWithWeight("d", "d", -3)
+	if cache.Weight() != 9 {
+		t.Errorf("Expected a negative weight to count as 0, got %d", cache.Weight())
+	}
+
+	cache.Clear()
+	if cache.Weight() != 0 || cache.Len() != 0 {
+		t.Errorf("Expected an empty cache after Clear, got weight %d and %d items", cache.Weight(), cache.Len())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Eviction
+// ----------------------------------------------------------------------------
+
+func TestWeightedLRUCache_EvictsByWeight(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](10)
+
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, reason EvictReason) {
+		evicted = append(evicted, fmt.Sprintf("%s:%v", key, reason))
+	})
+
+	cache.SetWithWeight("a", 1, 3)
+	cache.SetWithWeight("b", 2, 3)
+	cache.SetWithWeight("c", 3, 3)
+	cache.Get("a")
+
+	// 9 + 4 exceeds the budget: the least recently used b goes, leaving 10
+	cache.SetWithWeight("d", 4, 4)
+
+	if cache.Weight() != 10 || cache.Len() != 3 {
+		t.Errorf("Expected weight 10 with 3 items, got %d with %d items", cache.Weight(), cache.Len())
+	}
+	if cache.Contains("b") {
+		t.Error("Expected b to be evicted as the least recently used")
+	}
+
+	// A heavy item evicts several light ones
+	cache.SetWithWeight("e", 5, 9)
+
+	if cache.Weight() != 9 || cache.Len() != 1 || !cache.Contains("e") {
+		t.Errorf("Expected only e to remain, got weight %d with %d items", cache.Weight(), cache.Len())
+	}
+
+	expected := []string{
+		fmt.Sprintf("b:%v", Capacity),
+		fmt.Sprintf("c:%v", Capacity),
+		fmt.Sprintf("a:%v", Capacity),
+		fmt.Sprintf("d:%v", Capacity),
+	}
+	if !slices.Equal(evicted, expected) {
+		t.Errorf("Expected evictions %v, got %v", expected, evicted)
+	}
+}
+
+func TestWeightedLRUCache_GrowingItemEvictsOthers(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](10)
+
+	cache.SetWithWeight("a", 1, 4)
+	cache.SetWithWeight("b", 2, 4)
+	cache.SetWithWeight("a", 10, 8)
+
+	if cache.Contains("b") || !cache.Contains("a") || cache.Weight() != 8 {
+		t.Errorf("Expected the grown item to evict b, got weight %d", cache.Weight())
+	}
+}
+
+func TestWeightedLRUCache_OversizedItem(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](10)
+
+	var evicted []string
+	cache.OnEvict(func(key string, value int, reason EvictReason) {
+		evicted = append(evicted, fmt.Sprintf("%s=%d:%v", key, value, reason))
+	})
+
+	cache.SetWithWeight("a", 1, 4)
+	cache.SetWithWeight("b", 2, 4)
+	cache.SetWithWeight("huge", 3, 11)
+
+	if cache.Len() != 2 || cache.Weight() != 8 || cache.Contains("huge") {
+		t.Errorf("Expected an item heavier than the capacity to be rejected, got weight %d with %d items",
+			cache.Weight(), cache.Len())
+	}
+
+	// an oversized update drops the stale value instead of keeping it
+	cache.SetWithWeight("a", 4, 12)
+
+	if cache.Contains("a") || !cache.Contains("b") || cache.Weight() != 4 {
+		t.Errorf("Expected only b to remain, got weight %d with %d items", cache.Weight(), cache.Len())
+	}
+
+	expected := []string{
+		fmt.Sprintf("huge=3:%v", Capacity),
+		fmt.Sprintf("a=1:%v", Replaced),
+		fmt.Sprintf("a=4:%v", Capacity),
+	}
+	if !slices.Equal(evicted, expected) {
+		t.Errorf("Expected evictions %v, got %v", expected, evicted)
+	}
+}
+
+func TestWeightedLRUCache_SetWithTTL_KeepsWeight(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](10)
+
+	cache.SetWithWeight("a", 1, 6)
+	cache.SetWithTTL("a", 2, time.Hour)
+	cache.SetWithTTL("b", 3, time.Hour)
+
+	if cache.Weight() != 7 {
+		t.Errorf("Expected SetWithTTL to keep the weight of a and weigh b 1, got weight %d", cache.Weight())
+	}
+
+	cache.SetWithWeightAndTTL("a", 4, 10, time.Hour)
+	if cache.Weight() != 10 || cache.Contains("b") {
+		t.Errorf("Expected the new weight to evict b, got weight %d", cache.Weight())
+	}
+	if _, deadline, _ := cache.GetWithExpiry("a"); deadline.IsZero() {
+		t.Error("Expected SetWithWeightAndTTL to set a deadline")
+	}
+}
+
+func TestWeightedLRUCache_ZeroCapacity(t *testing.T) {
+	cache := NewWeightedLRUCache[int, int](0)
+
+	for i := range 100 {
+		cache.SetWithWeight(i, i, 1000)
+	}
+
+	if cache.Len() != 100 || cache.Weight() != 100_000 {
+		t.Errorf("Expected unlimited capacity, got weight %d with %d items", cache.Weight(), cache.Len())
+	}
+}
+
+func TestWeightedLRUCache_Resize(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](20)
+
+	cache.SetWithWeight("a", 1, 5)
+	cache.SetWithWeight("b", 2, 5)
+	cache.SetWithWeight("c", 3, 5)
+
+	cache.Resize(10)
+	if cache.Contains("a") || cache.Weight() != 10 {
+		t.Errorf("Expected shrinking to evict a, got weight %d", cache.Weight())
+	}
+
+	cache.Resize(-1)
+	if cache.Weight() != 10 || cache.Len() != 2 {
+		t.Errorf("Expected a negative capacity to be ignored, got weight %d", cache.Weight())
+	}
+
+	cache.Resize(0)
+	cache.SetWithWeight("d", 4, 50)
+	if cache.Weight() != 60 {
+		t.Errorf("Expected unlimited capacity after Resize(0), got weight %d", cache.Weight())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Common Operations
+// ----------------------------------------------------------------------------
+
+func TestWeightedLRUCache_ForEach(t *testing.T) {
+	cache := NewWeightedLRUCache[string, int](10)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Get("a")
+
+	var keys []string
+	cache.ForEach(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	if expected := []string{"a", "c", "b"}; !slices.Equal(keys, expected) {
+		t.Errorf("Expected items from the most recently used %v, got %v", expected, keys)
+	}
+}
+
+func TestWeightedLRUCache_ImplementsCache(t *testing.T) {
+	var cache Cache[string, int] = NewWeightedLRUCache[string, int](2)
+
+	value, err := cache.(*WeightedLRUCache[string, int]).GetOrCompute("a", func() (int, error) { return 7, nil })
+	if err != nil || value != 7 {
+		t.Fatalf("Expected GetOrCompute to store 7, got %d, %v", value, err)
+	}
+	if val, exists := cache.Get("a"); !exists || val != 7 {
+		t.Errorf("Expected the computed value, got %d, %v", val, exists)
+	}
+}
@@ -0,0 +1,84 @@
+package env
+
+import "sync"
+
+var (
+	// defaultFuncs holds the functions computing the defaults of fields by field path (see RegisterDefaultFunc)
+	defaultFuncs = make(map[string]func() string)
+	// defaultFuncsMutex guards defaultFuncs
+	defaultFuncsMutex sync.RWMutex
+)
+
+// RegisterDefaultFunc registers a function computing the default value of a field at load time,
+// for defaults that depend on the host, like a worker count matching the number of CPUs.
+// The function is called by FromEnvs and FromFile for .env files when the field has neither
+// a variable set nor a static default tag, and its result is parsed like a variable value.
+// Registering nil removes the function of the field.
+//
+// Fields are identified by their path in the loaded struct: the field name, preceded by the names
+// of the nested struct fields holding it and separated by dots (e.g., "Database.Pool").
+// Fields of embedded structs are promoted, so they are named as if declared in the parent.
+//
+// Parameters:
+//   - fieldName: The path of the field (e.g., "Workers" or "Database.Pool")
+//   - fn: The function returning the default value
+//
+// Example:
+//
+//	type Config struct {
+//	    Workers int `env:"WORKERS"`
+//	}
+//
+//	config.RegisterDefaultFunc("Workers", func() string {
+//	    return strconv.Itoa(runtime.NumCPU())
+//	})
+//	cfg, err := config.FromEnvs[Config]() // Workers is NumCPU unless WORKERS is set
+func RegisterDefaultFunc(fieldName string, fn func() string) {
+	defaultFuncsMutex.Lock()
+	defer defaultFuncsMutex.Unlock()
+
+	if fn == nil {
+		delete(defaultFuncs, fieldName)
+		return
+	}
+	defaultFuncs[fieldName] = fn
+}
+
+// defaultValue returns the default of a field: its static default tag, or the value computed
+// by the function registered for its path.
+//
+// Parameters:
+//   - path: The path of the field (see RegisterDefaultFunc)
+//   - static: The value of the default tag of the field
+//
+// Returns:
+//   - string: The default value, or an empty string if the field has none
+func defaultValue(path, static string) string {
+	if static != "" {
+		return static
+	}
+
+	defaultFuncsMutex.RLock()
+	fn, exists := defaultFuncs[path]
+	defaultFuncsMutex.RUnlock()
+
+	if !exists {
+		return ""
+	}
+	return fn()
+}
+
+// fieldPath joins the path of a struct with the name of one of its fields.
+//
+// Parameters:
+//   - path: The path of the struct (empty for the root)
+//   - name: The name of the field
+//
+// Returns:
+//   - string: The path of the field (e.g., "Database.Pool")
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
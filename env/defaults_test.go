@@ -0,0 +1,125 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// TestRegisterDefaultFunc tests that a registered function provides the default of a field without a variable or default tag
+func TestRegisterDefaultFunc(t *testing.T) {
+	RegisterDefaultFunc("Workers", func() string { return strconv.Itoa(runtime.NumCPU()) })
+	defer RegisterDefaultFunc("Workers", nil)
+
+	type config struct {
+		Workers int `env:"DEFAULTS_WORKERS"`
+	}
+
+	cfg, err := FromEnvs[config]()
+	if err != nil {
+		t.Fatalf("FromEnvs() error = %v", err)
+	}
+	if cfg.Workers != runtime.NumCPU() {
+		t.Errorf("Workers = %d, want %d", cfg.Workers, runtime.NumCPU())
+	}
+
+	t.Setenv("DEFAULTS_WORKERS", "3")
+	cfg, err = FromEnvs[config]()
+	if err != nil {
+		t.Fatalf("FromEnvs() error = %v", err)
+	}
+	if cfg.Workers != 3 {
+		t.Errorf("Workers = %d, want the variable value 3", cfg.Workers)
+	}
+}
+
+// TestRegisterDefaultFunc_Precedence tests that static default tags win over registered functions and that nil unregisters
+func TestRegisterDefaultFunc_Precedence(t *testing.T) {
+	calls := 0
+	fn := func() string {
+		calls++
+		return "computed"
+	}
+	RegisterDefaultFunc("Static", fn)
+	RegisterDefaultFunc("Dynamic", fn)
+	defer RegisterDefaultFunc("Static", nil)
+
+	type config struct {
+		Static  string `env:"DEFAULTS_STATIC" default:"static"`
+		Dynamic string `env:"DEFAULTS_DYNAMIC"`
+	}
+
+	cfg, err := FromEnvs[config]()
+	if err != nil {
+		t.Fatalf("FromEnvs() error = %v", err)
+	}
+	if cfg.Static != "static" || cfg.Dynamic != "computed" || calls != 1 {
+		t.Errorf("config = %+v after %d calls, want Static static and Dynamic computed after 1 call", *cfg, calls)
+	}
+
+	RegisterDefaultFunc("Dynamic", nil)
+	cfg, err = FromEnvs[config]()
+	if err != nil {
+		t.Fatalf("FromEnvs() error = %v", err)
+	}
+	if cfg.Dynamic != "" {
+		t.Errorf("Dynamic = %q, want empty after unregistering", cfg.Dynamic)
+	}
+}
+
+// TestRegisterDefaultFunc_Paths tests the field paths of nested, pointer and embedded struct fields
+func TestRegisterDefaultFunc_Paths(t *testing.T) {
+	paths := []string{"Database.Pool", "Cache.Size", "Name"}
+	for _, path := range paths {
+		RegisterDefaultFunc(path, func() string { return "7" })
+		defer RegisterDefaultFunc(path, nil)
+	}
+
+	type Embedded struct {
+		Name string `env:"NAME"`
+	}
+	type pool struct {
+		Pool int `env:"POOL"`
+	}
+	type config struct {
+		Embedded
+		Database pool `env:"DB"`
+		Cache    *struct {
+			Size int `env:"SIZE"`
+		} `env:"CACHE"`
+	}
+
+	cfg, err := FromEnvsWithPrefix[config]("DEFAULTS")
+	if err != nil {
+		t.Fatalf("FromEnvsWithPrefix() error = %v", err)
+	}
+	if cfg.Database.Pool != 7 || cfg.Cache.Size != 7 || cfg.Name != "7" {
+		t.Errorf("config = %+v, want every field computed as 7", *cfg)
+	}
+}
+
+// TestRegisterDefaultFunc_FromFile tests that registered functions apply to the fields missing from a .env file
+func TestRegisterDefaultFunc_FromFile(t *testing.T) {
+	RegisterDefaultFunc("Workers", func() string { return "5" })
+	defer RegisterDefaultFunc("Workers", nil)
+
+	path := filepath.Join(t.TempDir(), "config.env")
+	if err := os.WriteFile(path, []byte("HOST=db.internal\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type config struct {
+		Host    string `env:"HOST"`
+		Workers int    `env:"WORKERS" required:"true"`
+	}
+
+	cfg, err := FromFile[config](path)
+	if err != nil {
+		t.Fatalf("FromFile() error = %v", err)
+	}
+	if cfg.Host != "db.internal" || cfg.Workers != 5 {
+		t.Errorf("config = %+v, want Host db.internal and Workers 5", *cfg)
+	}
+}
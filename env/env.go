@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"reflect"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/0x626f/go-kit/utils"
+	"gopkg.in/yaml.v3"
 )
 
 // Configuration file extensions and tag constants
@@ -24,13 +26,34 @@ var (
 	jsonExt = ".json"
 	// envExt is the file extension for environment variable configuration files
 	envExt = ".env"
+	// yamlExt and ymlExt are the file extensions for YAML configuration files
+	yamlExt = ".yaml"
+	ymlExt  = ".yml"
 	// tagEnv is the struct tag used to map struct fields to environment variables
 	tagEnv     = "env"
 	tagDefault = "default"
+	// tagRequired is the struct tag marking fields that must have a value or a default
+	tagRequired = "required"
+	// fileSuffix is appended to a variable name to look up a file holding its value
+	fileSuffix = "_FILE"
+	// tagSeparator is the struct tag overriding the separator between slice items
+	tagSeparator = "sep"
+	// tagLayout is the struct tag overriding the time.Time layout (RFC3339 by default)
+	tagLayout = "layout"
+	// itemSeparator separates the items of a slice value (e.g., "1,2,3")
+	itemSeparator = ","
+	// groupSeparator separates the groups of a two-dimensional slice value (e.g., "a,b;c,d")
+	groupSeparator = ";"
 )
 
 var prefix string
 
+// ErrEnvNotSet is returned by GetEnvRequired and GetEnvRequiredAs when the variable is unset
+var ErrEnvNotSet = errors.New("environment variable is not set")
+
+// failFast stops mapping at the first field that fails instead of reporting all of them
+var failFast bool
+
 // SetEnvPrefix sets a global prefix for all environment variable lookups.
 // When a prefix is set, all environment variable names will be automatically
 // prefixed with the given name followed by an underscore.
@@ -55,6 +78,22 @@ func SetEnvPrefix(name string) {
 	prefix = name
 }
 
+// SetFailFast configures whether FromEnvs and FromFile stop at the first field that fails
+// to map, validate, or satisfy its required tag. By default every field is processed and
+// all failures are returned together as a single joined error, so a misconfigured
+// environment can be fixed in one pass.
+//
+// Parameters:
+//   - enabled: If true, mapping stops and returns the first error encountered
+//
+// Example:
+//
+//	config.SetFailFast(true)
+//	cfg, err := config.FromEnvs[AppConfig]() // err describes only the first failure
+func SetFailFast(enabled bool) {
+	failFast = enabled
+}
+
 // GetEnvPrefix returns the current global environment variable prefix.
 // If no prefix has been set, it returns an empty string.
 //
@@ -113,12 +152,41 @@ func getPrefixedEnv(env string) string {
 //	// Get optional feature flag
 //	enableFeature := config.GetEnv("ENABLE_FEATURE_X", "false")
 func GetEnv(name, fallback string) string {
-	if value, exists := os.LookupEnv(getPrefixedEnv(name)); exists {
+	if value, exists := lookupEnv(getPrefixedEnv(name)); exists {
 		return value
 	}
 	return fallback
 }
 
+// GetEnvRequired retrieves the value of an environment variable that must be set.
+// Unlike GetEnv, it reports an unset variable instead of falling back to a default,
+// which makes mandatory settings fail fast at startup without defining a whole struct.
+// The variable name is resolved with the global prefix like GetEnv. A variable set to an
+// empty string counts as set.
+//
+// Parameters:
+//   - name: The name of the environment variable to retrieve
+//
+// Returns:
+//   - string: The value of the environment variable
+//   - error: An error wrapping ErrEnvNotSet and naming the variable if it is not set
+//
+// Example:
+//
+//	dsn, err := config.GetEnvRequired("DATABASE_URL")
+//	if err != nil {
+//	    log.Fatal(err) // environment variable is not set: DATABASE_URL
+//	}
+func GetEnvRequired(name string) (string, error) {
+	key := getPrefixedEnv(name)
+
+	value, exists := lookupEnv(key)
+	if !exists {
+		return "", fmt.Errorf("%w: %s", ErrEnvNotSet, key)
+	}
+	return value, nil
+}
+
 // SetEnv sets an environment variable in the current process.
 // This is a convenience wrapper around os.Setenv that provides a consistent
 // API for setting environment variables programmatically.
@@ -158,6 +226,7 @@ func SetEnv(name, value string) error {
 //   - Boolean: bool
 //   - String: string
 //   - Slice: slice of supported types
+//   - Map: map with supported key and element types
 //
 // Parameters:
 //   - kind: The reflect.Kind to check for conversion support
@@ -167,7 +236,7 @@ func SetEnv(name, value string) error {
 func canConvertFromEnv(kind reflect.Kind) bool {
 	casted := uint(kind)
 
-	if casted > 0 && (casted <= 14 || casted == 23 || casted == 24) {
+	if casted > 0 && (casted <= 14 || casted == 21 || casted == 23 || casted == 24) {
 		return true
 	}
 	return false
@@ -204,19 +273,108 @@ func canConvertFromEnv(kind reflect.Kind) bool {
 //	// Get a slice of integers
 //	ids := config.GetEnvAs("ALLOWED_IDS", []int{1, 2, 3})
 func GetEnvAs[T any](name string, fallback T) T {
-	if value, exists := os.LookupEnv(getPrefixedEnv(name)); exists {
-		instance := utils.NewInstanceOf[T]()
-		instanceType := reflect.TypeOf(instance).Elem()
-
-		if !canConvertFromEnv(instanceType.Kind()) {
-			return fallback
+	if value, exists := lookupEnv(getPrefixedEnv(name)); exists {
+		if converted, err := convertEnv[T](value); err == nil {
+			return converted
 		}
+	}
+	return fallback
+}
+
+// GetEnvRequiredAs retrieves an environment variable that must be set and converts it to the type T.
+// Unlike GetEnvAs, it reports an unset variable or a value that can't be converted instead of
+// falling back to a default. The variable name is resolved with the global prefix like GetEnvAs,
+// and the same types are supported.
+//
+// Type parameters:
+//   - T: The target type for the environment variable value. Must be a convertible type.
+//
+// Parameters:
+//   - name: The name of the environment variable to retrieve
+//
+// Returns:
+//   - T: The converted value of the environment variable
+//   - error: An error wrapping ErrEnvNotSet if the variable is not set,
+//     or naming the variable if the value can't be converted to T
+//
+// Example:
+//
+//	port, err := config.GetEnvRequiredAs[int]("PORT")
+//	if err != nil {
+//	    log.Fatal(err) // e.g. environment variable PORT: strconv.ParseInt: parsing "http": invalid syntax
+//	}
+func GetEnvRequiredAs[T any](name string) (T, error) {
+	key := getPrefixedEnv(name)
 
-		instanceValue := reflect.ValueOf(instance).Elem()
-		err := mapPrimaryValue(instanceValue, value)
+	value, exists := lookupEnv(key)
+	if !exists {
+		return utils.Zero[T](), fmt.Errorf("%w: %s", ErrEnvNotSet, key)
+	}
 
-		if err == nil {
-			return *instance
+	converted, err := convertEnv[T](value)
+	if err != nil {
+		return utils.Zero[T](), fmt.Errorf("environment variable %s: %w", key, err)
+	}
+	return converted, nil
+}
+
+// convertEnv converts the value of an environment variable to the type T.
+//
+// Type parameters:
+//   - T: The target type. Must be a convertible type (see canConvertFromEnv).
+//
+// Parameters:
+//   - value: The value of the environment variable
+//
+// Returns:
+//   - T: The converted value
+//   - error: An error if T is not a convertible type or the conversion fails
+func convertEnv[T any](value string) (T, error) {
+	instance := utils.NewInstanceOf[T]()
+	instanceType := reflect.TypeOf(instance).Elem()
+
+	kind := instanceType.Kind()
+	if kind == reflect.Pointer {
+		kind = instanceType.Elem().Kind()
+	}
+
+	if !canConvertFromEnv(kind) {
+		return utils.Zero[T](), fmt.Errorf("unsupported type %s", instanceType)
+	}
+
+	if err := mapPrimaryValue(reflect.ValueOf(instance).Elem(), value); err != nil {
+		return utils.Zero[T](), err
+	}
+	return *instance, nil
+}
+
+// GetEnvSliceAs retrieves an environment variable, splits it by the given separator,
+// and converts each item to the type T. Items are trimmed of surrounding whitespace and
+// empty trailing items are skipped. If the environment variable doesn't exist or any item
+// fails to convert, the fallback value is returned.
+//
+// Type parameters:
+//   - T: The type of the slice items. Must be a convertible type.
+//
+// Parameters:
+//   - name: The name of the environment variable to retrieve
+//   - sep: The separator between items (e.g., "|" or ";")
+//   - fallback: The default value to return if the variable is not set or conversion fails
+//
+// Returns:
+//   - []T: The converted items of the environment variable, or the fallback value
+//
+// Example:
+//
+//	// HEADERS=id,name|email,phone
+//	headers := config.GetEnvSliceAs("HEADERS", "|", []string{"id"})
+//	// Returns []string{"id,name", "email,phone"}
+func GetEnvSliceAs[T any](name, sep string, fallback []T) []T {
+	if value, exists := lookupEnv(getPrefixedEnv(name)); exists {
+		var result []T
+
+		if err := mapSliceValue(reflect.ValueOf(&result).Elem(), value, sep); err == nil {
+			return result
 		}
 	}
 	return fallback
@@ -251,7 +409,7 @@ func GetEnvAs[T any](name string, fallback T) T {
 //	// RETRY_DELAY=500ms
 //	// IDLE_TIMEOUT=2m30s
 func GetEnvDuration(name string, fallback time.Duration) time.Duration {
-	if value, exists := os.LookupEnv(getPrefixedEnv(name)); exists {
+	if value, exists := lookupEnv(getPrefixedEnv(name)); exists {
 		duration, err := time.ParseDuration(value)
 
 		if err == nil {
@@ -262,10 +420,16 @@ func GetEnvDuration(name string, fallback time.Duration) time.Duration {
 }
 
 // FromFile loads configuration from a file and maps it to a struct of type T.
-// Supported file types are JSON (.json extension) and environment files (.env extension).
+// Supported file types are JSON (.json extension), YAML (.yaml or .yml extension),
+// and environment files (.env extension). YAML files are mapped using the "json" struct tags,
+// so the same struct can be loaded from either format.
+//
+// JSON and YAML files may also hold a top-level array, loaded into a slice of structs
+// such as []ServerConfig; each element is mapped with the same tags. Fields typed as
+// slices of structs are populated from nested arrays in the same way.
 //
 // Type parameters:
-//   - T: The struct type to which the configuration will be mapped
+//   - T: The struct type to which the configuration will be mapped, or a slice of structs for JSON and YAML
 //
 // Parameters:
 //   - filename: Path to the configuration file
@@ -283,24 +447,72 @@ func GetEnvDuration(name string, fallback time.Duration) time.Duration {
 //
 //	// Load from JSON file
 //	config, err := config.FromFile[ServerConfig]("server.json")
+//
+//	// Load from a JSON array: [{"host": "a", "port": 80}, {"host": "b", "port": 81}]
+//	servers, err := config.FromFile[[]ServerConfig]("upstreams.json")
 func FromFile[T any](filename string) (*T, error) {
-	if !utils.IsObject[T]() {
-		return nil, fmt.Errorf("underlying type must be a struct")
+	if isEnv(filename) {
+		if !utils.IsObject[T]() {
+			return nil, fmt.Errorf("underlying type must be a struct")
+		}
+		return mapEnvConfig[T](filename, prefix)
+	}
+
+	if !utils.IsObject[T]() && !isStructSlice[T]() {
+		return nil, fmt.Errorf("underlying type must be a struct or a slice of structs")
 	}
 
 	if isJson(filename) {
 		return mapJSONConfig[T](filename)
 	}
 
-	if isEnv(filename) {
-		return mapEnvConfig[T](filename)
+	if isYaml(filename) {
+		return mapYAMLConfig[T](filename)
 	}
 
 	return nil, fmt.Errorf("unsupported extension for %v", filename)
 }
 
+// FromFileWithPrefix loads configuration from a file like FromFile, but resolves the keys
+// of .env files with the given prefix instead of the global one set by SetEnvPrefix.
+// JSON and YAML files are not affected by prefixes.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Parameters:
+//   - filename: Path to the configuration file
+//   - prefix: The prefix for the keys of the file (empty for none)
+//
+// Returns:
+//   - *T: A pointer to a struct of type T populated with configuration values
+//   - error: An error if the file can't be read, has an unsupported extension, or if mapping fails
+//
+// Example:
+//
+//	// services.env contains BILLING_PORT=8081 and SHIPPING_PORT=8082
+//	billing, err := config.FromFileWithPrefix[ServiceConfig]("services.env", "BILLING")
+//	shipping, err := config.FromFileWithPrefix[ServiceConfig]("services.env", "SHIPPING")
+func FromFileWithPrefix[T any](filename, prefix string) (*T, error) {
+	if isEnv(filename) {
+		if !utils.IsObject[T]() {
+			return nil, fmt.Errorf("underlying type must be a struct")
+		}
+		return mapEnvConfig[T](filename, prefix)
+	}
+
+	return FromFile[T](filename)
+}
+
 // FromEnvs loads configuration directly from environment variables and maps
 // it to a struct of type T based on the "env" struct tag.
+// If a variable is unset but the same variable with a "_FILE" suffix is set, the value is
+// read from the file it points to (e.g., DB_PASSWORD_FILE=/run/secrets/db_password).
+// Fields tagged required:"true" that have neither an environment value nor a default
+// are reported in the returned error, one entry per field. Populated fields are then
+// checked against the rules of their "validate" tag (min, max, oneof, regex).
+// All failures are joined into a single error naming each field, unless SetFailFast is enabled.
+// With SetStrictKeys, prefixed variables that correspond to no field are reported as well.
 //
 // Type parameters:
 //   - T: The struct type to which the configuration will be mapped
@@ -329,32 +541,150 @@ func FromEnvs[T any]() (*T, error) {
 	instance := utils.NewInstanceOf[T]()
 	instanceValue := reflect.ValueOf(instance).Elem()
 
-	err = mapStructFromEnvs(instanceValue, "")
+	err = mapStructFromEnvs(instanceValue, prefix, "")
+	if err == nil || !failFast {
+		err = errors.Join(err, checkUnknownKeys(instanceValue.Type(), prefix))
+	}
+
+	return instance, err
+}
+
+// FromEnvsWithPrefix loads configuration from environment variables like FromEnvs,
+// but uses the given prefix instead of the global one set by SetEnvPrefix.
+// This allows loading several configurations with different prefixes, even concurrently,
+// without changing process-wide state.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Parameters:
+//   - prefix: The prefix for all environment variable names (empty for none)
+//
+// Returns:
+//   - *T: A pointer to a struct of type T populated with configuration values
+//   - error: An error if T is not a struct type or if mapping fails
+//
+// Example:
+//
+//	// BILLING_PORT=8081, SHIPPING_PORT=8082
+//	billing, err := config.FromEnvsWithPrefix[ServiceConfig]("BILLING")
+//	shipping, err := config.FromEnvsWithPrefix[ServiceConfig]("SHIPPING")
+func FromEnvsWithPrefix[T any](prefix string) (*T, error) {
+	if !utils.IsObject[T]() {
+		return nil, fmt.Errorf("underlying type must be a struct")
+	}
+
+	instance := utils.NewInstanceOf[T]()
+	instanceValue := reflect.ValueOf(instance).Elem()
+
+	err := mapStructFromEnvs(instanceValue, prefix, "")
+	if err == nil || !failFast {
+		err = errors.Join(err, checkUnknownKeys(instanceValue.Type(), prefix))
+	}
 
 	return instance, err
 }
 
 // LoadEnvs loads environment variables from a file and sets them
 // in the current process environment using os.Setenv.
+// References to other variables (${VAR} or $VAR) are expanded before the values are set;
+// see SetStrictInterpolation for how unknown references are handled.
 //
 // Parameters:
 //   - filename: Path to the .env file
 //
 // Returns:
-//   - error: An error if the file can't be read or if setting any environment variable fails
+//   - error: An error if the file can't be read, interpolation fails, or setting any environment variable fails
 //
 // Example:
 //
 //	// Load environment variables from .env file
 //	err := config.LoadEnvs(".env")
 func LoadEnvs(filename string) error {
-	file, err := os.Open(filename)
+	data, keys, err := readEnvFile(filename)
 
 	if err != nil {
 		return err
 	}
 
+	for _, key := range keys {
+		err = os.Setenv(key, data[key])
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadEnvsIfAbsent loads environment variables from one or more files, setting only the keys
+// that are not already present in the process environment. This follows the twelve-factor
+// convention that real environment variables take precedence over .env files.
+//
+// Files are loaded in order, so precedence is: the process environment first, then the
+// first file that defines a key. A key set by an earlier file is not overwritten by a later one.
+//
+// Parameters:
+//   - paths: Paths to the .env files, from the highest to the lowest precedence
+//
+// Returns:
+//   - []string: The keys that were actually set, in the order they were set
+//   - error: An error if a file can't be read or interpolated, or setting a variable fails;
+//     the keys set before the failure are still returned
+//
+// Example:
+//
+//	// Values from .env.local override those from .env, and both yield to the real environment
+//	keys, err := config.LoadEnvsIfAbsent(".env.local", ".env")
+//	log.Printf("loaded %v from files", keys)
+func LoadEnvsIfAbsent(paths ...string) ([]string, error) {
+	var loaded []string
+
+	for _, path := range paths {
+		data, keys, err := readEnvFile(path)
+
+		if err != nil {
+			return loaded, err
+		}
+
+		for _, key := range keys {
+			if _, exists := os.LookupEnv(key); exists {
+				continue
+			}
+
+			if err = os.Setenv(key, data[key]); err != nil {
+				return loaded, err
+			}
+
+			loaded = append(loaded, key)
+		}
+	}
+
+	return loaded, nil
+}
+
+// readEnvFile reads KEY=VALUE pairs from a .env file and expands references between them.
+// Lines starting with "#" and lines without "=" are skipped. If a key appears more than once,
+// the last value wins.
+//
+// Parameters:
+//   - filename: Path to the .env file
+//
+// Returns:
+//   - map[string]string: The interpolated values by key
+//   - []string: The keys in the order of their first appearance in the file
+//   - error: An error if the file can't be read or interpolation fails
+func readEnvFile(filename string) (map[string]string, []string, error) {
+	file, err := os.Open(filename)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
 	scanner := bufio.NewScanner(file)
+	data := make(map[string]string)
+	var keys []string
 	for scanner.Scan() {
 		line := scanner.Text()
 
@@ -369,16 +699,25 @@ func LoadEnvs(filename string) error {
 		}
 
 		key, value := line[:separator], line[separator+1:]
-		err = os.Setenv(key, value)
-
-		if err != nil {
-			break
+		if _, exists := data[key]; !exists {
+			keys = append(keys, key)
 		}
+		data[key] = value
 	}
 
-	err = errors.Join(err, file.Close())
+	err = errors.Join(scanner.Err(), file.Close())
 
-	return err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = interpolate(data)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, keys, nil
 }
 
 // isJson checks if the given filename has a JSON file extension (.json).
@@ -432,79 +771,185 @@ func mapJSONConfig[T any](filename string) (*T, error) {
 	return instance, nil
 }
 
-// mapEnvConfig loads an environment configuration file (.env) and maps it to a struct of type T
-// based on the "env" struct tag.
+// isYaml checks if the given filename has a YAML file extension (.yaml or .yml).
+//
+// Parameters:
+//   - filename: The filename to check
+//
+// Returns:
+//   - bool: True if the file has a .yaml or .yml extension, false otherwise
+func isYaml(filename string) bool {
+	return strings.HasSuffix(filename, yamlExt) || strings.HasSuffix(filename, ymlExt)
+}
+
+// mapYAMLConfig loads a YAML configuration file and maps it to a struct of type T.
+// The document is decoded into generic values and re-encoded as JSON, so the struct
+// is populated according to its "json" tags exactly as mapJSONConfig would.
 //
 // Type parameters:
 //   - T: The struct type to which the configuration will be mapped
 //
 // Parameters:
-//   - filename: Path to the .env configuration file
+//   - filename: Path to the YAML configuration file
 //
 // Returns:
 //   - *T: A pointer to a struct of type T populated with configuration values
-//   - error: An error if the file can't be read or if mapping fails
-func mapEnvConfig[T any](filename string) (*T, error) {
-	file, err := os.Open(filename)
+//   - error: An error if the file can't be read, isn't valid YAML, or doesn't match the struct
+func mapYAMLConfig[T any](filename string) (*T, error) {
+	data, err := os.ReadFile(filename)
 
 	if err != nil {
 		return nil, err
 	}
 
-	scanner := bufio.NewScanner(file)
-	data := make(map[string]string)
-	for scanner.Scan() {
-		line := scanner.Text()
+	var document any
 
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
+	err = yaml.Unmarshal(data, &document)
 
-		separator := strings.Index(line, "=")
+	if err != nil {
+		return nil, err
+	}
 
-		if separator == -1 {
-			continue
-		}
+	data, err = json.Marshal(normalizeYAML(document))
 
-		key, value := line[:separator], line[separator+1:]
-		data[key] = value
+	if err != nil {
+		return nil, err
 	}
 
-	err = file.Close()
+	instance := utils.NewInstanceOf[T]()
+
+	err = json.Unmarshal(data, instance)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// normalizeYAML converts maps with non-string keys produced by the YAML decoder
+// into maps with string keys, so the document can be encoded as JSON.
+//
+// Parameters:
+//   - value: A decoded YAML value
+//
+// Returns:
+//   - any: The value with all nested maps keyed by strings
+func normalizeYAML(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			typed[key] = normalizeYAML(item)
+		}
+		return typed
+	case map[any]any:
+		result := make(map[string]any, len(typed))
+		for key, item := range typed {
+			result[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return result
+	case []any:
+		for index, item := range typed {
+			typed[index] = normalizeYAML(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+// mapEnvConfig loads an environment configuration file (.env) and maps it to a struct of type T
+// based on the "env" struct tag. Fields missing from the file fall back to their "default" tag,
+// and fields tagged required:"true" without either are reported as an error.
+// References to other variables (${VAR} or $VAR) are expanded as in LoadEnvs.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Parameters:
+//   - filename: Path to the .env configuration file
+//   - prefix: The prefix for the keys of the file (empty for none)
+//
+// Returns:
+//   - *T: A pointer to a struct of type T populated with configuration values
+//   - error: An error if the file can't be read or if mapping fails
+func mapEnvConfig[T any](filename, prefix string) (*T, error) {
+	data, _, err := readEnvFile(filename)
 
 	if err != nil {
 		return nil, err
 	}
 
 	instance := utils.NewInstanceOf[T]()
-	instanceType := reflect.TypeOf(instance).Elem()
 	instanceValue := reflect.ValueOf(instance).Elem()
 
-	for index := 0; index < instanceType.NumField(); index++ {
-		field := instanceType.Field(index)
+	err = mapStructFromData(instanceValue, data, prefix, "")
+
+	return instance, err
+}
+
+// mapStructFromData maps the values of a .env file to struct fields based on the "env" struct tag.
+// Fields of embedded structs are promoted to the parent's namespace, as in mapStructFromEnvs,
+// and unset keys fall back to the file named by the key with the "_FILE" suffix.
+//
+// Parameters:
+//   - ref: The reflect.Value of the struct to populate
+//   - data: The values of the file by key
+//   - prefix: The prefix for the keys (empty for none)
+//   - path: The path of the struct in the loaded value, used for the defaults of RegisterDefaultFunc
+//
+// Returns:
+//   - error: An aggregated error if any field mapping or validation fails
+func mapStructFromData(ref reflect.Value, data map[string]string, prefix, path string) (err error) {
+	refType := ref.Type()
+	for index := 0; index < ref.NumField(); index++ {
+		if failFast && err != nil {
+			return
+		}
+
+		field := refType.Field(index)
 
 		tag := field.Tag.Get(tagEnv)
 
+		if field.Anonymous && tag != "-" && isNestedStruct(field.Type) {
+			err = errors.Join(err, mapStructFromData(ref.Field(index), data, addNestedPrefix(tag, prefix), path))
+			continue
+		}
+
 		if tag == "" {
 			continue
 		}
 
-		value, exists := data[getPrefixedEnv(tag)]
+		key := addNestedPrefix(tag, prefix)
+		value, exists, lookupErr := lookupDataOrFile(data, key, key+fileSuffix)
 
-		if !exists {
+		if lookupErr != nil {
+			err = errors.Join(err, lookupErr)
 			continue
 		}
 
-		ref := instanceValue.Field(index)
+		if !exists {
+			value = defaultValue(fieldPath(path, field.Name), field.Tag.Get(tagDefault))
+			if value == "" {
+				err = errors.Join(err, checkRequired(field, key))
+				continue
+			}
+		}
 
-		if !ref.CanSet() {
+		fieldRef := ref.Field(index)
+
+		if !fieldRef.CanSet() {
 			continue
 		}
 
-		err = errors.Join(err, mapPrimaryValue(ref, value))
-	}
+		if mapErr := mapFieldValue(field, fieldRef, value); mapErr != nil {
+			err = errors.Join(err, fieldError(field, key, mapErr))
+			continue
+		}
 
-	return instance, err
+		err = errors.Join(err, validateField(field, fieldRef))
+	}
+	return
 }
 
 // mapPrimaryValue converts a string value to the appropriate type and sets it in the given reflect.Value.
@@ -516,7 +961,14 @@ func mapEnvConfig[T any](filename string) (*T, error) {
 //   - Numeric types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64
 //   - Floating point: float32, float64
 //   - Boolean: true/false or 1/0
-//   - Slices: Comma-separated values (e.g., "1,2,3" for []int)
+//   - Slices: Comma-separated values (e.g., "1,2,3" for []int), trimmed and without empty trailing items
+//   - Two-dimensional slices: Semicolon-separated groups of comma-separated values
+//     (e.g., "a,b;c,d" for [][]string is [["a","b"],["c","d"]])
+//   - Maps: Comma-separated key=value pairs (e.g., "a=1,b=2" for map[string]int)
+//   - time.Time: RFC3339 timestamps (e.g., "2024-01-02T15:04:05Z")
+//   - net.IP: IPv4 or IPv6 addresses (e.g., "10.0.0.1" or "::1")
+//   - net.IPNet: CIDR notation, holding the network of the address (e.g., "10.0.0.0/8")
+//   - Pointers: A newly allocated value of the pointed-to type (e.g., "8080" for *int)
 //
 // Parameters:
 //   - ref: The reflect.Value to set
@@ -535,17 +987,38 @@ func mapPrimaryValue(ref reflect.Value, value string) error {
 		return nil
 	}
 
+	if utils.IsInstanceOf[time.Time](refType) {
+		return mapTimeValue(ref, value, time.RFC3339)
+	}
+
+	if utils.IsInstanceOf[net.IP](refType) {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("invalid IP address %q", value)
+		}
+		ref.Set(reflect.ValueOf(ip))
+		return nil
+	}
+
+	if utils.IsInstanceOf[net.IPNet](refType) {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q", value)
+		}
+		ref.Set(reflect.ValueOf(*network))
+		return nil
+	}
+
 	if utils.Implements[encoding.TextUnmarshaler](refType) {
 		ptr := ref.Addr()
 		m := ptr.MethodByName("UnmarshalText")
 		result := m.Call([]reflect.Value{reflect.ValueOf([]byte(value))})
-		if !result[0].IsNil() {
-			ref.Set(result[0].Elem())
+		if err, _ := result[0].Interface().(error); err != nil {
+			return err
 		}
 		return nil
 	}
 
-	var aggError error
 	switch ref.Kind() {
 	case reflect.String:
 		ref.SetString(value)
@@ -616,30 +1089,352 @@ func mapPrimaryValue(ref reflect.Value, value string) error {
 		}
 		ref.SetFloat(num)
 	case reflect.Slice:
-		elemType := refType.Elem()
+		return mapSliceValue(ref, value, itemSeparator)
+	case reflect.Pointer:
+		elem := reflect.New(refType.Elem())
+		if err := mapPrimaryValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		ref.Set(elem)
+	case reflect.Map:
+		result, err := mapMapValue(refType, value)
+		if err != nil {
+			return err
+		}
+		ref.Set(result)
+	default:
+		ref.SetZero()
+	}
+	return nil
+}
 
-		if elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array {
-			ref.SetZero()
-			return fmt.Errorf("couldn't map dimensional arrays from .env")
+// mapFieldValue converts a string value and sets it in the given struct field.
+// It honors field-level tags that affect parsing, such as "layout" for time.Time fields,
+// and delegates everything else to mapPrimaryValue. Pointer fields (e.g., *int) are
+// allocated only when a value is set, so nil means the field isn't configured.
+//
+// Parameters:
+//   - field: The struct field being populated
+//   - ref: The reflect.Value of the field
+//   - value: The string value to convert and set
+//
+// Returns:
+//   - error: An error if conversion fails
+func mapFieldValue(field reflect.StructField, ref reflect.Value, value string) error {
+	if ref.Kind() == reflect.Pointer {
+		elem := reflect.New(ref.Type().Elem())
+		if err := mapFieldValue(field, elem.Elem(), value); err != nil {
+			return err
 		}
+		ref.Set(elem)
+		return nil
+	}
 
-		values := strings.Split(value, ",")
+	if layout := field.Tag.Get(tagLayout); layout != "" && utils.IsInstanceOf[time.Time](ref.Type()) {
+		return mapTimeValue(ref, value, layout)
+	}
 
-		slice := reflect.MakeSlice(ref.Type(), len(values), len(values))
+	if separator := field.Tag.Get(tagSeparator); separator != "" && ref.Kind() == reflect.Slice {
+		return mapSliceValue(ref, value, separator)
+	}
 
-		for index, item := range values {
-			err := mapPrimaryValue(slice.Index(index), item)
-			if err != nil {
-				aggError = errors.Join(aggError, err)
-			}
+	return mapPrimaryValue(ref, value)
+}
+
+// mapSliceValue splits a string value by the separator and sets the converted items in a slice.
+// Items are trimmed of surrounding whitespace and empty trailing items are skipped, so "a, b,"
+// yields ["a", "b"]. For two-dimensional slices the value is first split into groups by ";",
+// and each group is split into items by the separator.
+//
+// Parameters:
+//   - ref: The reflect.Value of the slice to set
+//   - value: The string value to split and convert
+//   - separator: The separator between items (e.g., "," or "|")
+//
+// Returns:
+//   - error: An aggregated error for every item that fails to convert,
+//     or an error if the slice has more than two dimensions
+func mapSliceValue(ref reflect.Value, value, separator string) error {
+	elemType := ref.Type().Elem()
+
+	if elemType.Kind() == reflect.Array ||
+		(elemType.Kind() == reflect.Slice && (elemType.Elem().Kind() == reflect.Slice || elemType.Elem().Kind() == reflect.Array)) {
+		ref.SetZero()
+		return fmt.Errorf("couldn't map dimensional arrays from .env")
+	}
+
+	// net.IP is a byte slice, but its items are parsed from single values
+	if elemType.Kind() == reflect.Slice && !utils.IsInstanceOf[net.IP](elemType) {
+		groups := splitItems(value, groupSeparator)
+		slice := reflect.MakeSlice(ref.Type(), len(groups), len(groups))
+
+		var aggError error
+		for index, group := range groups {
+			aggError = errors.Join(aggError, mapSliceValue(slice.Index(index), group, separator))
 		}
 		ref.Set(slice)
-	default:
-		ref.SetZero()
+		return aggError
+	}
+
+	items := splitItems(value, separator)
+	slice := reflect.MakeSlice(ref.Type(), len(items), len(items))
+
+	var aggError error
+	for index, item := range items {
+		aggError = errors.Join(aggError, mapPrimaryValue(slice.Index(index), item))
 	}
+	ref.Set(slice)
 	return aggError
 }
 
+// splitItems splits a value by the separator, trims every item, and drops empty trailing items.
+//
+// Parameters:
+//   - value: The string to split
+//   - separator: The separator between items
+//
+// Returns:
+//   - []string: The trimmed items (empty for an empty or blank value)
+func splitItems(value, separator string) []string {
+	items := strings.Split(value, separator)
+	for index, item := range items {
+		items[index] = strings.TrimSpace(item)
+	}
+
+	for len(items) > 0 && items[len(items)-1] == "" {
+		items = items[:len(items)-1]
+	}
+
+	return items
+}
+
+// mapTimeValue parses a string value with the given layout and sets it in a time.Time value.
+//
+// Parameters:
+//   - ref: The reflect.Value of type time.Time to set
+//   - value: The string value to parse
+//   - layout: The layout accepted by time.Parse (e.g., time.RFC3339 or "2006-01-02")
+//
+// Returns:
+//   - error: An error if the value doesn't match the layout
+//
+// Example:
+//
+//	type Config struct {
+//	    Cutoff time.Time `env:"FEATURE_CUTOFF_DATE" layout:"2006-01-02" default:"2030-01-01"`
+//	}
+func mapTimeValue(ref reflect.Value, value, layout string) error {
+	parsed, err := time.Parse(layout, value)
+	if err != nil {
+		return err
+	}
+	ref.Set(reflect.ValueOf(parsed))
+	return nil
+}
+
+// mapMapValue parses comma-separated key=value pairs into a new map of the given type.
+// Both keys and values are converted with mapPrimaryValue, so any supported primitive
+// type can be used for either of them. An empty string yields an empty map.
+//
+// Parameters:
+//   - mapType: The type of the map to create
+//   - value: The string of pairs to parse (e.g., "a=1,b=2")
+//
+// Returns:
+//   - reflect.Value: The populated map
+//   - error: An aggregated error naming every pair, key, or value that failed to parse
+//
+// Example:
+//
+//	mapMapValue(reflect.TypeOf(map[string]bool{}), "beta=true,legacy=false")
+//	// Returns map[string]bool{"beta": true, "legacy": false}
+func mapMapValue(mapType reflect.Type, value string) (reflect.Value, error) {
+	result := reflect.MakeMap(mapType)
+
+	if value == "" {
+		return result, nil
+	}
+
+	var aggError error
+	for _, pair := range strings.Split(value, ",") {
+		separator := strings.Index(pair, "=")
+
+		if separator == -1 {
+			aggError = errors.Join(aggError, fmt.Errorf("couldn't map pair %q: expected key=value", pair))
+			continue
+		}
+
+		key := reflect.New(mapType.Key()).Elem()
+		if err := mapPrimaryValue(key, pair[:separator]); err != nil {
+			aggError = errors.Join(aggError, fmt.Errorf("couldn't map key %q: %w", pair[:separator], err))
+			continue
+		}
+
+		elem := reflect.New(mapType.Elem()).Elem()
+		if err := mapPrimaryValue(elem, pair[separator+1:]); err != nil {
+			aggError = errors.Join(aggError, fmt.Errorf("couldn't map value %q of key %q: %w", pair[separator+1:], pair[:separator], err))
+			continue
+		}
+
+		result.SetMapIndex(key, elem)
+	}
+
+	if aggError != nil {
+		return reflect.Value{}, aggError
+	}
+
+	return result, nil
+}
+
+// lookupEnvOrFile retrieves the value of an environment variable. If the variable is unset,
+// it falls back to the variable with the "_FILE" suffix, which holds the path of a file
+// containing the value (as used by Docker and Kubernetes secrets). The file contents are
+// trimmed of surrounding whitespace, including the trailing newline.
+//
+// Parameters:
+//   - key: The resolved environment variable name, including prefixes
+//   - fileKey: The key with the "_FILE" suffix
+//
+// Returns:
+//   - string: The value of the variable or the contents of the referenced file
+//   - bool: True if either the variable or the file reference is set
+//   - error: An error if the referenced file can't be read
+//
+// Example:
+//
+//	// DB_PASSWORD is unset, DB_PASSWORD_FILE=/run/secrets/db_password
+//	value, exists, err := lookupEnvOrFile("DB_PASSWORD", "DB_PASSWORD_FILE") // Returns the secret from the file
+func lookupEnvOrFile(key, fileKey string) (string, bool, error) {
+	if value, exists := lookupEnv(key); exists {
+		return value, true, nil
+	}
+
+	path, exists := lookupEnv(fileKey)
+	if !exists {
+		return "", false, nil
+	}
+
+	return readValueFile(fileKey, path)
+}
+
+// lookupDataOrFile retrieves the value of a key read from a .env file like lookupData. If the key
+// is unset, it falls back to the key with the "_FILE" suffix in the same data, like lookupEnvOrFile.
+//
+// Parameters:
+//   - data: The values of the file by key
+//   - key: The resolved key, including prefixes
+//   - fileKey: The key with the "_FILE" suffix
+//
+// Returns:
+//   - string: The value of the key or the contents of the referenced file
+//   - bool: True if either the key or the file reference is set
+//   - error: An error if the referenced file can't be read
+func lookupDataOrFile(data map[string]string, key, fileKey string) (string, bool, error) {
+	if value, exists := lookupData(data, key); exists {
+		return value, true, nil
+	}
+
+	path, exists := lookupData(data, fileKey)
+	if !exists {
+		return "", false, nil
+	}
+
+	return readValueFile(fileKey, path)
+}
+
+// readValueFile reads the value held by the file a "_FILE" variable points to,
+// trimmed of surrounding whitespace.
+//
+// Parameters:
+//   - fileKey: The variable naming the file, used in errors
+//   - path: The path of the file
+//
+// Returns:
+//   - string: The trimmed contents of the file
+//   - bool: True if the file was read
+//   - error: An error if the file can't be read
+func readValueFile(fileKey, path string) (string, bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("couldn't read %s: %w", fileKey, err)
+	}
+
+	return strings.TrimSpace(string(data)), true, nil
+}
+
+// fieldError wraps an error raised while mapping a field with the field name and its variable.
+//
+// Parameters:
+//   - field: The struct field that failed to map
+//   - key: The resolved environment variable name, including prefixes
+//   - err: The mapping error
+//
+// Returns:
+//   - error: The wrapped error (e.g., "field Port (APP_PORT): strconv.ParseInt: ...")
+func fieldError(field reflect.StructField, key string, err error) error {
+	return fmt.Errorf("field %s (%s): %w", field.Name, key, err)
+}
+
+// checkRequired reports a missing value for a field tagged with required:"true".
+// It is called only when the field has neither an environment value nor a default.
+//
+// Parameters:
+//   - field: The struct field that has no value
+//   - key: The resolved environment variable name, including prefixes
+//
+// Returns:
+//   - error: An error naming the field and the variable if the field is required, nil otherwise
+//
+// Example:
+//
+//	type Config struct {
+//	    Password string `env:"PASSWORD" required:"true"`
+//	}
+//	// With SetEnvPrefix("DB") and DB_PASSWORD unset, FromEnvs returns:
+//	// required field Password is missing: DB_PASSWORD is not set
+func checkRequired(field reflect.StructField, key string) error {
+	required, _ := strconv.ParseBool(field.Tag.Get(tagRequired))
+	if !required {
+		return nil
+	}
+	return fmt.Errorf("required field %s is missing: %s is not set", field.Name, key)
+}
+
+// isNestedStruct reports whether a field of the given type is mapped as a nested struct
+// whose fields are resolved individually, rather than parsed from a single value.
+//
+// Parameters:
+//   - fieldType: The type of the field
+//
+// Returns:
+//   - bool: True for struct types other than time.Time and net.IPNet
+func isNestedStruct(fieldType reflect.Type) bool {
+	return fieldType.Kind() == reflect.Struct &&
+		!utils.IsInstanceOf[time.Time](fieldType) && !utils.IsInstanceOf[net.IPNet](fieldType)
+}
+
+// isStructSlice reports whether T is a slice of structs or of pointers to structs,
+// which JSON and YAML files can populate from a top-level array.
+//
+// Type parameters:
+//   - T: The type to check
+//
+// Returns:
+//   - bool: True if the elements of T are structs other than time.Time and net.IPNet, or pointers to them
+func isStructSlice[T any]() bool {
+	sliceType := reflect.TypeOf((*T)(nil)).Elem()
+	if sliceType.Kind() != reflect.Slice {
+		return false
+	}
+
+	elemType := sliceType.Elem()
+	if elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+
+	return isNestedStruct(elemType)
+}
+
 // addNestedPrefix adds a prefix to an environment variable name for nested struct field mapping.
 // If the prefix is empty, the envName is returned unchanged.
 // This is used internally to construct hierarchical environment variable names for nested structs.
@@ -673,10 +1468,14 @@ func addNestedPrefix(envName, prefix string) string {
 // For nested structs, it builds hierarchical environment variable names by combining
 // prefixes from parent structs with child field names. For example, a nested struct
 // with env tag "DB" containing a field with env tag "HOST" will look for "DB_HOST".
+// Embedded (anonymous) structs without an env tag add no prefix, so their fields
+// map to the same variables as if they were declared in the parent.
+// The field metadata of each struct type is resolved once and cached (see fieldsOf).
 //
 // Parameters:
 //   - ref: The reflect.Value of the struct to populate
-//   - prefix: The accumulated prefix for nested struct fields
+//   - prefix: The accumulated prefix for nested struct fields, starting with the root prefix
+//   - path: The path of the struct in the loaded value, used for the defaults of RegisterDefaultFunc
 //
 // Returns:
 //   - error: An aggregated error if any field mapping fails
@@ -690,46 +1489,56 @@ func addNestedPrefix(envName, prefix string) string {
 //	    } `env:"DB"`
 //	}
 //	// Will look for environment variables: DB_HOST, DB_PORT
-func mapStructFromEnvs(ref reflect.Value, prefix string) (err error) {
-	refType := ref.Type()
-	for index := 0; index < ref.NumField(); index++ {
-		field := refType.Field(index)
+func mapStructFromEnvs(ref reflect.Value, prefix, path string) (err error) {
+	for _, descriptor := range fieldsOf(ref.Type(), prefix) {
+		if failFast && err != nil {
+			return
+		}
 
-		tag := field.Tag.Get(tagEnv)
+		fieldRef := ref.Field(descriptor.index)
 
-		if tag == "-" {
+		if descriptor.kind == embeddedField {
+			err = errors.Join(err, mapStructFromEnvs(fieldRef, descriptor.key, path))
 			continue
 		}
 
-		fieldRef := ref.Field(index)
-
 		if !fieldRef.CanSet() {
 			continue
 		}
 
-		if fieldRef.Kind() == reflect.Struct {
-			err = errors.Join(err, mapStructFromEnvs(fieldRef, addNestedPrefix(tag, prefix)))
-		} else if fieldRef.Kind() == reflect.Pointer && fieldRef.Type().Elem().Kind() == reflect.Struct {
+		switch descriptor.kind {
+		case nestedField:
+			err = errors.Join(err, mapStructFromEnvs(fieldRef, descriptor.key, fieldPath(path, descriptor.field.Name)))
+		case nestedPointerField:
 			fieldRef.Set(reflect.New(fieldRef.Type().Elem()))
-			err = errors.Join(err, mapStructFromEnvs(fieldRef.Elem(), addNestedPrefix(tag, prefix)))
-		} else {
-			if tag == "" {
-				continue
-			}
+			err = errors.Join(err, mapStructFromEnvs(fieldRef.Elem(), descriptor.key, fieldPath(path, descriptor.field.Name)))
+		default:
+			field, key := descriptor.field, descriptor.key
 
 			// Try to get value from environment variable first
-			value, exists := os.LookupEnv(getPrefixedEnv(addNestedPrefix(tag, prefix)))
+			value, exists, lookupErr := lookupEnvOrFile(key, descriptor.fileKey)
+
+			if lookupErr != nil {
+				err = errors.Join(err, lookupErr)
+				continue
+			}
 
-			// If env var doesn't exist, try to use default tag value
+			// If env var doesn't exist, try to use the default tag value or the registered default function
 			if !exists {
-				value = field.Tag.Get(tagDefault)
-				// If no default either, skip this field
+				value = defaultValue(fieldPath(path, field.Name), descriptor.defaultValue)
+				// If no default either, skip this field unless it is required
 				if value == "" {
+					err = errors.Join(err, checkRequired(field, key))
 					continue
 				}
 			}
 
-			err = errors.Join(err, mapPrimaryValue(fieldRef, value))
+			if mapErr := mapFieldValue(field, fieldRef, value); mapErr != nil {
+				err = errors.Join(err, fieldError(field, key, mapErr))
+				continue
+			}
+
+			err = errors.Join(err, validateField(field, fieldRef))
 		}
 	}
 	return
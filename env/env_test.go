@@ -1,10 +1,15 @@
 package env
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -28,7 +33,7 @@ func TestEnvConfig(t *testing.T) {
 	conf, err := FromFile[sample](envFile)
 
 	t.Run("ErrorHandling", func(t *testing.T) {
-		if err != nil && err.Error() != "couldn't map dimensional arrays from .env" {
+		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
@@ -94,8 +99,9 @@ func TestEnvConfig(t *testing.T) {
 	})
 
 	t.Run("DimensionalArrayField", func(t *testing.T) {
-		if len(conf.Eighth) != 0 {
-			t.Errorf("Eighth length = %v, want 0", len(conf.Eighth))
+		eighthExpected := [][]string{{"1", "2"}, {"3", "4."}}
+		if !reflect.DeepEqual(conf.Eighth, eighthExpected) {
+			t.Errorf("Eighth = %v, want %v", conf.Eighth, eighthExpected)
 		}
 	})
 }
@@ -184,6 +190,169 @@ func TestJsonConfig(t *testing.T) {
 	})
 }
 
+func TestYamlConfig(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	currentDir := filepath.Dir(file)
+
+	type server struct {
+		Host   string         `json:"host"`
+		Ports  []int          `json:"ports"`
+		Labels map[string]any `json:"labels"`
+	}
+
+	type sample struct {
+		First   int      `json:"first"`
+		Second  string   `json:"second"`
+		Third   float64  `json:"third"`
+		Fourth  []int8   `json:"fourth"`
+		Fifth   bool     `json:"fifth"`
+		Sixth   []string `json:"sixth"`
+		Seventh []bool   `json:"seventh"`
+		Server  server   `json:"server"`
+	}
+
+	yamlConf, err := FromFile[sample](filepath.Join(currentDir, ".test.yaml"))
+
+	t.Run("NoError", func(t *testing.T) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("MatchesJsonConfig", func(t *testing.T) {
+		jsonConf, err := FromFile[sample](filepath.Join(currentDir, ".test.json"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		jsonConf.Server = yamlConf.Server
+		if !reflect.DeepEqual(yamlConf, jsonConf) {
+			t.Errorf("yaml config = %+v, want %+v", yamlConf, jsonConf)
+		}
+	})
+
+	t.Run("NestedStruct", func(t *testing.T) {
+		if yamlConf.Server.Host != "localhost" {
+			t.Errorf("Server.Host = %v, want localhost", yamlConf.Server.Host)
+		}
+
+		if !reflect.DeepEqual(yamlConf.Server.Ports, []int{80, 443}) {
+			t.Errorf("Server.Ports = %v, want [80 443]", yamlConf.Server.Ports)
+		}
+
+		if yamlConf.Server.Labels["1"] != "one" {
+			t.Errorf("Server.Labels = %v, want map[1:one]", yamlConf.Server.Labels)
+		}
+	})
+
+	t.Run("YmlExtension", func(t *testing.T) {
+		ymlFile := filepath.Join(t.TempDir(), "config.yml")
+		if err := os.WriteFile(ymlFile, []byte("first: 7\nsecond: yml\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		conf, err := FromFile[sample](ymlFile)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conf.First != 7 || conf.Second != "yml" {
+			t.Errorf("conf = %+v, want First 7 and Second yml", conf)
+		}
+	})
+
+	t.Run("InvalidYaml", func(t *testing.T) {
+		invalidFile := filepath.Join(t.TempDir(), "invalid.yaml")
+		if err := os.WriteFile(invalidFile, []byte("first: [1, 2\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := FromFile[sample](invalidFile); err == nil {
+			t.Error("expected error for invalid yaml")
+		}
+	})
+}
+
+func TestFromFile_StructSlice(t *testing.T) {
+	type Server struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	}
+
+	dir := t.TempDir()
+	expected := []Server{{Host: "alpha", Port: 8080}, {Host: "beta", Port: 8081}}
+
+	t.Run("JSONArray", func(t *testing.T) {
+		file := filepath.Join(dir, "servers.json")
+		content := `[{"host": "alpha", "port": 8080}, {"host": "beta", "port": 8081}]`
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		servers, err := FromFile[[]Server](file)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(*servers, expected) {
+			t.Errorf("servers = %+v, want %+v", *servers, expected)
+		}
+	})
+
+	t.Run("YAMLArrayOfPointers", func(t *testing.T) {
+		file := filepath.Join(dir, "servers.yaml")
+		content := "- host: alpha\n  port: 8080\n- host: beta\n  port: 8081\n"
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		servers, err := FromFile[[]*Server](file)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(*servers) != 2 || *(*servers)[0] != expected[0] || *(*servers)[1] != expected[1] {
+			t.Errorf("servers = %+v, want %+v", *servers, expected)
+		}
+	})
+
+	t.Run("NestedArray", func(t *testing.T) {
+		type Proxy struct {
+			Name      string   `json:"name"`
+			Upstreams []Server `json:"upstreams"`
+		}
+
+		file := filepath.Join(dir, "proxy.yml")
+		content := "name: edge\nupstreams:\n  - host: alpha\n    port: 8080\n  - host: beta\n    port: 8081\n"
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		proxy, err := FromFile[Proxy](file)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if proxy.Name != "edge" || !reflect.DeepEqual(proxy.Upstreams, expected) {
+			t.Errorf("proxy = %+v, want edge with %+v", proxy, expected)
+		}
+	})
+
+	t.Run("EnvFileRejected", func(t *testing.T) {
+		file := filepath.Join(dir, "servers.env")
+		if err := os.WriteFile(file, []byte("HOST=alpha\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := FromFile[[]Server](file); err == nil {
+			t.Error("expected error for a slice loaded from a .env file")
+		}
+	})
+
+	t.Run("SliceOfScalarsRejected", func(t *testing.T) {
+		if _, err := FromFile[[]int](filepath.Join(dir, "servers.json")); err == nil {
+			t.Error("expected error for a slice of non-struct elements")
+		}
+	})
+}
+
 func TestEnvConfigWithLoad(t *testing.T) {
 	_, file, _, _ := runtime.Caller(0)
 	currentDir := filepath.Dir(file)
@@ -211,7 +380,7 @@ func TestEnvConfigWithLoad(t *testing.T) {
 	conf, err := FromEnvs[sample]()
 
 	t.Run("ErrorHandling", func(t *testing.T) {
-		if err != nil && err.Error() != "couldn't map dimensional arrays from .env" {
+		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
@@ -277,8 +446,9 @@ func TestEnvConfigWithLoad(t *testing.T) {
 	})
 
 	t.Run("DimensionalArrayField", func(t *testing.T) {
-		if len(conf.Eighth) != 0 {
-			t.Errorf("Eighth length = %v, want 0", len(conf.Eighth))
+		eighthExpected := [][]string{{"1", "2"}, {"3", "4."}}
+		if !reflect.DeepEqual(conf.Eighth, eighthExpected) {
+			t.Errorf("Eighth = %v, want %v", conf.Eighth, eighthExpected)
 		}
 	})
 }
@@ -306,9 +476,9 @@ func TestCanConvertFromEnv(t *testing.T) {
 		{"Float64", reflect.Float64, true},
 		{"Bool", reflect.Bool, true},
 		{"Slice", reflect.Slice, true},
+		{"Map", reflect.Map, true},
 
 		// Unsupported types
-		{"Map", reflect.Map, false},
 		{"Struct", reflect.Struct, false},
 		{"Ptr", reflect.Ptr, false},
 		{"Interface", reflect.Interface, false},
@@ -544,6 +714,102 @@ func TestGetEnvDuration(t *testing.T) {
 	})
 }
 
+// TestGetEnvRequired tests the GetEnvRequired and GetEnvRequiredAs functions
+func TestGetEnvRequired(t *testing.T) {
+	originalPrefix := GetEnvPrefix()
+	defer func() {
+		SetEnvPrefix(originalPrefix)
+		os.Unsetenv("TEST_REQUIRED")
+		os.Unsetenv("APP_TEST_REQUIRED")
+	}()
+	SetEnvPrefix("")
+
+	t.Run("GetEnvRequired_Present", func(t *testing.T) {
+		os.Setenv("TEST_REQUIRED", "value")
+		result, err := GetEnvRequired("TEST_REQUIRED")
+		if err != nil || result != "value" {
+			t.Errorf("GetEnvRequired = %q, %v, want 'value'", result, err)
+		}
+	})
+
+	t.Run("GetEnvRequired_Empty", func(t *testing.T) {
+		os.Setenv("TEST_REQUIRED", "")
+		if result, err := GetEnvRequired("TEST_REQUIRED"); err != nil || result != "" {
+			t.Errorf("GetEnvRequired with empty value = %q, %v, want no error", result, err)
+		}
+	})
+
+	t.Run("GetEnvRequired_Missing", func(t *testing.T) {
+		os.Unsetenv("TEST_REQUIRED")
+		_, err := GetEnvRequired("TEST_REQUIRED")
+		if !errors.Is(err, ErrEnvNotSet) || !strings.Contains(err.Error(), "TEST_REQUIRED") {
+			t.Errorf("GetEnvRequired with missing var = %v, want ErrEnvNotSet naming the variable", err)
+		}
+	})
+
+	t.Run("GetEnvRequiredAs_Present", func(t *testing.T) {
+		os.Setenv("TEST_REQUIRED", "8080")
+		if result, err := GetEnvRequiredAs[int]("TEST_REQUIRED"); err != nil || result != 8080 {
+			t.Errorf("GetEnvRequiredAs[int] = %v, %v, want 8080", result, err)
+		}
+
+		os.Setenv("TEST_REQUIRED", "1,2,3")
+		if result, err := GetEnvRequiredAs[[]int]("TEST_REQUIRED"); err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("GetEnvRequiredAs[[]int] = %v, %v, want [1 2 3]", result, err)
+		}
+
+		os.Setenv("TEST_REQUIRED", "90s")
+		if result, err := GetEnvRequiredAs[time.Duration]("TEST_REQUIRED"); err != nil || result != 90*time.Second {
+			t.Errorf("GetEnvRequiredAs[time.Duration] = %v, %v, want 1m30s", result, err)
+		}
+	})
+
+	t.Run("GetEnvRequiredAs_Missing", func(t *testing.T) {
+		os.Unsetenv("TEST_REQUIRED")
+		result, err := GetEnvRequiredAs[int]("TEST_REQUIRED")
+		if !errors.Is(err, ErrEnvNotSet) || result != 0 {
+			t.Errorf("GetEnvRequiredAs with missing var = %v, %v, want ErrEnvNotSet", result, err)
+		}
+	})
+
+	t.Run("GetEnvRequiredAs_Malformed", func(t *testing.T) {
+		os.Setenv("TEST_REQUIRED", "not_a_number")
+		result, err := GetEnvRequiredAs[int]("TEST_REQUIRED")
+		if err == nil || errors.Is(err, ErrEnvNotSet) || result != 0 {
+			t.Errorf("GetEnvRequiredAs with malformed value = %v, %v, want a conversion error", result, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "TEST_REQUIRED") {
+			t.Errorf("Expected the error to name the variable, got %v", err)
+		}
+	})
+
+	t.Run("GetEnvRequiredAs_UnsupportedType", func(t *testing.T) {
+		os.Setenv("TEST_REQUIRED", "value")
+		if _, err := GetEnvRequiredAs[struct{ Name string }]("TEST_REQUIRED"); err == nil {
+			t.Error("GetEnvRequiredAs with a struct type should return an error")
+		}
+	})
+
+	t.Run("GetEnvRequired_Prefix", func(t *testing.T) {
+		os.Unsetenv("TEST_REQUIRED")
+		os.Setenv("APP_TEST_REQUIRED", "42")
+		SetEnvPrefix("APP")
+		defer SetEnvPrefix("")
+
+		if result, err := GetEnvRequired("TEST_REQUIRED"); err != nil || result != "42" {
+			t.Errorf("GetEnvRequired with prefix = %q, %v, want '42'", result, err)
+		}
+		if result, err := GetEnvRequiredAs[int]("TEST_REQUIRED"); err != nil || result != 42 {
+			t.Errorf("GetEnvRequiredAs with prefix = %v, %v, want 42", result, err)
+		}
+
+		os.Unsetenv("APP_TEST_REQUIRED")
+		if _, err := GetEnvRequired("TEST_REQUIRED"); err == nil || !strings.Contains(err.Error(), "APP_TEST_REQUIRED") {
+			t.Errorf("Expected the error to name the prefixed variable, got %v", err)
+		}
+	})
+}
+
 // TestGetEnvAs_EdgeCases tests edge cases for GetEnvAs
 func TestGetEnvAs_EdgeCases(t *testing.T) {
 	defer func() {
@@ -2174,3 +2440,1137 @@ func TestPrefix_NotPrefixedDeepStructs(t *testing.T) {
 		}
 	})
 }
+
+// TestMapFields tests mapping of comma-separated key=value pairs into map fields
+func TestMapFields(t *testing.T) {
+	defer func() {
+		os.Unsetenv("FLAGS")
+		os.Unsetenv("LIMITS")
+		os.Unsetenv("WEIGHTS")
+	}()
+
+	type Config struct {
+		Flags   map[string]bool    `env:"FLAGS"`
+		Limits  map[string]int     `env:"LIMITS"`
+		Weights map[string]float64 `env:"WEIGHTS"`
+		Labels  map[string]string  `env:"LABELS" default:"team=core,tier=backend"`
+	}
+
+	t.Run("FromEnvs", func(t *testing.T) {
+		os.Setenv("FLAGS", "beta=true,legacy=false")
+		os.Setenv("LIMITS", "a=1,b=2")
+		os.Setenv("WEIGHTS", "x=0.5")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(config.Flags, map[string]bool{"beta": true, "legacy": false}) {
+			t.Errorf("Flags = %v, want map[beta:true legacy:false]", config.Flags)
+		}
+
+		if !reflect.DeepEqual(config.Limits, map[string]int{"a": 1, "b": 2}) {
+			t.Errorf("Limits = %v, want map[a:1 b:2]", config.Limits)
+		}
+
+		if !reflect.DeepEqual(config.Weights, map[string]float64{"x": 0.5}) {
+			t.Errorf("Weights = %v, want map[x:0.5]", config.Weights)
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(config.Labels, map[string]string{"team": "core", "tier": "backend"}) {
+			t.Errorf("Labels = %v, want map[team:core tier:backend]", config.Labels)
+		}
+	})
+
+	t.Run("ValueWithSeparator", func(t *testing.T) {
+		result, err := mapMapValue(reflect.TypeOf(map[string]string{}), "url=a=b")
+		if err != nil {
+			t.Fatalf("mapMapValue failed: %v", err)
+		}
+
+		if result.Interface().(map[string]string)["url"] != "a=b" {
+			t.Errorf("url = %v, want a=b", result.Interface())
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		os.Setenv("LIMITS", "a=1,b=two")
+
+		_, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected error for invalid map value")
+		}
+
+		if !strings.Contains(err.Error(), `couldn't map value "two" of key "b"`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("MissingSeparator", func(t *testing.T) {
+		os.Setenv("LIMITS", "a=1,b")
+
+		_, err := FromEnvs[Config]()
+		if err == nil || !strings.Contains(err.Error(), `couldn't map pair "b"`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		_, err := mapMapValue(reflect.TypeOf(map[int]string{}), "one=a")
+		if err == nil || !strings.Contains(err.Error(), `couldn't map key "one"`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+// TestDimensionalSlices tests mapping of semicolon-separated groups into two-dimensional slices
+func TestDimensionalSlices(t *testing.T) {
+	defer func() {
+		os.Unsetenv("MATRIX")
+		os.Unsetenv("SWITCHES")
+		os.Unsetenv("CUBE")
+	}()
+
+	type Config struct {
+		Matrix   [][]int    `env:"MATRIX"`
+		Switches [][]bool   `env:"SWITCHES"`
+		Names    [][]string `env:"NAMES" default:"a,b;c"`
+	}
+
+	t.Run("ParsesGroups", func(t *testing.T) {
+		os.Setenv("MATRIX", "1,2;3,4;5")
+		os.Setenv("SWITCHES", "true,false;1")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(config.Matrix, [][]int{{1, 2}, {3, 4}, {5}}) {
+			t.Errorf("Matrix = %v, want [[1 2] [3 4] [5]]", config.Matrix)
+		}
+
+		if !reflect.DeepEqual(config.Switches, [][]bool{{true, false}, {true}}) {
+			t.Errorf("Switches = %v, want [[true false] [true]]", config.Switches)
+		}
+
+		if !reflect.DeepEqual(config.Names, [][]string{{"a", "b"}, {"c"}}) {
+			t.Errorf("Names = %v, want [[a b] [c]]", config.Names)
+		}
+	})
+
+	t.Run("InvalidItem", func(t *testing.T) {
+		os.Setenv("MATRIX", "1,x;3")
+
+		if _, err := FromEnvs[Config](); err == nil {
+			t.Error("expected error for invalid item")
+		}
+	})
+
+	t.Run("DeeperDimensions", func(t *testing.T) {
+		type Cube struct {
+			Cube [][][]int `env:"CUBE"`
+		}
+		os.Setenv("CUBE", "1,2;3")
+
+		config, err := FromEnvs[Cube]()
+		if err == nil || !strings.Contains(err.Error(), "couldn't map dimensional arrays from .env") {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if len(config.Cube) != 0 {
+			t.Errorf("Cube length = %v, want 0", len(config.Cube))
+		}
+	})
+}
+
+// TestRequiredTag tests that required fields without a value or default are reported
+func TestRequiredTag(t *testing.T) {
+	defer func() {
+		os.Unsetenv("DB_HOST")
+		os.Unsetenv("DB_PASSWORD")
+		os.Unsetenv("APP_DB_HOST")
+		SetEnvPrefix("")
+	}()
+
+	type Database struct {
+		Host     string `env:"HOST" required:"true"`
+		Password string `env:"PASSWORD" required:"true"`
+		Port     int    `env:"PORT" required:"true" default:"5432"`
+		Name     string `env:"NAME" required:"false"`
+	}
+
+	type Config struct {
+		Database Database `env:"DB"`
+	}
+
+	t.Run("MissingRequired", func(t *testing.T) {
+		_, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected error for missing required fields")
+		}
+
+		for _, expected := range []string{
+			"required field Host is missing: DB_HOST is not set",
+			"required field Password is missing: DB_PASSWORD is not set",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("error %q does not contain %q", err, expected)
+			}
+		}
+
+		if strings.Contains(err.Error(), "Port") || strings.Contains(err.Error(), "Name") {
+			t.Errorf("error %q reports fields that are not missing", err)
+		}
+	})
+
+	t.Run("PresentRequired", func(t *testing.T) {
+		os.Setenv("DB_HOST", "db.local")
+		os.Setenv("DB_PASSWORD", "secret")
+		defer os.Unsetenv("DB_PASSWORD")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Database.Host != "db.local" || config.Database.Password != "secret" {
+			t.Errorf("Database = %+v, want Host db.local and Password secret", config.Database)
+		}
+	})
+
+	t.Run("RequiredWithDefault", func(t *testing.T) {
+		os.Setenv("DB_PASSWORD", "secret")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Database.Port != 5432 {
+			t.Errorf("Database.Port = %v, want 5432", config.Database.Port)
+		}
+	})
+
+	t.Run("MissingRequiredWithPrefix", func(t *testing.T) {
+		SetEnvPrefix("APP")
+		os.Unsetenv("DB_HOST")
+
+		_, err := FromEnvs[Config]()
+		if err == nil || !strings.Contains(err.Error(), "APP_DB_HOST is not set") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("FromFile", func(t *testing.T) {
+		SetEnvPrefix("")
+		envFile := filepath.Join(t.TempDir(), "required.env")
+		if err := os.WriteFile(envFile, []byte("HOST=db.local\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		type Flat struct {
+			Host     string `env:"HOST" required:"true"`
+			Password string `env:"PASSWORD" required:"true"`
+			Port     int    `env:"PORT" required:"true" default:"5432"`
+		}
+
+		config, err := FromFile[Flat](envFile)
+		if err == nil || err.Error() != "required field Password is missing: PASSWORD is not set" {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if config.Host != "db.local" || config.Port != 5432 {
+			t.Errorf("config = %+v, want Host db.local and Port 5432", config)
+		}
+	})
+}
+
+// TestTimeFields tests mapping of time.Time fields with the default and custom layouts
+func TestTimeFields(t *testing.T) {
+	defer func() {
+		os.Unsetenv("STARTED_AT")
+		os.Unsetenv("CUTOFF_DATE")
+		os.Unsetenv("SCHEDULE_AT")
+	}()
+
+	type Schedule struct {
+		At time.Time `env:"AT" layout:"15:04"`
+	}
+
+	type Config struct {
+		StartedAt time.Time   `env:"STARTED_AT"`
+		Cutoff    time.Time   `env:"CUTOFF_DATE" layout:"2006-01-02" default:"2030-01-01"`
+		Schedule  Schedule    `env:"SCHEDULE"`
+		Holidays  []time.Time `env:"HOLIDAYS" default:"2024-12-25T00:00:00Z,2025-01-01T00:00:00Z"`
+	}
+
+	t.Run("DefaultLayout", func(t *testing.T) {
+		os.Setenv("STARTED_AT", "2024-03-15T10:30:00Z")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		expected := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+		if !config.StartedAt.Equal(expected) {
+			t.Errorf("StartedAt = %v, want %v", config.StartedAt, expected)
+		}
+
+		if len(config.Holidays) != 2 || config.Holidays[1].Year() != 2025 {
+			t.Errorf("Holidays = %v, want two dates ending in 2025", config.Holidays)
+		}
+	})
+
+	t.Run("CustomLayout", func(t *testing.T) {
+		os.Setenv("CUTOFF_DATE", "2025-06-30")
+		os.Setenv("SCHEDULE_AT", "08:45")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !config.Cutoff.Equal(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Cutoff = %v, want 2025-06-30", config.Cutoff)
+		}
+
+		if config.Schedule.At.Hour() != 8 || config.Schedule.At.Minute() != 45 {
+			t.Errorf("Schedule.At = %v, want 08:45", config.Schedule.At)
+		}
+	})
+
+	t.Run("DefaultWithCustomLayout", func(t *testing.T) {
+		os.Unsetenv("CUTOFF_DATE")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !config.Cutoff.Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Cutoff = %v, want 2030-01-01", config.Cutoff)
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		os.Setenv("STARTED_AT", "2024-03-15")
+
+		config, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected error for value not matching RFC3339")
+		}
+
+		if !config.StartedAt.IsZero() {
+			t.Errorf("StartedAt = %v, want zero time", config.StartedAt)
+		}
+	})
+}
+
+// TestNetworkFields tests parsing net.IP and net.IPNet fields and slices of them
+func TestNetworkFields(t *testing.T) {
+	type Config struct {
+		Bind      net.IP       `env:"BIND" default:"0.0.0.0"`
+		Upstream  *net.IP      `env:"UPSTREAM"`
+		Subnet    *net.IPNet   `env:"SUBNET" default:"10.0.0.0/8"`
+		Allowlist []*net.IPNet `env:"ALLOWLIST"`
+		Peers     []net.IP     `env:"PEERS"`
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !config.Bind.Equal(net.IPv4zero) {
+			t.Errorf("Bind = %v, want 0.0.0.0", config.Bind)
+		}
+		if config.Upstream != nil {
+			t.Errorf("Upstream = %v, want nil", config.Upstream)
+		}
+		if config.Subnet == nil || config.Subnet.String() != "10.0.0.0/8" {
+			t.Errorf("Subnet = %v, want 10.0.0.0/8", config.Subnet)
+		}
+	})
+
+	t.Run("IPv4AndIPv6", func(t *testing.T) {
+		t.Setenv("BIND", "192.168.1.10")
+		t.Setenv("UPSTREAM", "2001:db8::1")
+		t.Setenv("PEERS", "10.0.0.1, ::1")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !config.Bind.Equal(net.ParseIP("192.168.1.10")) || config.Bind.To4() == nil {
+			t.Errorf("Bind = %v, want IPv4 192.168.1.10", config.Bind)
+		}
+		if config.Upstream == nil || !config.Upstream.Equal(net.ParseIP("2001:db8::1")) {
+			t.Errorf("Upstream = %v, want 2001:db8::1", config.Upstream)
+		}
+		if len(config.Peers) != 2 || !config.Peers[1].Equal(net.IPv6loopback) {
+			t.Errorf("Peers = %v, want [10.0.0.1 ::1]", config.Peers)
+		}
+	})
+
+	t.Run("CIDR", func(t *testing.T) {
+		t.Setenv("SUBNET", "172.16.5.4/12")
+		t.Setenv("ALLOWLIST", "192.168.0.0/16,fd00::/8")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Subnet.String() != "172.16.0.0/12" {
+			t.Errorf("Subnet = %v, want the network 172.16.0.0/12", config.Subnet)
+		}
+		if len(config.Allowlist) != 2 || !config.Allowlist[0].Contains(net.ParseIP("192.168.4.2")) ||
+			!config.Allowlist[1].Contains(net.ParseIP("fd12::1")) {
+			t.Errorf("Allowlist = %v, want [192.168.0.0/16 fd00::/8]", config.Allowlist)
+		}
+	})
+
+	t.Run("FromFile", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "network.env")
+		if err := os.WriteFile(path, []byte("BIND=127.0.0.1\nALLOWLIST=10.1.0.0/16\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := FromFile[Config](path)
+		if err != nil {
+			t.Fatalf("FromFile failed: %v", err)
+		}
+
+		if !config.Bind.Equal(net.IPv4(127, 0, 0, 1)) || len(config.Allowlist) != 1 {
+			t.Errorf("config = %+v, want Bind 127.0.0.1 and one allowed network", *config)
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		t.Setenv("BIND", "256.0.0.1")
+		t.Setenv("SUBNET", "10.0.0.0/33")
+
+		_, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected error for malformed addresses")
+		}
+
+		message := err.Error()
+		if !strings.Contains(message, `invalid IP address "256.0.0.1"`) || !strings.Contains(message, `invalid CIDR "10.0.0.0/33"`) {
+			t.Errorf("expected both malformed values to be reported, got %v", err)
+		}
+	})
+}
+
+// TestFileSuffix tests loading values from files referenced by variables with the _FILE suffix
+func TestFileSuffix(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "db_password")
+	if err := os.WriteFile(secret, []byte("  s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		os.Unsetenv("DB_PASSWORD")
+		os.Unsetenv("DB_PASSWORD_FILE")
+		os.Unsetenv("APP_DB_PASSWORD_FILE")
+		SetEnvPrefix("")
+	}()
+
+	type Database struct {
+		Password string `env:"PASSWORD" required:"true"`
+	}
+
+	type Config struct {
+		Database Database `env:"DB"`
+	}
+
+	t.Run("ReadsFile", func(t *testing.T) {
+		os.Setenv("DB_PASSWORD_FILE", secret)
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Database.Password != "s3cr3t" {
+			t.Errorf("Database.Password = %q, want s3cr3t", config.Database.Password)
+		}
+	})
+
+	t.Run("VariableWins", func(t *testing.T) {
+		os.Setenv("DB_PASSWORD", "direct")
+		defer os.Unsetenv("DB_PASSWORD")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Database.Password != "direct" {
+			t.Errorf("Database.Password = %q, want direct", config.Database.Password)
+		}
+	})
+
+	t.Run("WithPrefix", func(t *testing.T) {
+		os.Unsetenv("DB_PASSWORD_FILE")
+		os.Setenv("APP_DB_PASSWORD_FILE", secret)
+		SetEnvPrefix("APP")
+		defer SetEnvPrefix("")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Database.Password != "s3cr3t" {
+			t.Errorf("Database.Password = %q, want s3cr3t", config.Database.Password)
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		os.Setenv("DB_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		_, err := FromEnvs[Config]()
+		if err == nil || !strings.Contains(err.Error(), "couldn't read DB_PASSWORD_FILE") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+// TestFileSuffix_FromFile tests the _FILE suffix for keys of .env files
+func TestFileSuffix_FromFile(t *testing.T) {
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "db_password")
+	if err := os.WriteFile(secret, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type Config struct {
+		Password string `env:"DB_PASSWORD" required:"true"`
+		User     string `env:"DB_USER"`
+	}
+
+	load := func(t *testing.T, content string) (*Config, error) {
+		t.Helper()
+
+		filename := filepath.Join(t.TempDir(), "config.env")
+		if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return FromFile[Config](filename)
+	}
+
+	t.Run("ReadsFile", func(t *testing.T) {
+		config, err := load(t, "DB_PASSWORD_FILE="+secret+"\nDB_USER=admin\n")
+		if err != nil {
+			t.Fatalf("FromFile failed: %v", err)
+		}
+
+		if config.Password != "s3cr3t" || config.User != "admin" {
+			t.Errorf("config = %+v, want the password from the file", *config)
+		}
+	})
+
+	t.Run("KeyWins", func(t *testing.T) {
+		config, err := load(t, "DB_PASSWORD=direct\nDB_PASSWORD_FILE="+secret+"\n")
+		if err != nil {
+			t.Fatalf("FromFile failed: %v", err)
+		}
+
+		if config.Password != "direct" {
+			t.Errorf("Password = %q, want direct", config.Password)
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := load(t, "DB_PASSWORD_FILE="+filepath.Join(dir, "missing")+"\n")
+		if err == nil || !strings.Contains(err.Error(), "couldn't read DB_PASSWORD_FILE") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+// TestSeparatorTag tests custom slice separators, trimming, and empty trailing items
+func TestSeparatorTag(t *testing.T) {
+	defer func() {
+		os.Unsetenv("HEADERS")
+		os.Unsetenv("PORTS")
+		os.Unsetenv("HOSTS")
+		os.Unsetenv("GRID")
+	}()
+
+	type Config struct {
+		Headers []string `env:"HEADERS" sep:"|"`
+		Ports   []int    `env:"PORTS" sep:";"`
+		Hosts   []string `env:"HOSTS"`
+		Grid    [][]int  `env:"GRID" sep:"|"`
+	}
+
+	os.Setenv("HEADERS", "id,name | email,phone |")
+	os.Setenv("PORTS", " 80; 443 ;8080;;")
+	os.Setenv("HOSTS", "a, b ,c,")
+	os.Setenv("GRID", "1|2;3|4")
+
+	config, err := FromEnvs[Config]()
+	if err != nil {
+		t.Fatalf("FromEnvs failed: %v", err)
+	}
+
+	t.Run("PipeDelimited", func(t *testing.T) {
+		if !reflect.DeepEqual(config.Headers, []string{"id,name", "email,phone"}) {
+			t.Errorf("Headers = %q, want [id,name email,phone]", config.Headers)
+		}
+	})
+
+	t.Run("SemicolonDelimited", func(t *testing.T) {
+		if !reflect.DeepEqual(config.Ports, []int{80, 443, 8080}) {
+			t.Errorf("Ports = %v, want [80 443 8080]", config.Ports)
+		}
+	})
+
+	t.Run("DefaultSeparatorTrims", func(t *testing.T) {
+		if !reflect.DeepEqual(config.Hosts, []string{"a", "b", "c"}) {
+			t.Errorf("Hosts = %q, want [a b c]", config.Hosts)
+		}
+	})
+
+	t.Run("DimensionalWithSeparator", func(t *testing.T) {
+		if !reflect.DeepEqual(config.Grid, [][]int{{1, 2}, {3, 4}}) {
+			t.Errorf("Grid = %v, want [[1 2] [3 4]]", config.Grid)
+		}
+	})
+
+	t.Run("ToMapUsesSeparator", func(t *testing.T) {
+		if value := ToMap(config, false)["HEADERS"]; value != "id,name|email,phone" {
+			t.Errorf("HEADERS = %q, want id,name|email,phone", value)
+		}
+	})
+}
+
+// TestGetEnvSliceAs tests the GetEnvSliceAs function with custom separators
+func TestGetEnvSliceAs(t *testing.T) {
+	defer os.Unsetenv("TEST_SLICE")
+
+	t.Run("Pipe", func(t *testing.T) {
+		os.Setenv("TEST_SLICE", "a,1 | b,2|")
+
+		result := GetEnvSliceAs("TEST_SLICE", "|", []string{"fallback"})
+		if !reflect.DeepEqual(result, []string{"a,1", "b,2"}) {
+			t.Errorf("GetEnvSliceAs() = %q, want [a,1 b,2]", result)
+		}
+	})
+
+	t.Run("Semicolon", func(t *testing.T) {
+		os.Setenv("TEST_SLICE", "1;2;3")
+
+		result := GetEnvSliceAs("TEST_SLICE", ";", []int{0})
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("GetEnvSliceAs() = %v, want [1 2 3]", result)
+		}
+	})
+
+	t.Run("InvalidItem", func(t *testing.T) {
+		os.Setenv("TEST_SLICE", "1;x")
+
+		result := GetEnvSliceAs("TEST_SLICE", ";", []int{0})
+		if !reflect.DeepEqual(result, []int{0}) {
+			t.Errorf("GetEnvSliceAs() = %v, want fallback [0]", result)
+		}
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		os.Unsetenv("TEST_SLICE")
+
+		result := GetEnvSliceAs("TEST_SLICE", ";", []int{7})
+		if !reflect.DeepEqual(result, []int{7}) {
+			t.Errorf("GetEnvSliceAs() = %v, want fallback [7]", result)
+		}
+	})
+}
+
+// TestPointerFields tests that pointer-to-primitive fields stay nil when unset
+func TestPointerFields(t *testing.T) {
+	defer func() {
+		os.Unsetenv("WORKERS")
+		os.Unsetenv("DEBUG")
+		os.Unsetenv("STARTED_AT")
+	}()
+
+	type Config struct {
+		Workers   *int       `env:"WORKERS" validate:"min=1"`
+		Debug     *bool      `env:"DEBUG"`
+		Name      *string    `env:"NAME" default:"service"`
+		StartedAt *time.Time `env:"STARTED_AT" layout:"2006-01-02"`
+	}
+
+	t.Run("Unset", func(t *testing.T) {
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Workers != nil || config.Debug != nil || config.StartedAt != nil {
+			t.Errorf("config = %+v, want nil Workers, Debug and StartedAt", config)
+		}
+
+		if config.Name == nil || *config.Name != "service" {
+			t.Errorf("Name = %v, want pointer to service", config.Name)
+		}
+
+		if _, exists := ToMap(config, false)["WORKERS"]; exists {
+			t.Error("ToMap() exports unset pointer field WORKERS")
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		os.Setenv("WORKERS", "0")
+		os.Setenv("DEBUG", "false")
+		os.Setenv("STARTED_AT", "2024-05-01")
+
+		config, err := FromEnvs[Config]()
+		if err == nil || !strings.Contains(err.Error(), `field Workers: value "0" is less than min 1`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if config.Workers == nil || *config.Workers != 0 {
+			t.Errorf("Workers = %v, want pointer to 0", config.Workers)
+		}
+
+		if config.Debug == nil || *config.Debug {
+			t.Errorf("Debug = %v, want pointer to false", config.Debug)
+		}
+
+		if config.StartedAt == nil || config.StartedAt.Month() != time.May {
+			t.Errorf("StartedAt = %v, want pointer to 2024-05-01", config.StartedAt)
+		}
+
+		if value := ToMap(config, false)["WORKERS"]; value != "0" {
+			t.Errorf("WORKERS = %q, want 0", value)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		os.Setenv("WORKERS", "many")
+
+		config, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected error for invalid value")
+		}
+
+		if config.Workers != nil {
+			t.Errorf("Workers = %v, want nil", config.Workers)
+		}
+	})
+
+	t.Run("GetEnvAs", func(t *testing.T) {
+		os.Setenv("WORKERS", "4")
+
+		workers := GetEnvAs[*int]("WORKERS", nil)
+		if workers == nil || *workers != 4 {
+			t.Errorf("GetEnvAs[*int]() = %v, want pointer to 4", workers)
+		}
+
+		if missing := GetEnvAs[*int]("MISSING_WORKERS", nil); missing != nil {
+			t.Errorf("GetEnvAs[*int]() = %v, want nil", missing)
+		}
+	})
+}
+
+// TestFromEnvsWithPrefix tests loading configurations with explicit prefixes
+func TestFromEnvsWithPrefix(t *testing.T) {
+	defer func() {
+		os.Unsetenv("BILLING_PORT")
+		os.Unsetenv("BILLING_DB_HOST")
+		os.Unsetenv("SHIPPING_PORT")
+		os.Unsetenv("SHIPPING_DB_HOST")
+		SetEnvPrefix("")
+	}()
+
+	type Database struct {
+		Host string `env:"HOST"`
+	}
+
+	type ServiceConfig struct {
+		Port     int      `env:"PORT"`
+		Database Database `env:"DB"`
+	}
+
+	os.Setenv("BILLING_PORT", "8081")
+	os.Setenv("BILLING_DB_HOST", "billing-db")
+	os.Setenv("SHIPPING_PORT", "8082")
+	os.Setenv("SHIPPING_DB_HOST", "shipping-db")
+
+	t.Run("DifferentPrefixes", func(t *testing.T) {
+		billing, err := FromEnvsWithPrefix[ServiceConfig]("BILLING")
+		if err != nil {
+			t.Fatalf("FromEnvsWithPrefix failed: %v", err)
+		}
+
+		shipping, err := FromEnvsWithPrefix[ServiceConfig]("SHIPPING")
+		if err != nil {
+			t.Fatalf("FromEnvsWithPrefix failed: %v", err)
+		}
+
+		if billing.Port != 8081 || billing.Database.Host != "billing-db" {
+			t.Errorf("billing = %+v, want Port 8081 and Host billing-db", billing)
+		}
+
+		if shipping.Port != 8082 || shipping.Database.Host != "shipping-db" {
+			t.Errorf("shipping = %+v, want Port 8082 and Host shipping-db", shipping)
+		}
+	})
+
+	t.Run("IgnoresGlobalPrefix", func(t *testing.T) {
+		SetEnvPrefix("SHIPPING")
+		defer SetEnvPrefix("")
+
+		billing, err := FromEnvsWithPrefix[ServiceConfig]("BILLING")
+		if err != nil {
+			t.Fatalf("FromEnvsWithPrefix failed: %v", err)
+		}
+
+		if billing.Port != 8081 {
+			t.Errorf("billing.Port = %v, want 8081", billing.Port)
+		}
+
+		if GetEnvPrefix() != "SHIPPING" {
+			t.Errorf("GetEnvPrefix() = %v, want SHIPPING", GetEnvPrefix())
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		expected := map[string]int{"BILLING": 8081, "SHIPPING": 8082}
+
+		var wg sync.WaitGroup
+		for index := 0; index < 10; index++ {
+			for prefix, port := range expected {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					config, err := FromEnvsWithPrefix[ServiceConfig](prefix)
+					if err != nil || config.Port != port {
+						t.Errorf("FromEnvsWithPrefix(%s) = %+v, %v, want Port %d", prefix, config, err, port)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+	})
+
+	t.Run("FromFileWithPrefix", func(t *testing.T) {
+		envFile := filepath.Join(t.TempDir(), "services.env")
+		content := "BILLING_PORT=9081\nSHIPPING_PORT=9082\n"
+		if err := os.WriteFile(envFile, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		billing, err := FromFileWithPrefix[ServiceConfig](envFile, "BILLING")
+		if err != nil {
+			t.Fatalf("FromFileWithPrefix failed: %v", err)
+		}
+
+		shipping, err := FromFileWithPrefix[ServiceConfig](envFile, "SHIPPING")
+		if err != nil {
+			t.Fatalf("FromFileWithPrefix failed: %v", err)
+		}
+
+		if billing.Port != 9081 || shipping.Port != 9082 {
+			t.Errorf("ports = %v and %v, want 9081 and 9082", billing.Port, shipping.Port)
+		}
+	})
+}
+
+// BaseConfig is an exported configuration embedded by TestEmbeddedStructs
+type BaseConfig struct {
+	Port int    `env:"PORT" default:"8080"`
+	Name string `env:"NAME"`
+}
+
+// loggingConfig is an unexported configuration embedded by TestEmbeddedStructs
+type loggingConfig struct {
+	Level string `env:"LOG_LEVEL" default:"info"`
+}
+
+// TestEmbeddedStructs tests that embedded structs are promoted to the parent's namespace
+func TestEmbeddedStructs(t *testing.T) {
+	defer func() {
+		os.Unsetenv("PORT")
+		os.Unsetenv("NAME")
+		os.Unsetenv("LOG_LEVEL")
+		os.Unsetenv("DB_HOST")
+		os.Unsetenv("DB_PORT")
+		os.Unsetenv("EXTRA")
+	}()
+
+	type Database struct {
+		BaseConfig
+		Host string `env:"HOST"`
+	}
+
+	type Config struct {
+		BaseConfig
+		loggingConfig
+		Database Database `env:"DB"`
+		Extra    string   `env:"EXTRA"`
+	}
+
+	os.Setenv("PORT", "9090")
+	os.Setenv("NAME", "api")
+	os.Setenv("LOG_LEVEL", "debug")
+	os.Setenv("DB_HOST", "db.local")
+	os.Setenv("DB_PORT", "5432")
+	os.Setenv("EXTRA", "value")
+
+	t.Run("FromEnvs", func(t *testing.T) {
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Port != 9090 || config.Name != "api" {
+			t.Errorf("BaseConfig = %+v, want Port 9090 and Name api", config.BaseConfig)
+		}
+
+		if config.Level != "debug" {
+			t.Errorf("Level = %v, want debug", config.Level)
+		}
+
+		if config.Database.Host != "db.local" || config.Database.Port != 5432 {
+			t.Errorf("Database = %+v, want Host db.local and Port 5432", config.Database)
+		}
+
+		if config.Extra != "value" {
+			t.Errorf("Extra = %v, want value", config.Extra)
+		}
+	})
+
+	t.Run("TaggedEmbedded", func(t *testing.T) {
+		type Tagged struct {
+			BaseConfig `env:"DB"`
+		}
+
+		config, err := FromEnvs[Tagged]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Port != 5432 {
+			t.Errorf("Port = %v, want 5432", config.Port)
+		}
+	})
+
+	t.Run("FromFile", func(t *testing.T) {
+		envFile := filepath.Join(t.TempDir(), "embedded.env")
+		if err := os.WriteFile(envFile, []byte("PORT=7070\nLOG_LEVEL=warn\nEXTRA=file\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := FromFile[Config](envFile)
+		if err != nil {
+			t.Fatalf("FromFile failed: %v", err)
+		}
+
+		if config.Port != 7070 || config.Level != "warn" || config.Extra != "file" {
+			t.Errorf("config = %+v, want Port 7070, Level warn and Extra file", config)
+		}
+	})
+
+	t.Run("ToMap", func(t *testing.T) {
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		result := ToMap(config, false)
+		for key, expected := range map[string]string{"PORT": "9090", "LOG_LEVEL": "debug", "DB_PORT": "5432"} {
+			if result[key] != expected {
+				t.Errorf("%s = %q, want %q", key, result[key], expected)
+			}
+		}
+	})
+}
+
+// TestErrorAggregation tests that all failing fields are reported unless fail-fast is enabled
+func TestErrorAggregation(t *testing.T) {
+	defer func() {
+		os.Unsetenv("APP_PORT")
+		os.Unsetenv("APP_DEBUG")
+		os.Unsetenv("APP_RATIO")
+		SetFailFast(false)
+	}()
+
+	type Config struct {
+		Port   int     `env:"PORT"`
+		Debug  bool    `env:"DEBUG"`
+		Ratio  float64 `env:"RATIO"`
+		Secret string  `env:"SECRET" required:"true"`
+	}
+
+	os.Setenv("APP_PORT", "http")
+	os.Setenv("APP_DEBUG", "maybe")
+	os.Setenv("APP_RATIO", "half")
+
+	t.Run("AllErrors", func(t *testing.T) {
+		_, err := FromEnvsWithPrefix[Config]("APP")
+		if err == nil {
+			t.Fatal("expected errors")
+		}
+
+		for _, expected := range []string{
+			"field Port (APP_PORT)",
+			"field Debug (APP_DEBUG)",
+			"field Ratio (APP_RATIO)",
+			"required field Secret is missing: APP_SECRET is not set",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("error %q does not contain %q", err, expected)
+			}
+		}
+	})
+
+	t.Run("FailFast", func(t *testing.T) {
+		SetFailFast(true)
+		defer SetFailFast(false)
+
+		_, err := FromEnvsWithPrefix[Config]("APP")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		if !strings.Contains(err.Error(), "field Port (APP_PORT)") || strings.Contains(err.Error(), "Debug") {
+			t.Errorf("error %q, want only the Port failure", err)
+		}
+	})
+
+	t.Run("FailFastNested", func(t *testing.T) {
+		SetFailFast(true)
+		defer SetFailFast(false)
+
+		type Nested struct {
+			Inner Config `env:"APP"`
+			After int    `env:"APP_PORT"`
+		}
+
+		_, err := FromEnvs[Nested]()
+		if err == nil || strings.Contains(err.Error(), "After") {
+			t.Errorf("error %q, want only the first nested failure", err)
+		}
+	})
+}
+
+// TestLoadEnvsIfAbsent tests that existing variables win over files and earlier files over later ones
+func TestLoadEnvsIfAbsent(t *testing.T) {
+	directory := t.TempDir()
+	local := filepath.Join(directory, ".env.local")
+	base := filepath.Join(directory, ".env")
+
+	if err := os.WriteFile(local, []byte("ABSENT_HOST=local-host\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(base, []byte("ABSENT_HOST=base-host\nABSENT_PORT=8080\nABSENT_USER=base-user\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		os.Unsetenv("ABSENT_HOST")
+		os.Unsetenv("ABSENT_PORT")
+		os.Unsetenv("ABSENT_USER")
+	}()
+
+	os.Setenv("ABSENT_USER", "real-user")
+
+	t.Run("Precedence", func(t *testing.T) {
+		keys, err := LoadEnvsIfAbsent(local, base)
+		if err != nil {
+			t.Fatalf("LoadEnvsIfAbsent failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(keys, []string{"ABSENT_HOST", "ABSENT_PORT"}) {
+			t.Errorf("keys = %v, want [ABSENT_HOST ABSENT_PORT]", keys)
+		}
+
+		for key, expected := range map[string]string{
+			"ABSENT_HOST": "local-host",
+			"ABSENT_PORT": "8080",
+			"ABSENT_USER": "real-user",
+		} {
+			if value := os.Getenv(key); value != expected {
+				t.Errorf("%s = %q, want %q", key, value, expected)
+			}
+		}
+	})
+
+	t.Run("NothingToSet", func(t *testing.T) {
+		keys, err := LoadEnvsIfAbsent(base)
+		if err != nil {
+			t.Fatalf("LoadEnvsIfAbsent failed: %v", err)
+		}
+
+		if len(keys) != 0 {
+			t.Errorf("keys = %v, want none", keys)
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		os.Unsetenv("ABSENT_PORT")
+
+		keys, err := LoadEnvsIfAbsent(base, filepath.Join(directory, "missing.env"))
+		if err == nil {
+			t.Fatal("expected error for missing file")
+		}
+
+		if !reflect.DeepEqual(keys, []string{"ABSENT_PORT"}) {
+			t.Errorf("keys = %v, want [ABSENT_PORT]", keys)
+		}
+	})
+}
+
+// textMode is a TextUnmarshaler accepting only "on" and "off"
+type textMode bool
+
+func (mode *textMode) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "on":
+		*mode = true
+	case "off":
+		*mode = false
+	default:
+		return fmt.Errorf("invalid mode %q", text)
+	}
+	return nil
+}
+
+// TestFromEnvs_TextUnmarshalerError tests that errors returned by UnmarshalText are reported
+func TestFromEnvs_TextUnmarshalerError(t *testing.T) {
+	defer os.Unsetenv("TEXT_MODE")
+
+	type Config struct {
+		Mode textMode `env:"TEXT_MODE"`
+	}
+
+	os.Setenv("TEXT_MODE", "on")
+	config, err := FromEnvs[Config]()
+	if err != nil {
+		t.Fatalf("FromEnvs failed: %v", err)
+	}
+	if !config.Mode {
+		t.Errorf("Config.Mode = %v, want true", config.Mode)
+	}
+
+	os.Setenv("TEXT_MODE", "maybe")
+	if _, err = FromEnvs[Config](); err == nil || !strings.Contains(err.Error(), "invalid mode") {
+		t.Errorf("FromEnvs should report the UnmarshalText error, got %v", err)
+	}
+}
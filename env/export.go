@@ -0,0 +1,187 @@
+package env
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0x626f/go-kit/utils"
+)
+
+const (
+	// tagSecret is the struct tag marking fields whose values must not be exposed
+	tagSecret = "secret"
+	// secretMask replaces the values of secret fields in ToMap
+	secretMask = "******"
+)
+
+// ToMap walks a configuration struct and returns the value of every "env" tagged field
+// keyed by its resolved environment variable name. Keys are built exactly as FromEnvs
+// resolves them, including nested struct prefixes and the global prefix, and values are
+// formatted in the syntax FromEnvs parses, so the result can be used to verify what was
+// actually loaded (e.g., on a /config debug endpoint).
+//
+// Type parameters:
+//   - T: The configuration struct type, or a pointer to it
+//
+// Parameters:
+//   - cfg: The configuration to export
+//   - maskSecrets: If true, the values of fields tagged secret:"true" are replaced by "******"
+//
+// Returns:
+//   - map[string]string: The formatted values by environment variable name (empty if cfg isn't a struct)
+//
+// Example:
+//
+//	type Config struct {
+//	    Database struct {
+//	        Host     string `env:"HOST"`
+//	        Password string `env:"PASSWORD" secret:"true"`
+//	    } `env:"DB"`
+//	}
+//
+//	cfg, _ := config.FromEnvs[Config]()
+//	values := config.ToMap(cfg, true)
+//	// map[DB_HOST:localhost DB_PASSWORD:******]
+func ToMap[T any](cfg T, maskSecrets bool) map[string]string {
+	result := make(map[string]string)
+
+	ref := reflect.ValueOf(cfg)
+	for ref.Kind() == reflect.Pointer {
+		if ref.IsNil() {
+			return result
+		}
+		ref = ref.Elem()
+	}
+
+	if ref.Kind() == reflect.Struct {
+		exportStruct(ref, prefix, maskSecrets, result)
+	}
+
+	return result
+}
+
+// exportStruct recursively adds the formatted values of the struct fields to the result,
+// mirroring how mapStructFromEnvs builds environment variable names.
+//
+// Parameters:
+//   - ref: The reflect.Value of the struct to export
+//   - prefix: The accumulated prefix for nested struct fields, starting with the global prefix
+//   - maskSecrets: If true, the values of secret fields are masked
+//   - result: The map receiving the values
+func exportStruct(ref reflect.Value, prefix string, maskSecrets bool, result map[string]string) {
+	refType := ref.Type()
+	for index := 0; index < ref.NumField(); index++ {
+		field := refType.Field(index)
+
+		tag := field.Tag.Get(tagEnv)
+
+		if tag == "-" {
+			continue
+		}
+
+		fieldRef := ref.Field(index)
+
+		if field.Anonymous && isNestedStruct(fieldRef.Type()) {
+			exportStruct(fieldRef, addNestedPrefix(tag, prefix), maskSecrets, result)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if isNestedStruct(fieldRef.Type()) {
+			exportStruct(fieldRef, addNestedPrefix(tag, prefix), maskSecrets, result)
+		} else if fieldRef.Kind() == reflect.Pointer && isNestedStruct(fieldRef.Type().Elem()) {
+			if !fieldRef.IsNil() {
+				exportStruct(fieldRef.Elem(), addNestedPrefix(tag, prefix), maskSecrets, result)
+			}
+		} else {
+			if tag == "" {
+				continue
+			}
+
+			key := addNestedPrefix(tag, prefix)
+
+			// nil pointers mean the field isn't configured
+			if fieldRef.Kind() == reflect.Pointer {
+				if fieldRef.IsNil() {
+					continue
+				}
+				fieldRef = fieldRef.Elem()
+			}
+
+			if secret, _ := strconv.ParseBool(field.Tag.Get(tagSecret)); secret && maskSecrets {
+				result[key] = secretMask
+				continue
+			}
+
+			layout := field.Tag.Get(tagLayout)
+			if layout == "" {
+				layout = time.RFC3339
+			}
+
+			separator := field.Tag.Get(tagSeparator)
+			if separator == "" {
+				separator = itemSeparator
+			}
+
+			result[key] = formatValue(fieldRef, layout, separator)
+		}
+	}
+}
+
+// formatValue formats a value in the syntax accepted by mapFieldValue.
+//
+// Parameters:
+//   - ref: The value to format
+//   - layout: The layout used for time.Time values
+//   - separator: The separator between slice items
+//
+// Returns:
+//   - string: The formatted value (map pairs are sorted by key for a stable output)
+func formatValue(ref reflect.Value, layout, separator string) string {
+	refType := ref.Type()
+
+	if utils.IsInstanceOf[time.Duration](refType) {
+		return time.Duration(ref.Int()).String()
+	}
+
+	if utils.IsInstanceOf[time.Time](refType) {
+		return ref.Interface().(time.Time).Format(layout)
+	}
+
+	if marshaler, ok := ref.Interface().(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	switch ref.Kind() {
+	case reflect.Slice:
+		items := make([]string, ref.Len())
+		for index := range items {
+			items[index] = formatValue(ref.Index(index), layout, separator)
+		}
+
+		if refType.Elem().Kind() == reflect.Slice {
+			return strings.Join(items, groupSeparator)
+		}
+		return strings.Join(items, separator)
+	case reflect.Map:
+		pairs := make([]string, 0, ref.Len())
+		iterator := ref.MapRange()
+		for iterator.Next() {
+			pairs = append(pairs, formatValue(iterator.Key(), layout, separator)+"="+formatValue(iterator.Value(), layout, separator))
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, itemSeparator)
+	default:
+		return fmt.Sprint(ref.Interface())
+	}
+}
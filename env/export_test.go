@@ -0,0 +1,109 @@
+package env
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestToMap tests exporting a loaded configuration back to environment variable names
+func TestToMap(t *testing.T) {
+	defer func() {
+		os.Unsetenv("DB_HOST")
+		os.Unsetenv("DB_PASSWORD")
+		os.Unsetenv("CACHE_TTL")
+		SetEnvPrefix("")
+	}()
+
+	type Database struct {
+		Host     string `env:"HOST" default:"localhost"`
+		Password string `env:"PASSWORD" secret:"true"`
+	}
+
+	type Cache struct {
+		TTL time.Duration `env:"TTL" default:"5m"`
+	}
+
+	type Config struct {
+		Port     int            `env:"PORT" default:"8080"`
+		Tags     []string       `env:"TAGS" default:"a,b"`
+		Matrix   [][]int        `env:"MATRIX" default:"1,2;3"`
+		Limits   map[string]int `env:"LIMITS" default:"b=2,a=1"`
+		Cutoff   time.Time      `env:"CUTOFF" layout:"2006-01-02" default:"2030-01-01"`
+		Database Database       `env:"DB"`
+		Cache    *Cache         `env:"CACHE"`
+		Ignored  string         `env:"-"`
+		Untagged string
+		Labels   map[string]string `env:"LABELS"`
+	}
+
+	os.Setenv("DB_PASSWORD", "s3cr3t")
+
+	config, err := FromEnvs[Config]()
+	if err != nil {
+		t.Fatalf("FromEnvs failed: %v", err)
+	}
+
+	t.Run("ResolvedValues", func(t *testing.T) {
+		expected := map[string]string{
+			"PORT":        "8080",
+			"TAGS":        "a,b",
+			"MATRIX":      "1,2;3",
+			"LIMITS":      "a=1,b=2",
+			"CUTOFF":      "2030-01-01",
+			"DB_HOST":     "localhost",
+			"DB_PASSWORD": "s3cr3t",
+			"CACHE_TTL":   "5m0s",
+			"LABELS":      "",
+		}
+
+		if result := ToMap(config, false); !reflect.DeepEqual(result, expected) {
+			t.Errorf("ToMap() = %v, want %v", result, expected)
+		}
+	})
+
+	t.Run("MaskSecrets", func(t *testing.T) {
+		result := ToMap(*config, true)
+
+		if result["DB_PASSWORD"] != secretMask {
+			t.Errorf("DB_PASSWORD = %q, want %q", result["DB_PASSWORD"], secretMask)
+		}
+
+		if result["DB_HOST"] != "localhost" {
+			t.Errorf("DB_HOST = %q, want localhost", result["DB_HOST"])
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		for key, value := range ToMap(config, false) {
+			os.Setenv(key, value)
+			defer os.Unsetenv(key)
+		}
+
+		reloaded, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(ToMap(reloaded, false), ToMap(config, false)) {
+			t.Errorf("reloaded config = %+v, want %+v", reloaded, config)
+		}
+	})
+
+	t.Run("WithPrefix", func(t *testing.T) {
+		SetEnvPrefix("APP")
+		defer SetEnvPrefix("")
+
+		if _, exists := ToMap(config, false)["APP_DB_HOST"]; !exists {
+			t.Error("ToMap() is missing APP_DB_HOST")
+		}
+	})
+
+	t.Run("NilPointer", func(t *testing.T) {
+		var nilConfig *Config
+		if result := ToMap(nilConfig, false); len(result) != 0 {
+			t.Errorf("ToMap(nil) = %v, want empty map", result)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package env
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldKind describes how a struct field is mapped from environment variables
+type fieldKind int
+
+const (
+	// valueField is a field holding a single value read from one environment variable
+	valueField fieldKind = iota
+	// embeddedField is an embedded struct whose fields are promoted to the parent's namespace
+	embeddedField
+	// nestedField is a struct field whose fields are prefixed with the field's env tag
+	nestedField
+	// nestedPointerField is a pointer to a struct, allocated before its fields are mapped
+	nestedPointerField
+)
+
+// fieldDescriptor holds the resolved mapping metadata of a single struct field.
+type fieldDescriptor struct {
+	// index is the position of the field in its struct
+	index int
+	// field is the reflected struct field, used for the remaining tags and error messages
+	field reflect.StructField
+	// key is the prefixed environment variable name of a value field,
+	// or the prefix passed down to the fields of a struct field
+	key string
+	// fileKey is the variable naming a file that holds the value when key is unset
+	fileKey string
+	// defaultValue is the value of the default tag
+	defaultValue string
+	// kind describes how the field is mapped
+	kind fieldKind
+}
+
+// fieldCacheKey identifies a struct type mapped under a given prefix
+type fieldCacheKey struct {
+	structType reflect.Type
+	prefix     string
+}
+
+var (
+	// fieldCache holds the resolved descriptors of every struct type and prefix mapped so far
+	fieldCache = make(map[fieldCacheKey][]fieldDescriptor)
+	// fieldCacheMutex guards fieldCache
+	fieldCacheMutex sync.RWMutex
+)
+
+// fieldsOf returns the descriptors of the fields of a struct type that take part in
+// environment variable mapping, resolving them on the first call for the type and prefix.
+// Fields tagged with env:"-", unexported fields other than embedded structs, and value
+// fields without an env tag are left out.
+//
+// Descriptors of nested struct types are resolved lazily when mapping recurses into them,
+// and each struct type is resolved once per prefix it is mapped under.
+//
+// Parameters:
+//   - structType: The struct type to describe
+//   - prefix: The accumulated prefix of the environment variable names
+//
+// Returns:
+//   - []fieldDescriptor: The descriptors in field order, shared between callers and never modified
+//
+// Time complexity: O(1) for cached types, O(n) on the first call where n is the number of fields
+func fieldsOf(structType reflect.Type, prefix string) []fieldDescriptor {
+	cacheKey := fieldCacheKey{structType: structType, prefix: prefix}
+
+	fieldCacheMutex.RLock()
+	descriptors, cached := fieldCache[cacheKey]
+	fieldCacheMutex.RUnlock()
+
+	if cached {
+		return descriptors
+	}
+
+	descriptors = describeFields(structType, prefix)
+
+	fieldCacheMutex.Lock()
+	defer fieldCacheMutex.Unlock()
+
+	// Another goroutine may have resolved the type meanwhile, keep the first result
+	if existing, cached := fieldCache[cacheKey]; cached {
+		return existing
+	}
+	fieldCache[cacheKey] = descriptors
+
+	return descriptors
+}
+
+// describeFields resolves the descriptors of the fields of a struct type.
+//
+// Parameters:
+//   - structType: The struct type to describe
+//   - prefix: The accumulated prefix of the environment variable names
+//
+// Returns:
+//   - []fieldDescriptor: The descriptors in field order
+func describeFields(structType reflect.Type, prefix string) []fieldDescriptor {
+	descriptors := make([]fieldDescriptor, 0, structType.NumField())
+
+	for index := 0; index < structType.NumField(); index++ {
+		field := structType.Field(index)
+
+		tag := field.Tag.Get(tagEnv)
+
+		if tag == "-" {
+			continue
+		}
+
+		descriptor := fieldDescriptor{index: index, field: field, key: addNestedPrefix(tag, prefix)}
+
+		// Embedded structs promote their fields to the parent's namespace unless tagged,
+		// and their exported fields are settable even if the embedded type is unexported
+		if field.Anonymous && isNestedStruct(field.Type) {
+			descriptor.kind = embeddedField
+			descriptors = append(descriptors, descriptor)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if isNestedStruct(field.Type) {
+			descriptor.kind = nestedField
+		} else if field.Type.Kind() == reflect.Pointer && isNestedStruct(field.Type.Elem()) {
+			descriptor.kind = nestedPointerField
+		} else {
+			if tag == "" {
+				continue
+			}
+			descriptor.kind = valueField
+			descriptor.fileKey = descriptor.key + fileSuffix
+			descriptor.defaultValue = field.Tag.Get(tagDefault)
+		}
+
+		descriptors = append(descriptors, descriptor)
+	}
+
+	return descriptors
+}
@@ -0,0 +1,98 @@
+package env
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type benchmarkDatabase struct {
+	Host     string `env:"HOST" default:"localhost"`
+	Port     int    `env:"PORT" default:"5432"`
+	User     string `env:"USER" required:"true"`
+	Password string `env:"PASSWORD"`
+}
+
+type benchmarkConfig struct {
+	Name     string             `env:"NAME" default:"app"`
+	Port     int                `env:"PORT" validate:"min=1,max=65535"`
+	Debug    bool               `env:"DEBUG"`
+	Tags     []string           `env:"TAGS" sep:"|"`
+	Limits   map[string]int     `env:"LIMITS"`
+	Database benchmarkDatabase  `env:"DB"`
+	Replica  *benchmarkDatabase `env:"REPLICA"`
+	internal string
+	Ignored  string `env:"-"`
+}
+
+// TestFieldsOf_Cached tests that the descriptors of a type are resolved once and reused.
+func TestFieldsOf_Cached(t *testing.T) {
+	configType := reflect.TypeOf(benchmarkConfig{})
+
+	first := fieldsOf(configType, "")
+	second := fieldsOf(configType, "")
+
+	if len(first) == 0 || &first[0] != &second[0] {
+		t.Fatal("Expected the cached descriptors to be reused")
+	}
+
+	if prefixed := fieldsOf(configType, "APP"); prefixed[0].key != "APP_NAME" {
+		t.Errorf("Expected the prefixed key APP_NAME, got %s", prefixed[0].key)
+	}
+
+	names := make([]string, len(first))
+	for i, descriptor := range first {
+		names[i] = descriptor.field.Name
+	}
+	expected := []string{"Name", "Port", "Debug", "Tags", "Limits", "Database", "Replica"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected fields %v, got %v", expected, names)
+	}
+
+	if first[0].defaultValue != "app" || first[0].fileKey != "NAME_FILE" || first[5].key != "DB" || first[5].kind != nestedField || first[6].kind != nestedPointerField {
+		t.Errorf("Unexpected descriptors: %+v", first)
+	}
+}
+
+// TestFieldsOf_Concurrent tests that concurrent loads of the same type are safe.
+func TestFieldsOf_Concurrent(t *testing.T) {
+	t.Setenv("DB_USER", "admin")
+	t.Setenv("REPLICA_USER", "reader")
+	t.Setenv("PORT", "8080")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			config, err := FromEnvsWithPrefix[benchmarkConfig]("")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if config.Port != 8080 || config.Database.User != "admin" || config.Replica.User != "reader" {
+				t.Errorf("Unexpected config: %+v", config)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkFromEnvs_Repeated benchmarks repeated loads of the same configuration type,
+// which resolve the field descriptors only on the first call.
+func BenchmarkFromEnvs_Repeated(b *testing.B) {
+	b.Setenv("PORT", "8080")
+	b.Setenv("TAGS", "a|b|c")
+	b.Setenv("LIMITS", "a=1,b=2")
+	b.Setenv("DB_USER", "admin")
+	b.Setenv("REPLICA_USER", "reader")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := FromEnvs[benchmarkConfig](); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// strictInterpolation makes references to unknown variables an error instead of an empty string
+var strictInterpolation bool
+
+// SetStrictInterpolation configures how LoadEnvs and FromFile handle references to variables
+// that are defined neither in the file nor in the process environment.
+// By default such references expand to an empty string.
+//
+// Parameters:
+//   - strict: If true, unknown references make loading fail with an error
+//
+// Example:
+//
+//	config.SetStrictInterpolation(true)
+//
+//	// .env: URL=https://${HOST}:${PORT}
+//	err := config.LoadEnvs(".env") // Fails if HOST or PORT is not defined anywhere
+func SetStrictInterpolation(strict bool) {
+	strictInterpolation = strict
+}
+
+// interpolator expands ${VAR} and $VAR references in the values of a .env file.
+// References are resolved against the other values of the file first and the process
+// environment second. Values are resolved lazily and memoized, so each value is expanded once
+// regardless of how many other values reference it.
+type interpolator struct {
+	// data holds the raw values of the file
+	data map[string]string
+	// resolved holds the values that have been fully expanded
+	resolved map[string]string
+	// stack holds the keys currently being expanded, used to detect cycles
+	stack []string
+}
+
+// interpolate expands variable references in all values in place.
+// A "$$" sequence is replaced by a literal dollar sign, and a "$" that isn't followed
+// by a variable name or "{" is kept as is.
+//
+// Parameters:
+//   - data: The values of a .env file by key
+//
+// Returns:
+//   - error: An aggregated error for every value with a cyclic, unterminated, or (in strict mode) unknown reference
+//
+// Example:
+//
+//	data := map[string]string{"HOST": "localhost", "URL": "http://${HOST}:$$8080"}
+//	err := interpolate(data)
+//	// data["URL"] == "http://localhost:$8080"
+func interpolate(data map[string]string) (err error) {
+	interpolator := &interpolator{
+		data:     data,
+		resolved: make(map[string]string, len(data)),
+	}
+
+	for key := range data {
+		if _, resolveErr := interpolator.resolve(key); resolveErr != nil {
+			err = errors.Join(err, fmt.Errorf("couldn't interpolate %s: %w", key, resolveErr))
+		}
+	}
+
+	for key, value := range interpolator.resolved {
+		data[key] = value
+	}
+
+	return
+}
+
+// resolve returns the fully expanded value of a key defined in the file.
+//
+// Parameters:
+//   - key: The key to resolve
+//
+// Returns:
+//   - string: The expanded value
+//   - error: An error if the value references itself directly or indirectly, or has an invalid reference
+func (interpolator *interpolator) resolve(key string) (string, error) {
+	if value, exists := interpolator.resolved[key]; exists {
+		return value, nil
+	}
+
+	for index, visiting := range interpolator.stack {
+		if visiting == key {
+			cycle := append(append([]string{}, interpolator.stack[index:]...), key)
+			return "", fmt.Errorf("cyclic reference %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	interpolator.stack = append(interpolator.stack, key)
+	value, err := interpolator.expand(interpolator.data[key])
+	interpolator.stack = interpolator.stack[:len(interpolator.stack)-1]
+
+	if err != nil {
+		return "", err
+	}
+
+	interpolator.resolved[key] = value
+	return value, nil
+}
+
+// expand replaces every reference in the value by the value it refers to.
+//
+// Parameters:
+//   - value: The raw value to expand
+//
+// Returns:
+//   - string: The expanded value
+//   - error: An error if a reference can't be resolved
+func (interpolator *interpolator) expand(value string) (string, error) {
+	if !strings.Contains(value, "$") {
+		return value, nil
+	}
+
+	var builder strings.Builder
+	for index := 0; index < len(value); index++ {
+		if value[index] != '$' || index+1 == len(value) {
+			builder.WriteByte(value[index])
+			continue
+		}
+
+		var name string
+		switch next := value[index+1]; {
+		case next == '$':
+			builder.WriteByte('$')
+			index++
+			continue
+		case next == '{':
+			end := strings.IndexByte(value[index+2:], '}')
+			if end == -1 {
+				return "", fmt.Errorf("unterminated reference in %q", value)
+			}
+			name = value[index+2 : index+2+end]
+			index += end + 2
+		default:
+			end := index + 1
+			for end < len(value) && isNameByte(value[end]) {
+				end++
+			}
+			if end == index+1 {
+				builder.WriteByte('$')
+				continue
+			}
+			name = value[index+1 : end]
+			index = end - 1
+		}
+
+		resolved, err := interpolator.lookup(name)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteString(resolved)
+	}
+
+	return builder.String(), nil
+}
+
+// lookup returns the value of a referenced variable from the file or the process environment.
+//
+// Parameters:
+//   - name: The name of the referenced variable
+//
+// Returns:
+//   - string: The value of the variable, or "" if it is unknown and strict mode is off
+//   - error: An error if resolution fails, or if the variable is unknown in strict mode
+func (interpolator *interpolator) lookup(name string) (string, error) {
+	if _, exists := interpolator.data[name]; exists {
+		return interpolator.resolve(name)
+	}
+
+	if value, exists := os.LookupEnv(name); exists {
+		return value, nil
+	}
+
+	if strictInterpolation {
+		return "", fmt.Errorf("unknown reference to %s", name)
+	}
+
+	return "", nil
+}
+
+// isNameByte reports whether the byte can be part of an unbraced variable name ($VAR).
+//
+// Parameters:
+//   - char: The byte to check
+//
+// Returns:
+//   - bool: True for ASCII letters, digits, and underscores
+func isNameByte(char byte) bool {
+	return char == '_' || ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z') || ('0' <= char && char <= '9')
+}
@@ -0,0 +1,116 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestInterpolate tests expansion of references between values and to the process environment
+func TestInterpolate(t *testing.T) {
+	os.Setenv("INTERPOLATE_PROCESS", "from-process")
+	defer os.Unsetenv("INTERPOLATE_PROCESS")
+
+	tests := []struct {
+		name     string
+		data     map[string]string
+		key      string
+		expected string
+	}{
+		{"Braced", map[string]string{"HOST": "localhost", "URL": "http://${HOST}/"}, "URL", "http://localhost/"},
+		{"Unbraced", map[string]string{"HOST": "localhost", "URL": "http://$HOST/"}, "URL", "http://localhost/"},
+		{"Chained", map[string]string{"A": "${B}", "B": "${C}", "C": "c"}, "A", "c"},
+		{"ProcessEnv", map[string]string{"VALUE": "${INTERPOLATE_PROCESS}"}, "VALUE", "from-process"},
+		{"FileOverridesProcess", map[string]string{"INTERPOLATE_PROCESS": "file", "VALUE": "$INTERPOLATE_PROCESS"}, "VALUE", "file"},
+		{"EscapedDollar", map[string]string{"PRICE": "$$5 and $${HOST}"}, "PRICE", "$5 and ${HOST}"},
+		{"LoneDollar", map[string]string{"VALUE": "a $ b $"}, "VALUE", "a $ b $"},
+		{"UnknownIsEmpty", map[string]string{"VALUE": "[${INTERPOLATE_UNKNOWN}]"}, "VALUE", "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := interpolate(tt.data); err != nil {
+				t.Fatalf("interpolate() unexpected error: %v", err)
+			}
+
+			if tt.data[tt.key] != tt.expected {
+				t.Errorf("%s = %q, want %q", tt.key, tt.data[tt.key], tt.expected)
+			}
+		})
+	}
+}
+
+// TestInterpolate_Errors tests cyclic, unterminated, and strict unknown references
+func TestInterpolate_Errors(t *testing.T) {
+	t.Run("Cycle", func(t *testing.T) {
+		err := interpolate(map[string]string{"A": "${B}", "B": "$A"})
+		if err == nil || !strings.Contains(err.Error(), "cyclic reference") {
+			t.Errorf("interpolate() error = %v, want cyclic reference", err)
+		}
+	})
+
+	t.Run("SelfReference", func(t *testing.T) {
+		err := interpolate(map[string]string{"A": "x${A}"})
+		if err == nil || !strings.Contains(err.Error(), "cyclic reference A -> A") {
+			t.Errorf("interpolate() error = %v, want cyclic reference A -> A", err)
+		}
+	})
+
+	t.Run("Unterminated", func(t *testing.T) {
+		err := interpolate(map[string]string{"A": "${B"})
+		if err == nil || !strings.Contains(err.Error(), "unterminated reference") {
+			t.Errorf("interpolate() error = %v, want unterminated reference", err)
+		}
+	})
+
+	t.Run("StrictUnknown", func(t *testing.T) {
+		SetStrictInterpolation(true)
+		defer SetStrictInterpolation(false)
+
+		err := interpolate(map[string]string{"A": "${INTERPOLATE_UNKNOWN}"})
+		if err == nil || err.Error() != "couldn't interpolate A: unknown reference to INTERPOLATE_UNKNOWN" {
+			t.Errorf("interpolate() error = %v, want unknown reference", err)
+		}
+	})
+}
+
+// TestInterpolate_Files tests that LoadEnvs and FromFile expand references
+func TestInterpolate_Files(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "interpolate.env")
+	content := "INTERPOLATE_HOST=example.com\nINTERPOLATE_PORT=8443\nINTERPOLATE_URL=https://${INTERPOLATE_HOST}:$INTERPOLATE_PORT\n"
+	if err := os.WriteFile(envFile, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		os.Unsetenv("INTERPOLATE_HOST")
+		os.Unsetenv("INTERPOLATE_PORT")
+		os.Unsetenv("INTERPOLATE_URL")
+	}()
+
+	t.Run("LoadEnvs", func(t *testing.T) {
+		if err := LoadEnvs(envFile); err != nil {
+			t.Fatalf("LoadEnvs failed: %v", err)
+		}
+
+		if url := os.Getenv("INTERPOLATE_URL"); url != "https://example.com:8443" {
+			t.Errorf("INTERPOLATE_URL = %q, want https://example.com:8443", url)
+		}
+	})
+
+	t.Run("FromFile", func(t *testing.T) {
+		type Config struct {
+			URL string `env:"INTERPOLATE_URL"`
+		}
+
+		config, err := FromFile[Config](envFile)
+		if err != nil {
+			t.Fatalf("FromFile failed: %v", err)
+		}
+
+		if config.URL != "https://example.com:8443" {
+			t.Errorf("URL = %q, want https://example.com:8443", config.URL)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package env
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// MustFromEnvs loads configuration from environment variables like FromEnvs and panics if it fails.
+// It is meant for startup code where a configuration error is unrecoverable, like regexp.MustCompile;
+// prefer FromEnvs wherever the error can be handled.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Returns:
+//   - *T: A pointer to a struct of type T populated with configuration values
+//
+// Panics if T is not a struct type or mapping fails, with a message naming the type and the failing fields
+// (e.g., "env: FromEnvs[main.Config]: field Port (APP_PORT): ...").
+//
+// Example:
+//
+//	func main() {
+//	    cfg := config.MustFromEnvs[Config]()
+//	    serve(cfg.Port)
+//	}
+func MustFromEnvs[T any]() *T {
+	instance, err := FromEnvs[T]()
+	if err != nil {
+		panic("env: FromEnvs[" + reflect.TypeOf((*T)(nil)).Elem().String() + "]: " + err.Error())
+	}
+	return instance
+}
+
+// MustFromFile loads configuration from a file like FromFile and panics if it fails.
+// It is meant for startup code where a configuration error is unrecoverable, like regexp.MustCompile;
+// prefer FromFile wherever the error can be handled.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped, or a slice of structs for JSON and YAML
+//
+// Parameters:
+//   - filename: Path to the configuration file
+//
+// Returns:
+//   - *T: A pointer to a value of type T populated with configuration values
+//
+// Panics if the file can't be read, has an unsupported extension, or mapping fails, with a message naming
+// the type, the file and the failing fields (e.g., `env: FromFile[main.Config]("app.env"): field Port (PORT): ...`).
+//
+// Example:
+//
+//	func main() {
+//	    cfg := config.MustFromFile[Config]("config.yaml")
+//	    serve(cfg.Port)
+//	}
+func MustFromFile[T any](filename string) *T {
+	instance, err := FromFile[T](filename)
+	if err != nil {
+		panic("env: FromFile[" + reflect.TypeOf((*T)(nil)).Elem().String() + "](" + strconv.Quote(filename) + "): " + err.Error())
+	}
+	return instance
+}
@@ -0,0 +1,85 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// mustPanic calls load and returns the message it panicked with.
+func mustPanic(t *testing.T, load func()) (message string) {
+	t.Helper()
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			t.Fatal("Expected a panic")
+		}
+		message = fmt.Sprint(recovered)
+	}()
+
+	load()
+	return ""
+}
+
+type mustConfig struct {
+	Host string `env:"MUST_HOST"`
+	Port int    `env:"MUST_PORT" required:"true"`
+}
+
+// TestMustFromEnvs tests that MustFromEnvs returns the configuration and panics naming the failing field.
+func TestMustFromEnvs(t *testing.T) {
+	t.Setenv("MUST_HOST", "db.internal")
+	t.Setenv("MUST_PORT", "5432")
+
+	cfg := MustFromEnvs[mustConfig]()
+	if cfg.Host != "db.internal" || cfg.Port != 5432 {
+		t.Errorf("MustFromEnvs() = %+v, want Host db.internal and Port 5432", *cfg)
+	}
+
+	t.Setenv("MUST_PORT", "not-a-port")
+	message := mustPanic(t, func() { MustFromEnvs[mustConfig]() })
+
+	for _, expected := range []string{"env: FromEnvs[env.mustConfig]", "field Port (MUST_PORT)"} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("Expected the panic message to contain %q, got %q", expected, message)
+		}
+	}
+
+	if message := mustPanic(t, func() { MustFromEnvs[string]() }); !strings.Contains(message, "must be a struct") {
+		t.Errorf("Expected a panic for a non-struct type, got %q", message)
+	}
+}
+
+// TestMustFromFile tests that MustFromFile returns the configuration and panics naming the file and the failing field.
+func TestMustFromFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.env")
+	if err := os.WriteFile(valid, []byte("MUST_HOST=db.internal\nMUST_PORT=5432\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := MustFromFile[mustConfig](valid)
+	if cfg.Host != "db.internal" || cfg.Port != 5432 {
+		t.Errorf("MustFromFile() = %+v, want Host db.internal and Port 5432", *cfg)
+	}
+
+	missing := filepath.Join(dir, "missing.env")
+	if err := os.WriteFile(missing, []byte("MUST_HOST=db.internal\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	message := mustPanic(t, func() { MustFromFile[mustConfig](missing) })
+	for _, expected := range []string{"env: FromFile[env.mustConfig](", "missing.env", "Port", "MUST_PORT"} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("Expected the panic message to contain %q, got %q", expected, message)
+		}
+	}
+
+	if message := mustPanic(t, func() { MustFromFile[mustConfig](filepath.Join(dir, "config.txt")) }); !strings.Contains(message, "unsupported extension") {
+		t.Errorf("Expected a panic for an unsupported file, got %q", message)
+	}
+}
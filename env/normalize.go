@@ -0,0 +1,96 @@
+package env
+
+import (
+	"os"
+	"strings"
+)
+
+// keyNormalizer maps variable names to a canonical form before comparing them (nil for exact matching)
+var keyNormalizer func(string) string
+
+// SetKeyNormalizer makes variable lookups normalization-aware: when a variable isn't set under its
+// exact name, a variable whose normalized name equals the normalized key is used instead.
+// The normalizer applies to the GetEnv functions, FromEnvs and the keys of .env files read by FromFile,
+// so a field tagged env:"HOST" can be set by HOST, host or Host. Passing nil restores exact matching,
+// which is the default.
+//
+// Parameters:
+//   - normalizer: The function mapping a name to its canonical form (e.g., NormalizeKey or strings.ToUpper)
+//
+// Example:
+//
+//	// APP-HOST=db.internal, app_port=5432
+//	config.SetEnvPrefix("APP")
+//	config.SetKeyNormalizer(config.NormalizeKey)
+//
+//	host := config.GetEnv("HOST", "localhost") // Returns "db.internal"
+//	port := config.GetEnvAs("PORT", 5432)      // Reads app_port
+func SetKeyNormalizer(normalizer func(string) string) {
+	keyNormalizer = normalizer
+}
+
+// NormalizeKey converts a variable name to the conventional form of environment variables:
+// upper case with dashes, dots and spaces replaced by underscores.
+//
+// Parameters:
+//   - key: The variable name
+//
+// Returns:
+//   - string: The normalized name
+//
+// Example:
+//
+//	NormalizeKey("db-host")  // Returns "DB_HOST"
+//	NormalizeKey("app.port") // Returns "APP_PORT"
+func NormalizeKey(key string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(key))
+}
+
+// lookupEnv retrieves an environment variable by its exact name or, if a normalizer is set
+// (see SetKeyNormalizer), by its normalized name.
+//
+// Parameters:
+//   - key: The variable name, including prefixes
+//
+// Returns:
+//   - string: The value of the variable
+//   - bool: true if the variable is set, false otherwise
+//
+// Time complexity: O(1) for exact matches, O(n) otherwise where n is the number of environment variables
+func lookupEnv(key string) (string, bool) {
+	if value, exists := os.LookupEnv(key); exists || keyNormalizer == nil {
+		return value, exists
+	}
+
+	target := keyNormalizer(key)
+	for _, entry := range os.Environ() {
+		if name, value, found := strings.Cut(entry, "="); found && keyNormalizer(name) == target {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// lookupData retrieves the value of a key read from a file by its exact name or, if a normalizer
+// is set (see SetKeyNormalizer), by its normalized name.
+//
+// Parameters:
+//   - data: The values of the file by key
+//   - key: The variable name, including prefixes
+//
+// Returns:
+//   - string: The value of the key
+//   - bool: true if the key is present, false otherwise
+func lookupData(data map[string]string, key string) (string, bool) {
+	if value, exists := data[key]; exists || keyNormalizer == nil {
+		return value, exists
+	}
+
+	target := keyNormalizer(key)
+	for name, value := range data {
+		if keyNormalizer(name) == target {
+			return value, true
+		}
+	}
+	return "", false
+}
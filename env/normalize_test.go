@@ -0,0 +1,124 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSetKeyNormalizer_FromEnvs tests that a Host field resolves from differently spelled variables
+func TestSetKeyNormalizer_FromEnvs(t *testing.T) {
+	defer SetKeyNormalizer(nil)
+
+	type config struct {
+		Host string `env:"HOST"`
+	}
+
+	tests := []struct {
+		name       string
+		variable   string
+		normalizer func(string) string
+	}{
+		{"Exact", "HOST", nil},
+		{"LowerCase", "host", strings.ToUpper},
+		{"MixedCase", "Host", strings.ToUpper},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.variable, "db.internal")
+			SetKeyNormalizer(tt.normalizer)
+
+			cfg, err := FromEnvs[config]()
+			if err != nil {
+				t.Fatalf("FromEnvs() error = %v", err)
+			}
+			if cfg.Host != "db.internal" {
+				t.Errorf("Host = %q, want %q", cfg.Host, "db.internal")
+			}
+		})
+	}
+}
+
+// TestSetKeyNormalizer_ExactByDefault tests that variables only match their exact name without a normalizer
+func TestSetKeyNormalizer_ExactByDefault(t *testing.T) {
+	SetKeyNormalizer(nil)
+	t.Setenv("host", "db.internal")
+
+	if value := GetEnv("HOST", "localhost"); value != "localhost" {
+		t.Errorf("GetEnv() = %q, want the fallback", value)
+	}
+}
+
+// TestSetKeyNormalizer_CustomNormalizer tests a prefixed field resolving from a dashed, lower case variable
+func TestSetKeyNormalizer_CustomNormalizer(t *testing.T) {
+	defer SetKeyNormalizer(nil)
+	SetKeyNormalizer(NormalizeKey)
+
+	t.Setenv("app-host", "db.internal")
+	t.Setenv("app.port", "5432")
+
+	type config struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}
+
+	cfg, err := FromEnvsWithPrefix[config]("APP")
+	if err != nil {
+		t.Fatalf("FromEnvsWithPrefix() error = %v", err)
+	}
+	if cfg.Host != "db.internal" || cfg.Port != 5432 {
+		t.Errorf("config = %+v, want Host db.internal and Port 5432", *cfg)
+	}
+
+	originalPrefix := GetEnvPrefix()
+	defer SetEnvPrefix(originalPrefix)
+	SetEnvPrefix("APP")
+
+	if value := GetEnv("HOST", "localhost"); value != "db.internal" {
+		t.Errorf("GetEnv() = %q, want %q", value, "db.internal")
+	}
+	if port, err := GetEnvRequiredAs[int]("PORT"); err != nil || port != 5432 {
+		t.Errorf("GetEnvRequiredAs() = %d, %v, want 5432", port, err)
+	}
+}
+
+// TestSetKeyNormalizer_FromFile tests that the keys of .env files are matched after normalization
+func TestSetKeyNormalizer_FromFile(t *testing.T) {
+	defer SetKeyNormalizer(nil)
+	SetKeyNormalizer(NormalizeKey)
+
+	path := filepath.Join(t.TempDir(), "config.env")
+	if err := os.WriteFile(path, []byte("db-host=db.internal\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type config struct {
+		Host string `env:"HOST"`
+	}
+
+	cfg, err := FromFileWithPrefix[config](path, "DB")
+	if err != nil {
+		t.Fatalf("FromFileWithPrefix() error = %v", err)
+	}
+	if cfg.Host != "db.internal" {
+		t.Errorf("Host = %q, want %q", cfg.Host, "db.internal")
+	}
+}
+
+// TestNormalizeKey tests the conversion to the conventional variable form
+func TestNormalizeKey(t *testing.T) {
+	tests := map[string]string{
+		"db-host":   "DB_HOST",
+		"app.port":  "APP_PORT",
+		"DB_HOST":   "DB_HOST",
+		"Log Level": "LOG_LEVEL",
+	}
+
+	for input, want := range tests {
+		if got := NormalizeKey(input); got != want {
+			t.Errorf("NormalizeKey(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
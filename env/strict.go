@@ -0,0 +1,132 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownEnv is returned by FromEnvs in strict mode for each prefixed variable that maps to no field
+var ErrUnknownEnv = errors.New("unknown environment variable")
+
+var (
+	// strictKeys makes prefixed variables that map to no field an error
+	strictKeys bool
+	// allowedKeys holds the names, without prefix, of variables accepted in strict mode without a field
+	allowedKeys []string
+	// strictKeysMutex guards strictKeys and allowedKeys
+	strictKeysMutex sync.RWMutex
+)
+
+// SetStrictKeys configures whether FromEnvs and FromEnvsWithPrefix reject environment variables
+// that start with the prefix but correspond to no struct field, catching typos like APP_POTR=8080
+// that would otherwise be silently ignored. Variables naming a file that holds a value (the _FILE
+// variant of a field) count as known. The check only runs when a prefix is used, since unprefixed
+// variables belong to the whole process.
+//
+// Each unknown variable is reported as an error wrapping ErrUnknownEnv that names it,
+// joined with the mapping errors. Strict mode is disabled by default.
+//
+// Parameters:
+//   - strict: If true, loading fails when unknown prefixed variables are set
+//   - allowed: Names of variables intentionally outside the struct, without the prefix (e.g., "DEBUG_PPROF")
+//
+// Example:
+//
+//	// APP_PORT=8080, APP_POTR=9090, APP_PROFILE=cpu
+//	config.SetEnvPrefix("APP")
+//	config.SetStrictKeys(true, "PROFILE")
+//
+//	cfg, err := config.FromEnvs[AppConfig]() // err: unknown environment variable: APP_POTR
+func SetStrictKeys(strict bool, allowed ...string) {
+	strictKeysMutex.Lock()
+	defer strictKeysMutex.Unlock()
+
+	strictKeys = strict
+	allowedKeys = slices.Clone(allowed)
+}
+
+// checkUnknownKeys reports the environment variables starting with prefix that map to no field
+// of structType and aren't allowed, if strict mode is enabled (see SetStrictKeys).
+//
+// Parameters:
+//   - structType: The struct type the variables are mapped to
+//   - prefix: The prefix of the variables (the check is skipped if empty)
+//
+// Returns:
+//   - error: The joined ErrUnknownEnv errors of the unknown variables in name order, or nil
+//
+// Time complexity: O(n + f) where n is the number of environment variables and f the number of fields
+func checkUnknownKeys(structType reflect.Type, prefix string) error {
+	strictKeysMutex.RLock()
+	strict, allowed := strictKeys, allowedKeys
+	strictKeysMutex.RUnlock()
+
+	if !strict || prefix == "" {
+		return nil
+	}
+
+	normalize := func(name string) string { return name }
+	if keyNormalizer != nil {
+		normalize = keyNormalizer
+	}
+
+	known := make(map[string]struct{})
+	collectKeys(structType, prefix, known)
+	for _, name := range allowed {
+		known[addNestedPrefix(name, prefix)] = struct{}{}
+	}
+
+	normalized := make(map[string]struct{}, len(known))
+	for name := range known {
+		normalized[normalize(name)] = struct{}{}
+	}
+
+	start := normalize(prefix + "_")
+
+	var unknown []string
+	for _, entry := range os.Environ() {
+		name, _, _ := strings.Cut(entry, "=")
+		key := normalize(name)
+
+		if !strings.HasPrefix(key, start) {
+			continue
+		}
+		if _, exists := normalized[key]; !exists {
+			unknown = append(unknown, name)
+		}
+	}
+
+	slices.Sort(unknown)
+
+	var err error
+	for _, name := range unknown {
+		err = errors.Join(err, fmt.Errorf("%w: %s", ErrUnknownEnv, name))
+	}
+	return err
+}
+
+// collectKeys adds the variable names the fields of a struct type are mapped from to keys,
+// recursing into nested and embedded structs like mapStructFromEnvs.
+//
+// Parameters:
+//   - structType: The struct type to describe
+//   - prefix: The accumulated prefix of the variable names
+//   - keys: The set receiving the names, including the _FILE variants
+func collectKeys(structType reflect.Type, prefix string, keys map[string]struct{}) {
+	for _, descriptor := range fieldsOf(structType, prefix) {
+		switch descriptor.kind {
+		case embeddedField, nestedField:
+			collectKeys(descriptor.field.Type, descriptor.key, keys)
+		case nestedPointerField:
+			collectKeys(descriptor.field.Type.Elem(), descriptor.key, keys)
+		default:
+			keys[descriptor.key] = struct{}{}
+			keys[descriptor.fileKey] = struct{}{}
+		}
+	}
+}
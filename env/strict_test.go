@@ -0,0 +1,84 @@
+package env
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// strictConfig is the configuration loaded by the strict mode tests
+type strictConfig struct {
+	Port     int    `env:"PORT"`
+	Password string `env:"PASSWORD"`
+	Database struct {
+		Host string `env:"HOST"`
+	} `env:"DB"`
+	Cache *struct {
+		Size int `env:"SIZE"`
+	} `env:"CACHE"`
+}
+
+// TestSetStrictKeys tests that a stray prefixed variable is reported by name
+func TestSetStrictKeys(t *testing.T) {
+	SetStrictKeys(true)
+	defer SetStrictKeys(false)
+
+	t.Setenv("STRICT_PORT", "8080")
+	t.Setenv("STRICT_POTR", "9090")
+	t.Setenv("STRICT_DB_HOST", "localhost")
+	t.Setenv("STRICT_DB_HOTS", "localhost")
+	t.Setenv("STRICT_CACHE_SIZE", "10")
+	t.Setenv("STRICT_PASSWORD_FILE", "/dev/null")
+	t.Setenv("OTHER_POTR", "9090")
+
+	cfg, err := FromEnvsWithPrefix[strictConfig]("STRICT")
+	if !errors.Is(err, ErrUnknownEnv) {
+		t.Fatalf("FromEnvsWithPrefix() error = %v, want ErrUnknownEnv", err)
+	}
+
+	expected := "unknown environment variable: STRICT_DB_HOTS\nunknown environment variable: STRICT_POTR"
+	if err.Error() != expected {
+		t.Errorf("FromEnvsWithPrefix() error = %q, want %q", err.Error(), expected)
+	}
+
+	if cfg.Port != 8080 || cfg.Database.Host != "localhost" || cfg.Cache.Size != 10 {
+		t.Errorf("config = %+v, want the known variables mapped", *cfg)
+	}
+}
+
+// TestSetStrictKeys_Allowed tests that allowed variables and disabled strict mode don't fail loading
+func TestSetStrictKeys_Allowed(t *testing.T) {
+	t.Setenv("STRICT_PORT", "8080")
+	t.Setenv("STRICT_PPROF", "true")
+
+	if _, err := FromEnvsWithPrefix[strictConfig]("STRICT"); err != nil {
+		t.Errorf("FromEnvsWithPrefix() error = %v without strict mode, want nil", err)
+	}
+
+	SetStrictKeys(true, "PPROF")
+	defer SetStrictKeys(false)
+
+	if _, err := FromEnvsWithPrefix[strictConfig]("STRICT"); err != nil {
+		t.Errorf("FromEnvsWithPrefix() error = %v with STRICT_PPROF allowed, want nil", err)
+	}
+}
+
+// TestSetStrictKeys_GlobalPrefix tests strict mode with the prefix set by SetEnvPrefix,
+// and that no check runs without a prefix
+func TestSetStrictKeys_GlobalPrefix(t *testing.T) {
+	SetStrictKeys(true)
+	defer SetStrictKeys(false)
+
+	t.Setenv("STRICT_POTR", "9090")
+
+	if _, err := FromEnvs[strictConfig](); err != nil {
+		t.Errorf("FromEnvs() error = %v without a prefix, want nil", err)
+	}
+
+	SetEnvPrefix("STRICT")
+	defer SetEnvPrefix("")
+
+	if _, err := FromEnvs[strictConfig](); !errors.Is(err, ErrUnknownEnv) || !strings.Contains(err.Error(), "STRICT_POTR") {
+		t.Errorf("FromEnvs() error = %v, want ErrUnknownEnv naming STRICT_POTR", err)
+	}
+}
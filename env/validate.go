@@ -0,0 +1,157 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Validation tag and rule names
+const (
+	// tagValidate is the struct tag holding the validation rules of a field
+	tagValidate = "validate"
+	// ruleMin requires a numeric field to be greater than or equal to the bound
+	ruleMin = "min"
+	// ruleMax requires a numeric field to be less than or equal to the bound
+	ruleMax = "max"
+	// ruleOneOf requires a field to equal one of the space-separated options
+	ruleOneOf = "oneof"
+	// ruleRegex requires a string field to match the regular expression
+	ruleRegex = "regex"
+)
+
+// validateField checks a populated field against the rules of its "validate" tag.
+// Rules are separated by commas and written as name=argument. Since regular expressions
+// may contain commas, a regex rule consumes the rest of the tag and must come last.
+//
+// Supported rules:
+//   - min=N: The numeric value must be >= N
+//   - max=N: The numeric value must be <= N
+//   - oneof=a b c: The value must equal one of the space-separated options
+//   - regex=EXPR: The string value must match the regular expression
+//
+// Parameters:
+//   - field: The struct field carrying the "validate" tag
+//   - ref: The populated value of the field
+//
+// Returns:
+//   - error: An aggregated error naming the field and the offending value for every failed rule
+//
+// Example:
+//
+//	type Config struct {
+//	    Port int    `env:"PORT" validate:"min=1,max=65535"`
+//	    Env  string `env:"ENV" validate:"oneof=dev staging prod"`
+//	    ID   string `env:"ID" validate:"regex=^\\d+$"`
+//	}
+func validateField(field reflect.StructField, ref reflect.Value) (err error) {
+	rules := field.Tag.Get(tagValidate)
+
+	if ref.Kind() == reflect.Pointer {
+		if ref.IsNil() {
+			return nil
+		}
+		ref = ref.Elem()
+	}
+
+	for rules != "" {
+		var rule string
+		if strings.HasPrefix(rules, ruleRegex+"=") {
+			rule, rules = rules, ""
+		} else if separator := strings.Index(rules, ","); separator != -1 {
+			rule, rules = rules[:separator], rules[separator+1:]
+		} else {
+			rule, rules = rules, ""
+		}
+
+		name, argument, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "" {
+			continue
+		}
+
+		if ruleErr := validateRule(ref, name, argument); ruleErr != nil {
+			err = errors.Join(err, fmt.Errorf("field %s: value %q %w", field.Name, fmt.Sprint(ref.Interface()), ruleErr))
+		}
+	}
+	return
+}
+
+// validateRule checks a value against a single validation rule.
+//
+// Parameters:
+//   - ref: The value to check
+//   - name: The rule name (min, max, oneof, regex)
+//   - argument: The rule argument
+//
+// Returns:
+//   - error: A description of the violation, or nil if the value satisfies the rule
+func validateRule(ref reflect.Value, name, argument string) error {
+	switch name {
+	case ruleMin, ruleMax:
+		value, ok := numericValue(ref)
+		if !ok {
+			return fmt.Errorf("is not numeric and can't be validated with %s", name)
+		}
+
+		bound, err := strconv.ParseFloat(argument, 64)
+		if err != nil {
+			return fmt.Errorf("can't be validated: invalid %s bound %q", name, argument)
+		}
+
+		if name == ruleMin && value < bound {
+			return fmt.Errorf("is less than min %s", argument)
+		}
+
+		if name == ruleMax && value > bound {
+			return fmt.Errorf("is greater than max %s", argument)
+		}
+	case ruleOneOf:
+		value := fmt.Sprint(ref.Interface())
+		for _, option := range strings.Fields(argument) {
+			if value == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("is not one of [%s]", argument)
+	case ruleRegex:
+		if ref.Kind() != reflect.String {
+			return fmt.Errorf("is not a string and can't be validated with %s", name)
+		}
+
+		expression, err := regexp.Compile(argument)
+		if err != nil {
+			return fmt.Errorf("can't be validated: invalid regex %q: %w", argument, err)
+		}
+
+		if !expression.MatchString(ref.String()) {
+			return fmt.Errorf("doesn't match regex %s", argument)
+		}
+	default:
+		return fmt.Errorf("can't be validated: unknown rule %q", name)
+	}
+	return nil
+}
+
+// numericValue converts a numeric value to float64 for comparison with rule bounds.
+//
+// Parameters:
+//   - ref: The value to convert
+//
+// Returns:
+//   - float64: The converted value
+//   - bool: False if the value is not of a numeric kind
+func numericValue(ref reflect.Value) (float64, bool) {
+	switch ref.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(ref.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(ref.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return ref.Float(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,128 @@
+package env
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestValidateTag_Rules tests each validation rule against passing and failing values
+func TestValidateTag_Rules(t *testing.T) {
+	type sample struct {
+		Port    int     `validate:"min=1,max=65535"`
+		Ratio   float64 `validate:"min=0.5"`
+		Workers uint    `validate:"max=8"`
+		Env     string  `validate:"oneof=dev staging prod"`
+		ID      string  `validate:"regex=^\\d{2,4}$"`
+		Name    string  `validate:"min=1"`
+		Mode    string  `validate:"unknown=1"`
+	}
+
+	sampleType := reflect.TypeOf(sample{})
+
+	tests := []struct {
+		name    string
+		field   string
+		value   any
+		wantErr string
+	}{
+		{"MinPasses", "Port", 1, ""},
+		{"MinFails", "Port", 0, `field Port: value "0" is less than min 1`},
+		{"MaxFails", "Port", 70000, `field Port: value "70000" is greater than max 65535`},
+		{"FloatMinFails", "Ratio", 0.25, `field Ratio: value "0.25" is less than min 0.5`},
+		{"UintMaxFails", "Workers", uint(9), `field Workers: value "9" is greater than max 8`},
+		{"OneOfPasses", "Env", "staging", ""},
+		{"OneOfFails", "Env", "qa", `field Env: value "qa" is not one of [dev staging prod]`},
+		{"RegexWithCommaPasses", "ID", "123", ""},
+		{"RegexFails", "ID", "12345", `field ID: value "12345" doesn't match regex ^\d{2,4}$`},
+		{"MinOnString", "Name", "x", "is not numeric"},
+		{"UnknownRule", "Mode", "x", `unknown rule "unknown"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, _ := sampleType.FieldByName(tt.field)
+			err := validateField(field, reflect.ValueOf(tt.value))
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateField() unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateField() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestValidateTag_FromEnvs tests that validation runs for top-level, nested, and pointer struct fields
+func TestValidateTag_FromEnvs(t *testing.T) {
+	defer func() {
+		os.Unsetenv("PORT")
+		os.Unsetenv("DB_MODE")
+		os.Unsetenv("CACHE_SIZE")
+	}()
+
+	type Database struct {
+		Mode string `env:"MODE" validate:"oneof=primary replica"`
+	}
+
+	type Cache struct {
+		Size int `env:"SIZE" validate:"min=16"`
+	}
+
+	type Config struct {
+		Port     int      `env:"PORT" default:"8080" validate:"min=1,max=65535"`
+		Database Database `env:"DB"`
+		Cache    *Cache   `env:"CACHE"`
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		os.Setenv("DB_MODE", "replica")
+		os.Setenv("CACHE_SIZE", "64")
+
+		config, err := FromEnvs[Config]()
+		if err != nil {
+			t.Fatalf("FromEnvs failed: %v", err)
+		}
+
+		if config.Port != 8080 || config.Database.Mode != "replica" || config.Cache.Size != 64 {
+			t.Errorf("config = %+v, want Port 8080, Mode replica, Size 64", config)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		os.Setenv("PORT", "0")
+		os.Setenv("DB_MODE", "arbiter")
+		os.Setenv("CACHE_SIZE", "8")
+
+		_, err := FromEnvs[Config]()
+		if err == nil {
+			t.Fatal("expected validation errors")
+		}
+
+		for _, expected := range []string{
+			`field Port: value "0" is less than min 1`,
+			`field Mode: value "arbiter" is not one of [primary replica]`,
+			`field Size: value "8" is less than min 16`,
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("error %q does not contain %q", err, expected)
+			}
+		}
+	})
+
+	t.Run("UnsetFieldIsNotValidated", func(t *testing.T) {
+		os.Unsetenv("PORT")
+		os.Unsetenv("DB_MODE")
+		os.Unsetenv("CACHE_SIZE")
+
+		if _, err := FromEnvs[Config](); err != nil {
+			t.Errorf("FromEnvs failed: %v", err)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package env
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultWatchInterval is the polling interval used by Watch
+const defaultWatchInterval = 500 * time.Millisecond
+
+// Watcher polls a configuration file started by Watch or WatchEvery until it is stopped.
+type Watcher struct {
+	// stop is closed to end the polling goroutine
+	stop chan struct{}
+	// once guards closing stop
+	once sync.Once
+}
+
+// fileState identifies a version of a watched file
+type fileState struct {
+	// modTime is the modification time of the file in nanoseconds since the Unix epoch
+	modTime int64
+	// size is the size of the file in bytes
+	size int64
+	// missing is set when the file can't be stat'ed, e.g. while an editor replaces it
+	missing bool
+}
+
+// Watch reloads a configuration file with FromFile whenever it changes and passes the result
+// to onChange, turning the file into a live-reload configuration source.
+// The file is polled every 500 milliseconds, see WatchEvery to choose the interval.
+//
+// A change is reported once the file has stayed the same for a whole interval, so the
+// successive writes of an editor saving a file (or replacing it with a rename) produce
+// a single reload. Changes are detected from the modification time and the size of the file.
+//
+// onChange is not invoked for the initial contents, which are usually loaded with FromFile
+// before watching. It is called from the watching goroutine, one call at a time.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Parameters:
+//   - path: Path to the configuration file (.json, .yaml, .yml, or .env)
+//   - onChange: Called with the reloaded configuration and a nil error,
+//     or with the zero value of T and the error if the file can't be read or mapped
+//
+// Returns:
+//   - *Watcher: The watcher, whose Stop method ends watching
+//
+// Example:
+//
+//	cfg, err := config.FromFile[ServerConfig]("server.yaml")
+//	...
+//	watcher := config.Watch("server.yaml", func(cfg ServerConfig, err error) {
+//	    if err != nil {
+//	        log.Printf("keeping the previous configuration: %v", err)
+//	        return
+//	    }
+//	    server.Apply(cfg)
+//	})
+//	defer watcher.Stop()
+func Watch[T any](path string, onChange func(T, error)) *Watcher {
+	return WatchEvery(path, defaultWatchInterval, onChange)
+}
+
+// WatchEvery reloads a configuration file whenever it changes like Watch,
+// polling the file at the given interval.
+//
+// Type parameters:
+//   - T: The struct type to which the configuration will be mapped
+//
+// Parameters:
+//   - path: Path to the configuration file (.json, .yaml, .yml, or .env)
+//   - interval: The polling interval, also the time a change must settle before it is reported;
+//     non-positive values use the default of 500 milliseconds
+//   - onChange: Called with the reloaded configuration or an error, see Watch
+//
+// Returns:
+//   - *Watcher: The watcher, whose Stop method ends watching
+//
+// Example:
+//
+//	watcher := config.WatchEvery("features.json", 5*time.Second, func(flags Features, err error) {
+//	    if err == nil {
+//	        current.Store(&flags)
+//	    }
+//	})
+//	defer watcher.Stop()
+func WatchEvery[T any](path string, interval time.Duration, onChange func(T, error)) *Watcher {
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	watcher := &Watcher{stop: make(chan struct{})}
+	initial := statFile(path)
+
+	go watcher.poll(path, initial, interval, func() {
+		config, err := FromFile[T](path)
+		if err != nil {
+			var zero T
+			onChange(zero, err)
+			return
+		}
+		onChange(*config, nil)
+	})
+
+	return watcher
+}
+
+// Stop ends watching. A reload already in progress completes, but changes made afterwards are not reported.
+// Stop may be called more than once and from within onChange.
+func (watcher *Watcher) Stop() {
+	watcher.once.Do(func() {
+		close(watcher.stop)
+	})
+}
+
+// poll checks the state of the file at every tick and calls reload once a change has settled,
+// that is when the state differs from the last loaded one but matches the previous tick.
+//
+// Parameters:
+//   - path: Path to the watched file
+//   - loaded: The state of the file when watching started
+//   - interval: The polling interval
+//   - reload: Loads the file and reports the result
+func (watcher *Watcher) poll(path string, loaded fileState, interval time.Duration, reload func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	previous := loaded
+
+	for {
+		select {
+		case <-watcher.stop:
+			return
+		case <-ticker.C:
+		}
+
+		current := statFile(path)
+		if current != loaded && current == previous {
+			select {
+			case <-watcher.stop:
+				return
+			default:
+			}
+
+			reload()
+			loaded = current
+		}
+		previous = current
+	}
+}
+
+// statFile returns the current state of a file.
+//
+// Parameters:
+//   - path: Path to the file
+//
+// Returns:
+//   - fileState: The modification time and size, or a missing state if the file can't be stat'ed
+func statFile(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{missing: true}
+	}
+	return fileState{modTime: info.ModTime().UnixNano(), size: info.Size()}
+}
@@ -0,0 +1,106 @@
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type watchedConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+func TestWatch_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"host": "localhost", "port": 8080}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := make(chan watchedConfig, 10)
+	watcher := WatchEvery(path, 20*time.Millisecond, func(config watchedConfig, err error) {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		changes <- config
+	})
+	defer watcher.Stop()
+
+	// an editor saving twice in a row is reported once, with the final contents
+	if err := os.WriteFile(path, []byte(`{"host": "example.com"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"host": "example.com", "port": 9090}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case config := <-changes:
+		if config.Host != "example.com" || config.Port != 9090 {
+			t.Errorf("expected the updated values, got %+v", config)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the callback to fire")
+	}
+
+	select {
+	case config := <-changes:
+		t.Errorf("expected a single reload, got another one with %+v", config)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatch_Error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": 8080}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 10)
+	watcher := WatchEvery(path, 20*time.Millisecond, func(config watchedConfig, err error) {
+		if config != (watchedConfig{}) {
+			t.Errorf("expected the zero value along with an error, got %+v", config)
+		}
+		errs <- err
+	})
+	defer watcher.Stop()
+
+	if err := os.WriteFile(path, []byte(`{"port": "not a number"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("expected an error for an invalid file")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the callback to fire")
+	}
+}
+
+func TestWatch_Stop(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": 8080}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	called := make(chan struct{}, 10)
+	watcher := WatchEvery(path, 20*time.Millisecond, func(watchedConfig, error) {
+		called <- struct{}{}
+	})
+	watcher.Stop()
+	watcher.Stop()
+
+	if err := os.WriteFile(path, []byte(`{"port": 9090}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-called:
+		t.Error("expected no callback after Stop")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -1,3 +1,8 @@
 module github.com/0x626f/go-kit
 
 go 1.24.2
+
+require (
+	golang.org/x/sys v0.33.0
+	gopkg.in/yaml.v3 v3.0.1
+)
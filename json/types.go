@@ -1,10 +1,16 @@
 package json
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"math"
 	"strconv"
+	"unicode/utf8"
 )
 
+// hexDigits are the lowercase hexadecimal digits used by \u escapes
+const hexDigits = "0123456789abcdef"
+
 // AppendString appends a JSON string value (quoted).
 // The value is wrapped in double quotes as required by JSON specification.
 //
@@ -74,6 +80,106 @@ func (encoder *JSONEncoder) AppendBytes(values []byte) *JSONEncoder {
 	return encoder
 }
 
+// AppendEscapedBytes appends a byte slice as a quoted JSON string, escaping quotes, backslashes
+// and control characters, and replacing invalid UTF-8 with U+FFFD, so arbitrary bytes
+// keep the output valid JSON. The bytes are escaped directly into the buffer without intermediate allocations.
+//
+// Parameters:
+//   - values: The byte slice to encode
+//
+// Returns:
+//   - The encoder for method chaining
+//
+// Example:
+//
+//	encoder.AppendKey("body").AppendEscapedBytes([]byte("say \"hi\"\n"))
+//	// Produces: "body":"say \"hi\"\n"
+//
+// Time complexity: O(n) where n is the length of values
+func (encoder *JSONEncoder) AppendEscapedBytes(values []byte) *JSONEncoder {
+	encoder.buffer = append(encoder.buffer, '"')
+
+	start := 0
+	for i := 0; i < len(values); {
+		if b := values[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+
+			encoder.buffer = append(encoder.buffer, values[start:i]...)
+			switch b {
+			case '"', '\\':
+				encoder.buffer = append(encoder.buffer, '\\', b)
+			case '\n':
+				encoder.buffer = append(encoder.buffer, '\\', 'n')
+			case '\r':
+				encoder.buffer = append(encoder.buffer, '\\', 'r')
+			case '\t':
+				encoder.buffer = append(encoder.buffer, '\\', 't')
+			default:
+				encoder.buffer = append(encoder.buffer, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xf])
+			}
+
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRune(values[i:])
+		if r == utf8.RuneError && size == 1 {
+			encoder.buffer = append(encoder.buffer, values[start:i]...)
+			encoder.buffer = append(encoder.buffer, `\ufffd`...)
+			start = i + size
+		}
+		i += size
+	}
+
+	encoder.buffer = append(encoder.buffer, values[start:]...)
+	encoder.buffer = append(encoder.buffer, '"')
+	return encoder
+}
+
+// AppendHexBytes appends a byte slice as a quoted lowercase hexadecimal string.
+// The bytes are encoded directly into the buffer without intermediate allocations.
+//
+// Parameters:
+//   - values: The byte slice to encode
+//
+// Returns:
+//   - The encoder for method chaining
+//
+// Example:
+//
+//	encoder.AppendKey("digest").AppendHexBytes([]byte{0xde, 0xad})
+//	// Produces: "digest":"dead"
+func (encoder *JSONEncoder) AppendHexBytes(values []byte) *JSONEncoder {
+	encoder.buffer = append(encoder.buffer, '"')
+	encoder.buffer = hex.AppendEncode(encoder.buffer, values)
+	encoder.buffer = append(encoder.buffer, '"')
+	return encoder
+}
+
+// AppendBase64Bytes appends a byte slice as a quoted standard base64 string (with padding).
+// The bytes are encoded directly into the buffer without intermediate allocations.
+//
+// Parameters:
+//   - values: The byte slice to encode
+//
+// Returns:
+//   - The encoder for method chaining
+//
+// Example:
+//
+//	encoder.AppendKey("payload").AppendBase64Bytes([]byte("hello"))
+//	// Produces: "payload":"aGVsbG8="
+func (encoder *JSONEncoder) AppendBase64Bytes(values []byte) *JSONEncoder {
+	encoder.buffer = append(encoder.buffer, '"')
+	encoder.buffer = base64.StdEncoding.AppendEncode(encoder.buffer, values)
+	encoder.buffer = append(encoder.buffer, '"')
+	return encoder
+}
+
 // AppendBool appends a JSON boolean value (true or false, unquoted).
 //
 // Parameters:
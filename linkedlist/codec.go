@@ -0,0 +1,92 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements the json.Marshaler interface for LinkedList.
+// The list is serialized as a JSON array of its elements in order.
+// Elements are encoded node by node without building an intermediate slice.
+//
+// Returns:
+//   - A JSON byte array representing the list
+//   - An error if marshaling any element fails
+//
+// Example:
+//
+//	list := linkedlist.FromSlice([]int{1, 2, 3})
+//	data, _ := json.Marshal(list)
+//	// data = [1,2,3]
+func (list *LinkedListBase[I, D]) MarshalJSON() ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+
+	buffer.WriteByte('[')
+
+	for iterator := list.head; iterator != nil; iterator = iterator.right {
+		if iterator != list.head {
+			buffer.WriteByte(',')
+		}
+
+		if err := encoder.Encode(iterator.Data); err != nil {
+			return nil, err
+		}
+
+		// Encoder terminates every value with a newline
+		buffer.Truncate(buffer.Len() - 1)
+	}
+
+	buffer.WriteByte(']')
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LinkedList.
+// It expects a JSON array and replaces the content of the list with its elements in order.
+// A JSON null leaves the list empty. On error the list is left unchanged.
+//
+// Parameters:
+//   - data: The JSON byte array to deserialize
+//
+// Returns an error if data is not a JSON array or an element cannot be decoded.
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	_ = json.Unmarshal([]byte(`[1,2,3]`), list)
+//	// List now contains: 1, 2, 3
+func (list *LinkedListBase[I, D]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	decoded := NewLinkedList[D]()
+
+	if token != nil {
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("linkedlist: cannot unmarshal %v into LinkedList", token)
+		}
+
+		for decoder.More() {
+			var item D
+			if err = decoder.Decode(&item); err != nil {
+				return err
+			}
+			decoded.Push(item)
+		}
+
+		if _, err = decoder.Token(); err != nil {
+			return err
+		}
+	}
+
+	list.DeleteAll()
+	list.head, list.tail, list.size = decoded.head, decoded.tail, decoded.size
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+type codecPoint struct {
+	X    int      `json:"x"`
+	Y    int      `json:"y"`
+	Tags []string `json:"tags"`
+}
+
+func TestLinkedList_MarshalJSON_Empty(t *testing.T) {
+	data, err := json.Marshal(NewLinkedList[int]())
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != "[]" {
+		t.Errorf("Expected [], got %s", data)
+	}
+}
+
+func TestLinkedList_MarshalJSON_Order(t *testing.T) {
+	data, err := json.Marshal(FromSlice([]string{"a", "b", "<c>"}))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	expected, _ := json.Marshal([]string{"a", "b", "<c>"})
+	if string(data) != string(expected) {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestLinkedList_UnmarshalJSON_Empty(t *testing.T) {
+	list := FromSlice([]int{1, 2})
+
+	if err := json.Unmarshal([]byte("[]"), list); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !list.IsEmpty() {
+		t.Errorf("Expected empty list, got %v", list.ToSlice())
+	}
+
+	list.PushAll(1, 2)
+	if err := json.Unmarshal([]byte("null"), list); err != nil {
+		t.Fatalf("Unmarshal of null failed: %v", err)
+	}
+	if !list.IsEmpty() {
+		t.Errorf("Expected empty list after null, got %v", list.ToSlice())
+	}
+}
+
+func TestLinkedList_UnmarshalJSON_Invalid(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	for _, input := range []string{`{"a":1}`, `"text"`, `[1,"two",3]`, `[1,2`} {
+		if err := json.Unmarshal([]byte(input), list); err == nil {
+			t.Errorf("Expected error for %s", input)
+		}
+		if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("List should be unchanged after %s, got %v", input, list.ToSlice())
+		}
+	}
+}
+
+func TestLinkedList_JSON_RoundTrip(t *testing.T) {
+	source := FromSlice([]int{5, -1, 3, 0, 42})
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	decoded := NewLinkedList[int]()
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if !slices.Equal(decoded.ToSlice(), source.ToSlice()) {
+		t.Errorf("Expected %v, got %v", source.ToSlice(), decoded.ToSlice())
+	}
+	if decoded.Last() != 42 || decoded.Size() != 5 {
+		t.Errorf("Tail or size not restored: last=%d size=%d", decoded.Last(), decoded.Size())
+	}
+
+	decoded.PopRight()
+	decoded.PushFront(7)
+	if !slices.Equal(decoded.ToSlice(), []int{7, 5, -1, 3, 0}) {
+		t.Errorf("Decoded list links are broken: %v", decoded.ToSlice())
+	}
+}
+
+func TestLinkedList_JSON_RoundTrip_Structs(t *testing.T) {
+	source := FromSlice([]codecPoint{
+		{X: 1, Y: 2, Tags: []string{"a"}},
+		{X: 3, Y: 4},
+		{X: 5, Y: 6, Tags: []string{"b", "c"}},
+	})
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	decoded := NewLinkedList[codecPoint]()
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if decoded.Size() != source.Size() {
+		t.Fatalf("Expected size %d, got %d", source.Size(), decoded.Size())
+	}
+	for i := 0; i < source.Size(); i++ {
+		expected, actual := source.At(i), decoded.At(i)
+		if expected.X != actual.X || expected.Y != actual.Y || !slices.Equal(expected.Tags, actual.Tags) {
+			t.Errorf("Index %d: expected %+v, got %+v", i, expected, actual)
+		}
+	}
+}
+
+func TestLinkedList_JSON_Nested(t *testing.T) {
+	type container struct {
+		Name  string                        `json:"name"`
+		Items *LinkedList[*LinkedList[int]] `json:"items"`
+	}
+
+	inner := NewLinkedList[*LinkedList[int]]()
+	inner.Push(FromSlice([]int{1, 2}))
+	inner.Push(NewLinkedList[int]())
+
+	data, err := json.Marshal(container{Name: "nested", Items: inner})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"name":"nested","items":[[1,2],[]]}` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+
+	var decoded container
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Items.Size() != 2 || !slices.Equal(decoded.Items.First().ToSlice(), []int{1, 2}) || !decoded.Items.Last().IsEmpty() {
+		t.Errorf("Nested lists not restored: %s", data)
+	}
+}
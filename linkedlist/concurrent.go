@@ -0,0 +1,440 @@
+package linkedlist
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/0x626f/go-kit/abstract"
+)
+
+// ConcurrentLinkedList is a thread-safe wrapper around LinkedList.
+// Methods that only read the list take a shared read lock, and methods that modify it
+// take the exclusive write lock, so the list can be used from multiple goroutines.
+//
+// Methods returning or accepting *LinkedNode (Insert, InsertFront, InsertAt, Remove, MoveToFront)
+// exist for cache-like use cases. The node links are guarded by the lock only while a method runs:
+// a returned node may be unlinked or relinked by other goroutines at any time, so callers must not
+// read or modify its links, and may only pass it back to Remove or MoveToFront if no other goroutine
+// can remove it concurrently.
+//
+// Callbacks (predicates, comparators, ForEach receivers) are invoked while the lock is held
+// and must not call methods of the same list, otherwise they deadlock.
+//
+// Type parameters:
+//   - D: The type of data stored in the list
+//
+// Example:
+//
+//	list := linkedlist.NewConcurrentLinkedList[int]()
+//
+//	var wg sync.WaitGroup
+//	for i := 0; i < 10; i++ {
+//	    wg.Add(1)
+//	    go func(value int) {
+//	        defer wg.Done()
+//	        list.Push(value)
+//	    }(i)
+//	}
+//	wg.Wait()
+//	// list.Size() == 10
+type ConcurrentLinkedList[D any] struct {
+	// mutex guards every access to list
+	mutex sync.RWMutex
+	// list is the underlying non thread-safe list
+	list *LinkedList[D]
+}
+
+// NewConcurrentLinkedList creates and returns a new empty thread-safe linked list.
+//
+// Type parameters:
+//   - D: The type of data to store in the list
+//
+// Returns:
+//   - A pointer to a new empty ConcurrentLinkedList
+func NewConcurrentLinkedList[D any]() *ConcurrentLinkedList[D] {
+	return &ConcurrentLinkedList[D]{list: NewLinkedList[D]()}
+}
+
+// Size returns the number of elements in the list.
+func (list *ConcurrentLinkedList[D]) Size() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Size()
+}
+
+// IsEmpty checks if the list contains no elements.
+func (list *ConcurrentLinkedList[D]) IsEmpty() bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.IsEmpty()
+}
+
+// At returns the element at the specified index (supports negative indices),
+// or a zero value if the index is out of bounds.
+func (list *ConcurrentLinkedList[D]) At(index int) D {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.At(index)
+}
+
+// Get is an alias for At.
+func (list *ConcurrentLinkedList[D]) Get(index int) D {
+	return list.At(index)
+}
+
+// First returns the first element, or a zero value if the list is empty.
+func (list *ConcurrentLinkedList[D]) First() D {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.First()
+}
+
+// Last returns the last element, or a zero value if the list is empty.
+func (list *ConcurrentLinkedList[D]) Last() D {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Last()
+}
+
+// Push adds an element to the end of the list.
+func (list *ConcurrentLinkedList[D]) Push(data D) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Push(data)
+}
+
+// PushFront adds an element to the beginning of the list.
+func (list *ConcurrentLinkedList[D]) PushFront(data D) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.PushFront(data)
+}
+
+// PushAll adds multiple elements to the end of the list atomically.
+func (list *ConcurrentLinkedList[D]) PushAll(data ...D) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.PushAll(data...)
+}
+
+// Insert adds an element to the end of the list and returns the created node.
+// See ConcurrentLinkedList for the restrictions on using the returned node.
+func (list *ConcurrentLinkedList[D]) Insert(data D) *LinkedNode[D] {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.Insert(data)
+}
+
+// InsertFront adds an element to the beginning of the list and returns the created node.
+// See ConcurrentLinkedList for the restrictions on using the returned node.
+func (list *ConcurrentLinkedList[D]) InsertFront(data D) *LinkedNode[D] {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.InsertFront(data)
+}
+
+// InsertAt adds an element before the node currently at the specified index and returns the created node.
+// See LinkedListBase.InsertAt for the index semantics and ConcurrentLinkedList for the restrictions
+// on using the returned node.
+func (list *ConcurrentLinkedList[D]) InsertAt(index int, data D) *LinkedNode[D] {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.InsertAt(index, data)
+}
+
+// Remove removes a specific node from the list.
+// The node must belong to this list and must not have been removed already.
+func (list *ConcurrentLinkedList[D]) Remove(node *LinkedNode[D]) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Remove(node)
+}
+
+// MoveToFront moves a specific node to the front of the list.
+// The node must belong to this list and must not have been removed already.
+func (list *ConcurrentLinkedList[D]) MoveToFront(node *LinkedNode[D]) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.MoveToFront(node)
+}
+
+// IndexOf returns the index of the first element matching the predicate.
+func (list *ConcurrentLinkedList[D]) IndexOf(predicate abstract.Predicate[D]) (int, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.IndexOf(predicate)
+}
+
+// LastIndexOf returns the index of the last element matching the predicate.
+func (list *ConcurrentLinkedList[D]) LastIndexOf(predicate abstract.Predicate[D]) (int, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.LastIndexOf(predicate)
+}
+
+// Join appends all elements from another collection to this list.
+// The elements of the collection are read before the lock is taken,
+// so a list can be joined with itself.
+func (list *ConcurrentLinkedList[D]) Join(collection abstract.Collection[int, D]) {
+	var items []D
+	collection.ForEach(func(index int, data D) bool {
+		items = append(items, data)
+		return true
+	})
+
+	list.PushAll(items...)
+}
+
+// Merge creates a new thread-safe list containing all elements from this list and another collection.
+// The original lists are not modified.
+func (list *ConcurrentLinkedList[D]) Merge(collection abstract.Collection[int, D]) abstract.Collection[int, D] {
+	merged := list.Clone()
+	merged.Join(collection)
+
+	return merged
+}
+
+// Clone creates a new thread-safe list with the same elements in the same order.
+// See LinkedListBase.Clone for the copy semantics.
+func (list *ConcurrentLinkedList[D]) Clone() *ConcurrentLinkedList[D] {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return &ConcurrentLinkedList[D]{list: list.list.Clone()}
+}
+
+// SubList creates a new list with the elements in the range [start, end), leaving this list unmodified.
+// See LinkedListBase.SubList for the index semantics.
+func (list *ConcurrentLinkedList[D]) SubList(start, end int) *ConcurrentLinkedList[D] {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return &ConcurrentLinkedList[D]{list: list.list.SubList(start, end)}
+}
+
+// EqualBy reports whether the list and another collection hold equal elements in the same order.
+// The list is copied before other is read, so a list can be compared with itself.
+// See LinkedListBase.EqualBy for the comparison.
+func (list *ConcurrentLinkedList[D]) EqualBy(other abstract.Collection[int, D], equal func(a, b D) bool) bool {
+	return list.Clone().list.EqualBy(other, equal)
+}
+
+// Delete removes the element at the specified index (supports negative indices).
+func (list *ConcurrentLinkedList[D]) Delete(index int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Delete(index)
+}
+
+// DeleteBy removes all elements matching the predicate.
+func (list *ConcurrentLinkedList[D]) DeleteBy(predicate abstract.Predicate[D]) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.DeleteBy(predicate)
+}
+
+// DeleteAll removes all elements from the list.
+func (list *ConcurrentLinkedList[D]) DeleteAll() {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.DeleteAll()
+}
+
+// Some checks if at least one element matches the predicate.
+func (list *ConcurrentLinkedList[D]) Some(predicate abstract.Predicate[D]) bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Some(predicate)
+}
+
+// Contains checks if the list holds an element matching the predicate.
+func (list *ConcurrentLinkedList[D]) Contains(predicate abstract.Predicate[D]) bool {
+	return list.Some(predicate)
+}
+
+// Count returns the number of elements matching the predicate.
+func (list *ConcurrentLinkedList[D]) Count(predicate abstract.Predicate[D]) int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Count(predicate)
+}
+
+// Find returns the first element matching the predicate.
+func (list *ConcurrentLinkedList[D]) Find(predicate abstract.Predicate[D]) (D, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Find(predicate)
+}
+
+// FindLast returns the last element matching the predicate.
+func (list *ConcurrentLinkedList[D]) FindLast(predicate abstract.Predicate[D]) (D, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.FindLast(predicate)
+}
+
+// Filter creates a new thread-safe list containing only the elements matching the predicate.
+func (list *ConcurrentLinkedList[D]) Filter(predicate abstract.Predicate[D]) abstract.Collection[int, D] {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return &ConcurrentLinkedList[D]{list: list.list.Filter(predicate).(*LinkedList[D])}
+}
+
+// Partition splits the elements into two new thread-safe lists: those matching the predicate and the others.
+// See LinkedListBase.Partition for the ordering.
+func (list *ConcurrentLinkedList[D]) Partition(predicate abstract.Predicate[D]) (matched, rest *ConcurrentLinkedList[D]) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	matchedList, restList := list.list.Partition(predicate)
+	return &ConcurrentLinkedList[D]{list: matchedList}, &ConcurrentLinkedList[D]{list: restList}
+}
+
+// ForEach iterates over all elements in the list while holding the read lock.
+// If the receiver returns false, iteration stops early.
+func (list *ConcurrentLinkedList[D]) ForEach(receiver abstract.IndexedReceiver[int, D]) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	list.list.ForEach(receiver)
+}
+
+// All returns an iterator over the index-value pairs of a snapshot of the list.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (list *ConcurrentLinkedList[D]) All() iter.Seq2[int, D] {
+	return func(yield func(int, D) bool) {
+		for index, data := range list.ToSlice() {
+			if !yield(index, data) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of a snapshot of the list.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (list *ConcurrentLinkedList[D]) Values() iter.Seq[D] {
+	return func(yield func(D) bool) {
+		for _, data := range list.ToSlice() {
+			if !yield(data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs of a snapshot of the list in reverse order.
+// The snapshot is taken when iteration starts, so the loop body may modify the list.
+func (list *ConcurrentLinkedList[D]) Backward() iter.Seq2[int, D] {
+	return func(yield func(int, D) bool) {
+		items := list.ToSlice()
+		for index := len(items) - 1; index >= 0; index-- {
+			if !yield(index, items[index]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the list as a new slice.
+func (list *ConcurrentLinkedList[D]) ToSlice() []D {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.ToSlice()
+}
+
+// Pop removes and returns the element at the specified index (supports negative indices).
+func (list *ConcurrentLinkedList[D]) Pop(index int) D {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.Pop(index)
+}
+
+// PopLeft removes and returns the first element, or a zero value if the list is empty.
+func (list *ConcurrentLinkedList[D]) PopLeft() D {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.PopLeft()
+}
+
+// PopRight removes and returns the last element, or a zero value if the list is empty.
+func (list *ConcurrentLinkedList[D]) PopRight() D {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.PopRight()
+}
+
+// Swap exchanges the elements at two indices.
+func (list *ConcurrentLinkedList[D]) Swap(i, j int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Swap(i, j)
+}
+
+// Move moves the element at index from to index to.
+func (list *ConcurrentLinkedList[D]) Move(from, to int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Move(from, to)
+}
+
+// Shrink removes elements from the end of the list until its size is at most capacity.
+func (list *ConcurrentLinkedList[D]) Shrink(capacity int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Shrink(capacity)
+}
+
+// Reverse reverses the order of the elements in place.
+func (list *ConcurrentLinkedList[D]) Reverse() {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Reverse()
+}
+
+// Rotate moves the elements n positions to the left in place, wrapping around (negative n rotates right).
+func (list *ConcurrentLinkedList[D]) Rotate(n int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Rotate(n)
+}
+
+// Sort sorts the list in place with a stable merge sort.
+func (list *ConcurrentLinkedList[D]) Sort(comparator abstract.Comparator[D]) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Sort(comparator)
+}
@@ -0,0 +1,136 @@
+package linkedlist
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/0x626f/go-kit/abstract"
+)
+
+// ============================================================================
+// Concurrent Linked List
+// ============================================================================
+
+var _ abstract.Collection[int, int] = (*ConcurrentLinkedList[int])(nil)
+
+func TestConcurrentLinkedList_BasicOperations(t *testing.T) {
+	list := NewConcurrentLinkedList[int]()
+
+	if !list.IsEmpty() {
+		t.Error("New list should be empty")
+	}
+
+	list.PushAll(2, 3)
+	list.PushFront(1)
+	list.InsertAt(-1, 10)
+
+	if got := list.ToSlice(); !slices.Equal(got, []int{1, 2, 10, 3}) {
+		t.Errorf("ToSlice should be [1 2 10 3], got %v", got)
+	}
+
+	list.Sort(func(a, b int) int { return a - b })
+
+	if list.First() != 1 || list.Last() != 10 {
+		t.Errorf("First/Last should be 1/10, got %d/%d", list.First(), list.Last())
+	}
+
+	if list.Count(func(x int) bool { return x > 1 }) != 3 {
+		t.Error("Count should find 3 elements greater than 1")
+	}
+
+	filtered := list.Filter(func(x int) bool { return x%2 == 0 })
+	if _, ok := filtered.(*ConcurrentLinkedList[int]); !ok || filtered.Size() != 2 {
+		t.Errorf("Filter should return a concurrent list of size 2, got %T of size %d", filtered, filtered.Size())
+	}
+
+	evens, odds := list.Partition(func(x int) bool { return x%2 == 0 })
+	if !slices.Equal(evens.ToSlice(), []int{2, 10}) || !slices.Equal(odds.ToSlice(), []int{1, 3}) {
+		t.Errorf("Partition should return [2 10] and [1 3], got %v and %v", evens.ToSlice(), odds.ToSlice())
+	}
+
+	if val := list.PopRight(); val != 10 || list.Size() != 3 {
+		t.Errorf("PopRight should return 10 and leave 3 elements, got %d and %d", val, list.Size())
+	}
+}
+
+func TestConcurrentLinkedList_JoinItself(t *testing.T) {
+	list := NewConcurrentLinkedList[int]()
+	list.PushAll(1, 2)
+
+	list.Join(list)
+
+	if got := list.ToSlice(); !slices.Equal(got, []int{1, 2, 1, 2}) {
+		t.Errorf("Join with itself should duplicate the elements, got %v", got)
+	}
+}
+
+func TestConcurrentLinkedList_EqualBy(t *testing.T) {
+	list := NewConcurrentLinkedList[int]()
+	list.PushAll(1, 2, 3)
+
+	equal := func(a, b int) bool { return a == b }
+
+	if !list.EqualBy(list, equal) {
+		t.Error("A list should be equal to itself")
+	}
+	if !list.EqualBy(FromSlice([]int{1, 2, 3}), equal) || list.EqualBy(FromSlice([]int{1, 2}), equal) {
+		t.Error("EqualBy should compare sizes and elements")
+	}
+}
+
+func TestConcurrentLinkedList_IterateAndModify(t *testing.T) {
+	list := NewConcurrentLinkedList[int]()
+	list.PushAll(1, 2, 3)
+
+	for _, value := range list.All() {
+		list.Push(value * 10)
+	}
+
+	if got := list.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 10, 20, 30}) {
+		t.Errorf("Iterating a snapshot should allow modifications, got %v", got)
+	}
+}
+
+func TestConcurrentLinkedList_Race(t *testing.T) {
+	const goroutines = 16
+	const iterations = 500
+
+	list := NewConcurrentLinkedList[int]()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < goroutines; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+
+			for iteration := 0; iteration < iterations; iteration++ {
+				switch iteration % 5 {
+				case 0:
+					list.Push(iteration)
+				case 1:
+					list.PushFront(iteration)
+				case 2:
+					_ = list.At(worker)
+					_ = list.Size()
+				case 3:
+					list.Find(func(x int) bool { return x == worker })
+					list.ForEach(func(index int, data int) bool { return index < 10 })
+				case 4:
+					list.PopLeft()
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	// Every worker pushes two elements and pops one per five iterations
+	expected := goroutines * iterations / 5
+	if list.Size() != expected {
+		t.Errorf("Size should be %d, got %d", expected, list.Size())
+	}
+
+	if got := len(list.ToSlice()); got != expected {
+		t.Errorf("ToSlice should have %d elements, got %d", expected, got)
+	}
+}
@@ -6,8 +6,10 @@
 //   - Deletion by index or predicate
 //   - Access by index with optimized traversal
 //   - Functional operations (filter, find, forEach, etc.)
-//   - In-place sorting using quicksort
+//   - In-place stable sorting using merge sort
 //   - Node manipulation for cache implementations
+//   - An optional bound on the size, evicting from the opposite end on insertion
+//   - JSON serialization as an array of elements
 //
 // The list uses bidirectional links, allowing efficient traversal from either end
 // and enabling optimizations like accessing elements closer to the back by traversing
@@ -15,6 +17,9 @@
 package linkedlist
 
 import (
+	"iter"
+	"sync"
+
 	"github.com/0x626f/go-kit/abstract"
 	"github.com/0x626f/go-kit/utils"
 )
@@ -44,10 +49,15 @@ type LinkedList[D any] struct {
 //   - I: Index type (always int in practice)
 //   - D: The type of data stored in nodes
 type LinkedListBase[I int, D any] struct {
-	// head points to the first node in the list, or nil if empty
+	// head and tail point to the first and last nodes, or are both nil if empty.
+	// A single-element list has head == tail.
 	head, tail *LinkedNode[D]
 	// size tracks the current number of elements in the list
 	size int
+	// pool recycles removed nodes, or is nil if the list allocates every node
+	pool *sync.Pool
+	// bound is the maximum number of elements, or 0 if the list is unbounded
+	bound int
 }
 
 // LinkedNode represents a single node in the doubly-linked list.
@@ -79,6 +89,250 @@ func NewLinkedList[D any]() *LinkedList[D] {
 	return &LinkedList[D]{}
 }
 
+// NewLinkedListPooled creates a new empty linked list that recycles its nodes.
+// Nodes released by Remove, Pop, PopLeft, PopRight, DeleteAll and the methods built on them
+// are zeroed and returned to a sync.Pool, and later insertions draw from it.
+// This reduces allocations in queue-like workloads with frequent push/pop churn.
+//
+// A node returned by Insert, InsertFront or InsertAt must not be used after it has been removed,
+// since the pool may hand it out again for a different element.
+//
+// Type parameters:
+//   - D: The type of data to store in the list
+//
+// Returns:
+//   - A pointer to the newly created LinkedList
+//
+// Example:
+//
+//	queue := linkedlist.NewLinkedListPooled[int]()
+//	queue.Push(1)
+//	queue.PopLeft() // the node is recycled
+//	queue.Push(2)   // and reused here
+func NewLinkedListPooled[D any]() *LinkedList[D] {
+	return &LinkedList[D]{
+		LinkedListBase: LinkedListBase[int, D]{
+			pool: &sync.Pool{
+				New: func() any {
+					return &LinkedNode[D]{}
+				},
+			},
+		},
+	}
+}
+
+// NewBoundedLinkedList creates a new empty linked list holding at most capacity elements.
+// Once the list is full, inserting at the back (Push, PushAll, Insert, Join) evicts the first element,
+// and inserting at the front (PushFront, InsertFront) evicts the last one, so the list keeps the
+// most recently added elements. InsertAt evicts the first element too, unless it inserts at the front.
+// Use PushEvicting and PushFrontEvicting to receive the evicted element.
+//
+// Type parameters:
+//   - D: The type of data to store in the list
+//
+// Parameters:
+//   - capacity: The maximum number of elements; values below 1 are raised to 1
+//
+// Returns:
+//   - A pointer to the newly created LinkedList
+//
+// Example:
+//
+//	events := linkedlist.NewBoundedLinkedList[string](3)
+//	events.PushAll("a", "b", "c", "d")
+//	// events contains: "b", "c", "d"
+func NewBoundedLinkedList[D any](capacity int) *LinkedList[D] {
+	return &LinkedList[D]{
+		LinkedListBase: LinkedListBase[int, D]{bound: max(capacity, 1)},
+	}
+}
+
+// FromSlice creates a new linked list containing the elements of the slice in order.
+// The slice is not retained; modifying it afterwards doesn't affect the list.
+//
+// Type parameters:
+//   - D: The type of data to store in the list
+//
+// Parameters:
+//   - items: The elements to add to the list
+//
+// Returns:
+//   - A pointer to the newly created LinkedList
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.FromSlice([]int{1, 2, 3})
+//	// List now contains: 1, 2, 3
+func FromSlice[D any](items []D) *LinkedList[D] {
+	list := NewLinkedList[D]()
+	list.PushAll(items...)
+	return list
+}
+
+// Map creates a new list by applying fn to every element of the source list, in order.
+// The element type may change, which is why Map is a function rather than a method.
+// The source list is not modified.
+//
+// Type parameters:
+//   - A: The type of the elements of the source list
+//   - B: The type of the elements of the resulting list
+//
+// Parameters:
+//   - list: The source list
+//   - fn: The function transforming each element
+//
+// Returns:
+//   - A new list containing the transformed elements
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	numbers := linkedlist.FromSlice([]int{1, 2, 3})
+//	labels := linkedlist.Map(numbers, strconv.Itoa)
+//	// labels contains: "1", "2", "3"
+func Map[A, B any](list *LinkedList[A], fn func(A) B) *LinkedList[B] {
+	mapped := NewLinkedList[B]()
+	iterator := list.head
+
+	for iterator != nil {
+		mapped.Push(fn(iterator.Data))
+		iterator = iterator.right
+	}
+
+	return mapped
+}
+
+// Reduce folds the elements of the list into a single value, from head to tail.
+//
+// Type parameters:
+//   - A: The type of the elements of the list
+//   - B: The type of the accumulated value
+//
+// Parameters:
+//   - list: The source list
+//   - init: The initial accumulated value
+//   - fn: The function combining the accumulated value with the next element
+//
+// Returns:
+//   - The accumulated value, or init if the list is empty
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	numbers := linkedlist.FromSlice([]int{1, 2, 3})
+//	sum := linkedlist.Reduce(numbers, 0, func(acc, x int) int { return acc + x })
+//	// sum = 6
+func Reduce[A, B any](list *LinkedList[A], init B, fn func(B, A) B) B {
+	accumulated := init
+	iterator := list.head
+
+	for iterator != nil {
+		accumulated = fn(accumulated, iterator.Data)
+		iterator = iterator.right
+	}
+
+	return accumulated
+}
+
+// Equal reports whether the list and another collection hold equal elements in the same order,
+// comparing elements with ==. Use EqualBy for element types that aren't comparable.
+//
+// Type parameters:
+//   - D: The type of the elements (must be comparable)
+//
+// Parameters:
+//   - list: The list to compare
+//   - other: The collection to compare with
+//
+// Returns:
+//   - true if both have the same size and equal elements at every index
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	a := linkedlist.FromSlice([]int{1, 2, 3})
+//	b := linkedlist.FromSlice([]int{1, 2, 3})
+//	linkedlist.Equal(a, b) // true
+func Equal[D comparable](list *LinkedList[D], other abstract.Collection[int, D]) bool {
+	return list.EqualBy(other, func(a, b D) bool {
+		return a == b
+	})
+}
+
+// RemoveDuplicates removes the later occurrences of repeated elements, comparing elements with ==.
+// The first occurrence of every element is kept in place, so the list keeps its first-seen order
+// whether it is sorted or not. Use RemoveDuplicatesBy for element types that aren't comparable.
+//
+// Type parameters:
+//   - D: The type of the elements (must be comparable)
+//
+// Parameters:
+//   - list: The list to remove duplicates from
+//
+// Returns:
+//   - The number of removed elements
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.FromSlice([]int{3, 1, 3, 2, 1})
+//	removed := linkedlist.RemoveDuplicates(list)
+//	// removed = 2, list contains: 3, 1, 2
+func RemoveDuplicates[D comparable](list *LinkedList[D]) int {
+	return RemoveDuplicatesBy(list, func(data D) D {
+		return data
+	})
+}
+
+// RemoveDuplicatesBy removes the later occurrences of elements with the same key,
+// keeping the first occurrence of every key in place.
+//
+// Type parameters:
+//   - D: The type of the elements
+//   - K: The type of the keys (must be comparable)
+//
+// Parameters:
+//   - list: The list to remove duplicates from
+//   - key: The function deriving the comparison key of an element
+//
+// Returns:
+//   - The number of removed elements
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	users := linkedlist.FromSlice([]User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 1, Name: "c"}})
+//	linkedlist.RemoveDuplicatesBy(users, func(user User) int { return user.ID })
+//	// users contains: {1 a}, {2 b}
+func RemoveDuplicatesBy[D any, K comparable](list *LinkedList[D], key func(D) K) int {
+	seen := make(map[K]struct{}, list.size)
+	removed := 0
+	iterator := list.head
+
+	for iterator != nil {
+		next := iterator.right
+		k := key(iterator.Data)
+
+		if _, exists := seen[k]; exists {
+			list.Remove(iterator)
+			removed++
+		} else {
+			seen[k] = struct{}{}
+		}
+
+		iterator = next
+	}
+
+	return removed
+}
+
 // insert is an internal method that adds a new node to the list.
 // When back is true, inserts at the tail; when false, inserts at the head.
 //
@@ -89,32 +343,33 @@ func NewLinkedList[D any]() *LinkedList[D] {
 // Returns:
 //   - A pointer to the newly created node
 func (list *LinkedListBase[I, D]) insert(data D, back bool) *LinkedNode[D] {
-	node := &LinkedNode[D]{Data: data}
+	node := list.link(data, back)
+	list.evict(back)
 
-	if list.head == nil {
-		list.head = node
-	} else if list.tail == nil {
-		if back {
-			list.tail = node
-			list.tail.left = list.head
-			list.head.right = list.tail
-		} else {
-			list.tail = list.head
-			list.head = node
+	return node
+}
 
-			list.tail.left = list.head
-			list.head.right = list.tail
-		}
+// link is an internal method that adds a new node to either end of the list, ignoring the bound.
+//
+// Parameters:
+//   - data: The data to store in the new node
+//   - back: If true, insert at tail; if false, insert at head
+//
+// Returns:
+//   - A pointer to the newly created node
+func (list *LinkedListBase[I, D]) link(data D, back bool) *LinkedNode[D] {
+	node := list.newNode(data)
+
+	if list.head == nil {
+		list.head, list.tail = node, node
+	} else if back {
+		list.tail.right = node
+		node.left = list.tail
+		list.tail = node
 	} else {
-		if back {
-			list.tail.right = node
-			node.left = list.tail
-			list.tail = node
-		} else {
-			list.head.left = node
-			node.right = list.head
-			list.head = node
-		}
+		list.head.left = node
+		node.right = list.head
+		list.head = node
 	}
 
 	list.size++
@@ -122,6 +377,59 @@ func (list *LinkedListBase[I, D]) insert(data D, back bool) *LinkedNode[D] {
 	return node
 }
 
+// evict is an internal method that removes an element from the end opposite to the last insertion
+// once a bounded list holds more elements than its capacity.
+//
+// Parameters:
+//   - back: If true, the last insertion was at the tail and the head is evicted; otherwise the tail is
+//
+// Returns:
+//   - The evicted element and true, or a zero value and false if nothing was evicted
+func (list *LinkedListBase[I, D]) evict(back bool) (D, bool) {
+	if list.bound == 0 || list.size <= list.bound {
+		return utils.Zero[D](), false
+	}
+
+	if back {
+		return list.PopLeft(), true
+	}
+	return list.PopRight(), true
+}
+
+// newNode is an internal method that creates a node holding data,
+// drawing it from the pool when the list is pooled.
+//
+// Parameters:
+//   - data: The data to store in the node
+//
+// Returns:
+//   - A pointer to an unlinked node
+func (list *LinkedListBase[I, D]) newNode(data D) *LinkedNode[D] {
+	if list.pool == nil {
+		return &LinkedNode[D]{Data: data}
+	}
+
+	node := list.pool.Get().(*LinkedNode[D])
+	node.Data = data
+
+	return node
+}
+
+// releaseNode is an internal method that returns an unlinked node to the pool.
+// The node data is zeroed so the pool doesn't retain references. It is a no-op for non-pooled lists.
+//
+// Parameters:
+//   - node: The node to release
+func (list *LinkedListBase[I, D]) releaseNode(node *LinkedNode[D]) {
+	if list.pool == nil {
+		return
+	}
+
+	node.left, node.right = nil, nil
+	node.Data = utils.Zero[D]()
+	list.pool.Put(node)
+}
+
 // deleteByIndex is an internal method that removes a node at the specified index.
 //
 // Parameters:
@@ -158,6 +466,8 @@ func (list *LinkedListBase[I, D]) Remove(node *LinkedNode[D]) {
 		list.head = node.right
 	}
 	list.size--
+
+	list.releaseNode(node)
 }
 
 // calcAbsoluteIndex converts a potentially negative index to an absolute position.
@@ -235,6 +545,14 @@ func (list *LinkedListBase[I, D]) findNodeByIndex(index int) *LinkedNode[D] {
 	return iterator
 }
 
+// Cap returns the maximum number of elements of a list created by NewBoundedLinkedList.
+//
+// Returns:
+//   - The capacity of the list, or 0 if the list is unbounded
+func (list *LinkedListBase[I, D]) Cap() int {
+	return list.bound
+}
+
 // Size returns the number of elements in the list.
 //
 // Returns:
@@ -294,6 +612,7 @@ func (list *LinkedListBase[I, D]) Get(index int) D {
 }
 
 // Push appends an element to the end of the list.
+// A full bounded list evicts its first element (see NewBoundedLinkedList).
 //
 // Parameters:
 //   - data: The element to append
@@ -304,6 +623,7 @@ func (list *LinkedListBase[I, D]) Push(data D) {
 }
 
 // PushFront inserts an element at the beginning of the list.
+// A full bounded list evicts its last element (see NewBoundedLinkedList).
 //
 // Parameters:
 //   - data: The element to insert at the front
@@ -313,6 +633,43 @@ func (list *LinkedListBase[I, D]) PushFront(data D) {
 	_ = list.insert(data, false)
 }
 
+// PushEvicting appends an element to the end of the list like Push, returning the first element
+// if it was evicted because a bounded list was full.
+//
+// Parameters:
+//   - data: The element to append
+//
+// Returns:
+//   - The evicted element and true, or a zero value and false if nothing was evicted
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	events := linkedlist.NewBoundedLinkedList[string](2)
+//	events.PushAll("a", "b")
+//	evicted, ok := events.PushEvicting("c")
+//	// evicted = "a", ok = true, events contains: "b", "c"
+func (list *LinkedListBase[I, D]) PushEvicting(data D) (D, bool) {
+	_ = list.link(data, true)
+	return list.evict(true)
+}
+
+// PushFrontEvicting inserts an element at the beginning of the list like PushFront, returning the last
+// element if it was evicted because a bounded list was full.
+//
+// Parameters:
+//   - data: The element to insert at the front
+//
+// Returns:
+//   - The evicted element and true, or a zero value and false if nothing was evicted
+//
+// Time complexity: O(1)
+func (list *LinkedListBase[I, D]) PushFrontEvicting(data D) (D, bool) {
+	_ = list.link(data, false)
+	return list.evict(false)
+}
+
 // PushAll appends multiple elements to the end of the list in order.
 //
 // Parameters:
@@ -359,6 +716,50 @@ func (list *LinkedListBase[I, D]) InsertFront(data D) *LinkedNode[D] {
 	return list.insert(data, false)
 }
 
+// InsertAt adds an element before the node currently at the specified index and returns the created node.
+// Negative indices count from the end (-1 inserts before the last element). Out-of-range
+// indices are clamped: an index >= Size() appends to the list, and an index <= -Size() prepends to it.
+//
+// Parameters:
+//   - index: The position the new element will occupy (supports negative indices)
+//   - data: The element to insert
+//
+// Returns:
+//   - A pointer to the newly created node
+//
+// Time complexity: O(n/2) on average, O(1) at either end
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 3)
+//	list.InsertAt(1, 2)
+//	// List now contains: 1, 2, 3
+func (list *LinkedListBase[I, D]) InsertAt(index int, data D) *LinkedNode[D] {
+	if index >= list.size {
+		return list.insert(data, true)
+	}
+
+	if index == 0 || index <= -list.size {
+		return list.insert(data, false)
+	}
+
+	next := list.findNodeByIndex(index)
+	if next == list.head {
+		return list.insert(data, false)
+	}
+
+	node := list.newNode(data)
+	node.left, node.right = next.left, next
+	next.left.right = node
+	next.left = node
+
+	list.size++
+	list.evict(true)
+
+	return node
+}
+
 // IndexOf finds the index of the first element matching the predicate.
 //
 // Parameters:
@@ -391,6 +792,38 @@ func (list *LinkedListBase[I, D]) IndexOf(predicate abstract.Predicate[D]) (int,
 	return 0, false
 }
 
+// LastIndexOf finds the index of the last element matching the predicate.
+// The list is traversed backwards from the tail, so it returns early on the last match.
+//
+// Parameters:
+//   - predicate: A function that returns true for the desired element
+//
+// Returns:
+//   - The index of the last matching element and true if found
+//   - 0 and false if no element matches
+//
+// Time complexity: O(n) in worst case
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(10, 20, 30, 20)
+//	idx, found := list.LastIndexOf(func(x int) bool { return x == 20 })
+//	// idx = 3, found = true
+func (list *LinkedListBase[I, D]) LastIndexOf(predicate abstract.Predicate[D]) (int, bool) {
+	index := list.size - 1
+	iterator := list.tail
+
+	for iterator != nil {
+		if predicate(iterator.Data) {
+			return index, true
+		}
+		iterator = iterator.left
+		index--
+	}
+	return 0, false
+}
+
 // Join appends all elements from another collection to this list.
 // This modifies the current list in place.
 //
@@ -414,42 +847,166 @@ func (list *LinkedListBase[I, D]) Join(collection abstract.Collection[int, D]) {
 	})
 }
 
-// Merge creates a new list containing all elements from this list and another collection.
-// The original lists are not modified.
+// Merge creates a new list containing all elements from this list and another collection.
+// The original lists are not modified.
+//
+// Parameters:
+//   - collection: The collection to merge with
+//
+// Returns:
+//   - A new list containing elements from both collections
+//
+// Time complexity: O(n + k) where n is this list's size and k is the collection's size
+//
+// Example:
+//
+//	list1 := linkedlist.NewLinkedList[int]()
+//	list1.PushAll(1, 2, 3)
+//	list2 := linkedlist.NewLinkedList[int]()
+//	list2.PushAll(4, 5, 6)
+//	merged := list1.Merge(list2)
+//	// merged contains: 1, 2, 3, 4, 5, 6
+//	// list1 and list2 are unchanged
+func (list *LinkedListBase[I, D]) Merge(collection abstract.Collection[int, D]) abstract.Collection[int, D] {
+	merged := NewLinkedList[D]()
+
+	iterator := list.head
+
+	for iterator != nil {
+		merged.Push(iterator.Data)
+		iterator = iterator.right
+	}
+
+	collection.ForEach(func(index int, data D) bool {
+		merged.Push(data)
+		return true
+	})
+
+	return merged
+}
+
+// Clone creates a new list with the same elements in the same order and the same bound.
+// The new list has its own nodes, so adding, removing, or reordering elements in one list
+// doesn't affect the other. Element values are copied as is: if D is a pointer, map, or
+// slice type, both lists refer to the same underlying data.
+//
+// Returns:
+//   - A new list containing the elements of this list
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 2, 3)
+//	clone := list.Clone()
+//	clone.PopLeft()
+//	// clone contains: 2, 3; list still contains: 1, 2, 3
+func (list *LinkedListBase[I, D]) Clone() *LinkedList[D] {
+	clone := NewLinkedList[D]()
+	clone.bound = list.bound
+	iterator := list.head
+
+	for iterator != nil {
+		clone.Push(iterator.Data)
+		iterator = iterator.right
+	}
+
+	return clone
+}
+
+// SubList creates a new list with the elements in the range [start, end), leaving this list unmodified.
+// Negative indices count from the end (-1 is the last element), and out-of-range bounds are
+// clamped to the list, so SubList never panics. Element values are copied as in Clone.
+//
+// Parameters:
+//   - start: The index of the first element to include (can be negative)
+//   - end: The index after the last element to include (can be negative)
+//
+// Returns:
+//   - A new list with the elements in range, or an empty list if start is not before end
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.FromSlice([]int{1, 2, 3, 4, 5})
+//	list.SubList(1, 3)  // contains: 2, 3
+//	list.SubList(-2, 5) // contains: 4, 5
+//	list.SubList(3, 99) // contains: 4, 5
+func (list *LinkedListBase[I, D]) SubList(start, end int) *LinkedList[D] {
+	sub := NewLinkedList[D]()
+
+	start, end = list.clampIndex(start), list.clampIndex(end)
+	if start >= end {
+		return sub
+	}
+
+	iterator := list.findNodeByIndex(start)
+	for count := end - start; count > 0; count-- {
+		sub.Push(iterator.Data)
+		iterator = iterator.right
+	}
+
+	return sub
+}
+
+// clampIndex converts a potentially negative index to an absolute position (see calcAbsoluteIndex),
+// clamping out-of-range indices to the bounds of the list.
+//
+// Parameters:
+//   - index: The index to convert (can be negative)
+//
+// Returns:
+//   - The absolute index in the range [0, size]
+func (list *LinkedListBase[I, D]) clampIndex(index int) int {
+	if idx, valid := list.calcAbsoluteIndex(index); valid {
+		return idx
+	}
+
+	if index < 0 {
+		return 0
+	}
+	return list.size
+}
+
+// EqualBy reports whether the list and another collection hold equal elements in the same order,
+// using equal to compare elements. The sizes are compared first, then both are walked in lockstep
+// until the first mismatch.
 //
 // Parameters:
-//   - collection: The collection to merge with
+//   - other: The collection to compare with; a nil collection equals no list
+//   - equal: A function reporting whether an element of the list equals the element of other at the same index
 //
 // Returns:
-//   - A new list containing elements from both collections
+//   - true if both have the same size and equal elements at every index
 //
-// Time complexity: O(n + k) where n is this list's size and k is the collection's size
+// Time complexity: O(n)
 //
 // Example:
 //
-//	list1 := linkedlist.NewLinkedList[int]()
-//	list1.PushAll(1, 2, 3)
-//	list2 := linkedlist.NewLinkedList[int]()
-//	list2.PushAll(4, 5, 6)
-//	merged := list1.Merge(list2)
-//	// merged contains: 1, 2, 3, 4, 5, 6
-//	// list1 and list2 are unchanged
-func (list *LinkedListBase[I, D]) Merge(collection abstract.Collection[int, D]) abstract.Collection[int, D] {
-	merged := NewLinkedList[D]()
+//	a := linkedlist.FromSlice([][]int{{1}, {2, 3}})
+//	b := linkedlist.FromSlice([][]int{{1}, {2, 3}})
+//	a.EqualBy(b, slices.Equal[[]int]) // true
+func (list *LinkedListBase[I, D]) EqualBy(other abstract.Collection[int, D], equal func(a, b D) bool) bool {
+	if other == nil || list.size != other.Size() {
+		return false
+	}
 
+	equals := true
 	iterator := list.head
 
-	for iterator != nil {
-		merged.Push(iterator.Data)
+	other.ForEach(func(index int, data D) bool {
+		// other may have grown since its size was read
+		if iterator == nil || !equal(iterator.Data, data) {
+			equals = false
+			return false
+		}
 		iterator = iterator.right
-	}
-
-	collection.ForEach(func(index int, data D) bool {
-		merged.Push(data)
 		return true
 	})
 
-	return merged
+	return equals && iterator == nil
 }
 
 // Delete removes the element at the specified index.
@@ -480,10 +1037,11 @@ func (list *LinkedListBase[I, D]) DeleteBy(predicate abstract.Predicate[D]) {
 	iterator := list.head
 
 	for iterator != nil {
+		next := iterator.right
 		if predicate(iterator.Data) {
 			list.Remove(iterator)
 		}
-		iterator = iterator.right
+		iterator = next
 	}
 }
 
@@ -497,6 +1055,7 @@ func (list *LinkedListBase[I, D]) DeleteAll() {
 	for iterator != nil {
 		next := iterator.right
 		iterator.left, iterator.right = nil, nil
+		list.releaseNode(iterator)
 		iterator = next
 	}
 
@@ -533,6 +1092,57 @@ func (list *LinkedListBase[I, D]) Some(predicate abstract.Predicate[D]) bool {
 	return false
 }
 
+// Contains checks if the list holds an element matching the predicate.
+// It is equivalent to Some.
+//
+// Parameters:
+//   - predicate: A function that returns true for matching elements
+//
+// Returns:
+//   - true if any element matches, false otherwise
+//
+// Time complexity: O(n) in worst case, but returns early on first match
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[string]()
+//	list.PushAll("a", "b", "c")
+//	hasB := list.Contains(func(s string) bool { return s == "b" })
+//	// hasB = true
+func (list *LinkedListBase[I, D]) Contains(predicate abstract.Predicate[D]) bool {
+	return list.Some(predicate)
+}
+
+// Count returns the number of elements matching the predicate.
+//
+// Parameters:
+//   - predicate: A function that returns true for matching elements
+//
+// Returns:
+//   - The number of matching elements
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 2, 3, 4)
+//	evens := list.Count(func(x int) bool { return x%2 == 0 })
+//	// evens = 2
+func (list *LinkedListBase[I, D]) Count(predicate abstract.Predicate[D]) int {
+	count := 0
+	iterator := list.head
+
+	for iterator != nil {
+		if predicate(iterator.Data) {
+			count++
+		}
+		iterator = iterator.right
+	}
+
+	return count
+}
+
 // Find returns the first element matching the predicate.
 //
 // Parameters:
@@ -563,6 +1173,37 @@ func (list *LinkedListBase[I, D]) Find(predicate abstract.Predicate[D]) (D, bool
 	return utils.Zero[D](), false
 }
 
+// FindLast returns the last element matching the predicate.
+// The list is traversed backwards from the tail, so it returns early on the last match.
+//
+// Parameters:
+//   - predicate: A function that returns true for the desired element
+//
+// Returns:
+//   - The last matching element and true if found
+//   - A zero value and false if no element matches
+//
+// Time complexity: O(n) in worst case, but returns early on last match
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(10, 20, 30)
+//	val, found := list.FindLast(func(x int) bool { return x > 15 })
+//	// val = 30, found = true
+func (list *LinkedListBase[I, D]) FindLast(predicate abstract.Predicate[D]) (D, bool) {
+	iterator := list.tail
+
+	for iterator != nil {
+		if predicate(iterator.Data) {
+			return iterator.Data, true
+		}
+		iterator = iterator.left
+	}
+
+	return utils.Zero[D](), false
+}
+
 // Filter creates a new list containing only elements matching the predicate.
 // The original list is not modified.
 //
@@ -594,6 +1235,39 @@ func (list *LinkedListBase[I, D]) Filter(predicate abstract.Predicate[D]) abstra
 	return filtered
 }
 
+// Partition splits the elements into two new lists in a single pass: those matching the predicate
+// and the others, both in their original order. The original list is not modified.
+//
+// Parameters:
+//   - predicate: A function that returns true for elements to put in the matched list
+//
+// Returns:
+//   - matched: A new list containing the elements matching the predicate
+//   - rest: A new list containing the other elements
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.FromSlice([]int{1, 2, 3, 4, 5})
+//	evens, odds := list.Partition(func(x int) bool { return x%2 == 0 })
+//	// evens contains: 2, 4; odds contains: 1, 3, 5
+func (list *LinkedListBase[I, D]) Partition(predicate abstract.Predicate[D]) (matched, rest *LinkedList[D]) {
+	matched, rest = NewLinkedList[D](), NewLinkedList[D]()
+
+	iterator := list.head
+	for iterator != nil {
+		if predicate(iterator.Data) {
+			matched.Push(iterator.Data)
+		} else {
+			rest.Push(iterator.Data)
+		}
+		iterator = iterator.right
+	}
+
+	return matched, rest
+}
+
 // ForEach iterates over all elements in the list, calling the receiver function for each.
 // If the receiver returns false, iteration stops early.
 //
@@ -623,6 +1297,121 @@ func (list *LinkedListBase[I, D]) ForEach(receiver abstract.IndexedReceiver[int,
 	}
 }
 
+// All returns an iterator over the indices and elements of the list, from head to tail.
+// Iteration stops as soon as the consumer breaks out of the loop, like ForEach stops
+// when its receiver returns false.
+//
+// Returns:
+//   - An iterator yielding index-element pairs
+//
+// Time complexity: O(n) for a full traversal
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[string]()
+//	list.PushAll("a", "b", "c")
+//	for i, val := range list.All() {
+//	    fmt.Printf("Index %d: %s\n", i, val)
+//	}
+func (list *LinkedListBase[I, D]) All() iter.Seq2[int, D] {
+	return func(yield func(int, D) bool) {
+		var index int
+		iterator := list.head
+
+		for iterator != nil {
+			if !yield(index, iterator.Data) {
+				return
+			}
+			iterator = iterator.right
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list, from head to tail.
+//
+// Returns:
+//   - An iterator yielding the elements
+//
+// Time complexity: O(n) for a full traversal
+//
+// Example:
+//
+//	for val := range list.Values() {
+//	    if val > 10 {
+//	        break
+//	    }
+//	}
+func (list *LinkedListBase[I, D]) Values() iter.Seq[D] {
+	return func(yield func(D) bool) {
+		iterator := list.head
+
+		for iterator != nil {
+			if !yield(iterator.Data) {
+				return
+			}
+			iterator = iterator.right
+		}
+	}
+}
+
+// Backward returns an iterator over the indices and elements of the list, from tail to head.
+// Indices are the regular (non-negative) positions of the elements, so they count down.
+//
+// Returns:
+//   - An iterator yielding index-element pairs in reverse order
+//
+// Time complexity: O(n) for a full traversal
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(10, 20, 30)
+//	for i, val := range list.Backward() {
+//	    fmt.Println(i, val) // 2 30, 1 20, 0 10
+//	}
+func (list *LinkedListBase[I, D]) Backward() iter.Seq2[int, D] {
+	return func(yield func(int, D) bool) {
+		index := list.size - 1
+		iterator := list.tail
+
+		for iterator != nil {
+			if !yield(index, iterator.Data) {
+				return
+			}
+			iterator = iterator.left
+			index--
+		}
+	}
+}
+
+// ToSlice copies the elements of the list into a new slice, in order.
+// The slice has a length and capacity of exactly Size(). An empty list yields an empty,
+// non-nil slice, so the result always encodes as [] rather than null in JSON.
+//
+// Returns:
+//   - A slice containing the elements of the list
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 2, 3)
+//	items := list.ToSlice()
+//	// items = []int{1, 2, 3}
+func (list *LinkedListBase[I, D]) ToSlice() []D {
+	items := make([]D, 0, list.size)
+	iterator := list.head
+
+	for iterator != nil {
+		items = append(items, iterator.Data)
+		iterator = iterator.right
+	}
+
+	return items
+}
+
 // First returns the first element in the list.
 //
 // Returns:
@@ -690,7 +1479,11 @@ func (list *LinkedListBase[I, D]) Pop(index int) D {
 	}
 
 	list.size--
-	return node.Data
+
+	data := node.Data
+	list.releaseNode(node)
+
+	return data
 }
 
 // Swap exchanges the positions of two elements at the specified indices.
@@ -834,21 +1627,21 @@ func (list *LinkedListBase[I, D]) PopLeft() D {
 	}
 
 	node := list.head
+	list.head = node.right
 
-	if list.head.right != nil {
-		list.head.right.left = nil
-	}
-
-	list.head = list.head.right
-
-	if list.head == list.tail {
+	if list.head != nil {
+		list.head.left = nil
+	} else {
 		list.tail = nil
 	}
 
 	node.right = nil
 	list.size--
 
-	return node.Data
+	data := node.Data
+	list.releaseNode(node)
+
+	return data
 }
 
 // PopRight removes and returns the last element from the list.
@@ -865,33 +1658,33 @@ func (list *LinkedListBase[I, D]) PopLeft() D {
 //	val := list.PopRight()
 //	// val = 30, list now contains: 10, 20
 func (list *LinkedListBase[I, D]) PopRight() D {
-	if list.size == 0 {
-		return utils.Zero[D]()
-	}
-
 	if list.tail == nil {
-		return list.PopLeft()
+		return utils.Zero[D]()
 	}
 
 	node := list.tail
+	list.tail = node.left
 
-	node.left.right = nil
-
-	if node.left != list.head {
-		list.tail = node.left
+	if list.tail != nil {
+		list.tail.right = nil
 	} else {
-		list.tail = nil
+		list.head = nil
 	}
 
 	node.left = nil
 	list.size--
 
-	return node.Data
+	data := node.Data
+	list.releaseNode(node)
+
+	return data
 }
 
 // Shrink reduces the list size to the specified capacity by removing elements from the end.
 // If capacity is 0, all elements are removed.
 // If capacity is greater than or equal to the current size, no elements are removed.
+// The bound of a bounded list is not changed: it can grow back to Cap() elements afterwards,
+// and since it never holds more than Cap() elements, shrinking to Cap() or more has no effect.
 //
 // Parameters:
 //   - capacity: The maximum number of elements to keep
@@ -1057,8 +1850,74 @@ func (list *LinkedListBase[I, D]) swap(node0, node1 *LinkedNode[D]) {
 
 }
 
-// Sort sorts the list in place using quicksort algorithm.
+// Reverse reverses the order of the elements in place.
+// No nodes are allocated; every node's links are swapped and head and tail are exchanged,
+// so node references held by callers remain valid.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 2, 3)
+//	list.Reverse()
+//	// List now contains: 3, 2, 1
+func (list *LinkedListBase[I, D]) Reverse() {
+	if list.size < 2 {
+		return
+	}
+
+	iterator := list.head
+
+	for iterator != nil {
+		next := iterator.right
+		iterator.left, iterator.right = iterator.right, iterator.left
+		iterator = next
+	}
+
+	list.head, list.tail = list.tail, list.head
+}
+
+// Rotate moves the elements n positions to the left in place, wrapping around:
+// the first n elements move to the end. A negative n rotates to the right,
+// and n is taken modulo the size, so rotating by the size leaves the list unchanged.
+// No data is copied; the list is closed into a ring and reopened at the new head,
+// so node references held by callers remain valid.
+//
+// Parameters:
+//   - n: The number of positions to rotate left by (negative to rotate right)
+//
+// Time complexity: O(n) where n is the size of the list
+//
+// Example:
+//
+//	list := linkedlist.NewLinkedList[int]()
+//	list.PushAll(1, 2, 3, 4)
+//	list.Rotate(1)
+//	// List now contains: 2, 3, 4, 1
+//	list.Rotate(-2)
+//	// List now contains: 4, 1, 2, 3
+func (list *LinkedListBase[I, D]) Rotate(n int) {
+	if list.size < 2 {
+		return
+	}
+
+	shift := ((n % list.size) + list.size) % list.size
+	if shift == 0 {
+		return
+	}
+
+	head := list.findNodeByIndex(shift)
+
+	list.tail.right, list.head.left = list.head, list.tail
+	list.head, list.tail = head, head.left
+	list.head.left, list.tail.right = nil, nil
+}
+
+// Sort sorts the list in place using a bottom-up merge sort.
 // The list is reordered according to the provided comparator function.
+// The sort is stable: elements the comparator considers equal keep their relative order.
+// Nodes are relinked rather than copied, so node references held by callers remain valid.
 //
 // Parameters:
 //   - comparator: A function that compares two elements.
@@ -1067,7 +1926,7 @@ func (list *LinkedListBase[I, D]) swap(node0, node1 *LinkedNode[D]) {
 //   - zero if elements are equal
 //   - positive value if first element should come after second
 //
-// Time complexity: O(n log n) average, O(n²) worst case
+// Time complexity: O(n log n)
 //
 // Example:
 //
@@ -1076,128 +1935,110 @@ func (list *LinkedListBase[I, D]) swap(node0, node1 *LinkedNode[D]) {
 //	list.Sort(func(a, b int) int { return a - b })
 //	// List now contains: 10, 20, 30
 func (list *LinkedListBase[I, D]) Sort(comparator abstract.Comparator[D]) {
-	if list.head == nil {
+	if list.size < 2 {
 		return
 	}
 
-	list.head = quickSort(list.head, getTail(list.head), comparator)
+	head := list.head
+
+	// Merge adjacent runs of width nodes, doubling the width on every pass
+	for width := 1; width < list.size; width *= 2 {
+		var merged, last *LinkedNode[D]
+
+		remaining := head
+		for remaining != nil {
+			left := remaining
+			right := splitRun(left, width)
+			remaining = splitRun(right, width)
+
+			first, end := mergeRuns(left, right, comparator)
+			if last == nil {
+				merged = first
+			} else {
+				last.right = first
+			}
+			last = end
+		}
+
+		head = merged
+	}
 
-	// Rebuild left pointers and update tail after sorting
-	list.head.left = nil
-	curr := list.head
+	// Rebuild left pointers and update head and tail after sorting
+	head.left = nil
+	curr := head
 	for curr.right != nil {
 		curr.right.left = curr
 		curr = curr.right
 	}
-	curr.left = nil
-	if curr != list.head {
-		list.tail = curr
-		// Find the node before tail
-		temp := list.head
-		for temp.right != list.tail {
-			temp = temp.right
-		}
-		list.tail.left = temp
-	} else {
-		list.tail = nil
-	}
+
+	list.head = head
+	list.tail = curr
 }
 
-// quickSort is an internal function implementing the quicksort algorithm for linked lists.
-// It partitions the list and recursively sorts the partitions.
+// splitRun is an internal helper function that detaches the first count nodes of a chain.
 //
 // Parameters:
-//   - head, tail: The range of nodes to sort
-//   - comparator: The comparison function
+//   - head: The first node of the chain
+//   - count: The number of nodes to keep in the run starting at head
 //
 // Returns:
-//   - The new head of the sorted range
-func quickSort[D any](head, tail *LinkedNode[D], comparator abstract.Comparator[D]) *LinkedNode[D] {
-	if head == nil || head == tail {
-		return head
-	}
-
-	newHead, newEnd := partition(head, tail, comparator)
-
-	// If pivot is not the only element
-	if newHead != newEnd {
-		// Find node before pivot
-		temp := newHead
-		for temp.right != newEnd {
-			temp = temp.right
-		}
-		temp.right = nil
-
-		// Recursively sort before pivot
-		newHead = quickSort(newHead, temp, comparator)
-
-		// Get tail of left part and connect to pivot
-		temp = getTail(newHead)
-		if temp != nil {
-			temp.right = newEnd
-		}
+//   - The first node after the run, or nil if the chain has no more nodes
+func splitRun[D any](head *LinkedNode[D], count int) *LinkedNode[D] {
+	for ; head != nil && count > 1; count-- {
+		head = head.right
 	}
 
-	// Recursively sort after pivot
-	if newEnd.right != nil {
-		rightTail := getTail(newEnd.right)
-		newEnd.right = quickSort(newEnd.right, rightTail, comparator)
+	if head == nil {
+		return nil
 	}
 
-	return newHead
+	rest := head.right
+	head.right = nil
+	return rest
 }
 
-// partition is an internal function that partitions the list around a pivot element.
-// Elements less than the pivot are placed before it, others after it.
+// mergeRuns is an internal helper function that merges two sorted chains linked by their right pointers.
+// When elements are equal, the element from the left chain is taken first, which keeps the sort stable.
 //
 // Parameters:
-//   - head, end: The range to partition (end is the pivot)
+//   - left, right: The sorted chains to merge (either may be nil)
 //   - comparator: The comparison function
 //
 // Returns:
-//   - The new head of the partitioned range and the pivot node
-func partition[D any](head, end *LinkedNode[D], comparator abstract.Comparator[D]) (*LinkedNode[D], *LinkedNode[D]) {
-	if head == nil || end == nil {
-		return head, end
-	}
-
-	pivot := end
-	prev, curr := (*LinkedNode[D])(nil), head
-	tail := pivot
-
-	for curr != nil && curr != pivot {
-		next := curr.right
-		if comparator(curr.Data, pivot.Data) < 0 {
-			// Keep in left partition
-			if prev == nil {
-				head = curr
-			} else {
-				prev.right = curr
-			}
-			prev = curr
-			curr.right = next
+//   - The first and the last node of the merged chain
+func mergeRuns[D any](left, right *LinkedNode[D], comparator abstract.Comparator[D]) (*LinkedNode[D], *LinkedNode[D]) {
+	var head, tail *LinkedNode[D]
+
+	appendNode := func(node *LinkedNode[D]) {
+		if tail == nil {
+			head = node
 		} else {
-			// Move to right partition
-			if prev != nil {
-				prev.right = next
-			} else {
-				head = next
-			}
-			curr.right = nil
-			tail.right = curr
-			tail = curr
+			tail.right = node
 		}
-		curr = next
+		tail = node
 	}
 
-	// Connect left partition to pivot
-	if prev == nil {
-		head = pivot
-	} else {
-		prev.right = pivot
+	for left != nil && right != nil {
+		if comparator(left.Data, right.Data) <= 0 {
+			appendNode(left)
+			left = left.right
+		} else {
+			appendNode(right)
+			right = right.right
+		}
+	}
+
+	rest := left
+	if rest == nil {
+		rest = right
+	}
+
+	if rest != nil {
+		appendNode(rest)
+		tail = getTail(rest)
 	}
 
-	return head, pivot
+	return head, tail
 }
 
 // getTail is an internal helper function that finds the last node in a chain.
@@ -884,3 +884,28 @@ func BenchmarkLinkedList_IsEmpty_Repeated(b *testing.B) {
 		_ = list.IsEmpty()
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Benchmarks: Pooled Nodes
+// ----------------------------------------------------------------------------
+
+func BenchmarkLinkedList_AlternatingPushPop_Default(b *testing.B) {
+	benchmarkAlternatingPushPop(b, NewLinkedList[int]())
+}
+
+func BenchmarkLinkedList_AlternatingPushPop_Pooled(b *testing.B) {
+	benchmarkAlternatingPushPop(b, NewLinkedListPooled[int]())
+}
+
+func benchmarkAlternatingPushPop(b *testing.B, list *LinkedList[int]) {
+	for i := 0; i < 100; i++ {
+		list.Push(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Push(i)
+		list.PopLeft()
+	}
+}
@@ -1,6 +1,9 @@
 package linkedlist
 
 import (
+	"slices"
+	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -284,6 +287,100 @@ func TestLinkedList_Edge_TwoElements_PopOperations(t *testing.T) {
 	})
 }
 
+func TestLinkedList_Edge_TwoElements_PopRightThenPush(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2)
+
+	if val := list.PopRight(); val != 2 {
+		t.Errorf("PopRight should return 2, got %d", val)
+	}
+
+	list.Push(3)
+
+	if list.Size() != 2 {
+		t.Errorf("Size should be 2, got %d", list.Size())
+	}
+	if list.First() != 1 || list.Last() != 3 {
+		t.Errorf("Expected First=1 Last=3, got First=%d Last=%d", list.First(), list.Last())
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", list.ToSlice())
+	}
+
+	if val := list.PopRight(); val != 3 {
+		t.Errorf("PopRight should return 3, got %d", val)
+	}
+	if val := list.PopRight(); val != 1 {
+		t.Errorf("PopRight should return 1, got %d", val)
+	}
+	if !list.IsEmpty() || list.PopRight() != 0 {
+		t.Error("List should be empty")
+	}
+}
+
+func TestLinkedList_Edge_TwoElements_RemoveThenPopRight(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(list *LinkedList[int])
+		remain int
+	}{
+		{"Delete(0)", func(list *LinkedList[int]) { list.Delete(0) }, 2},
+		{"Delete(-1)", func(list *LinkedList[int]) { list.Delete(-1) }, 1},
+		{"Pop(0)", func(list *LinkedList[int]) { list.Pop(0) }, 2},
+		{"Pop(1)", func(list *LinkedList[int]) { list.Pop(1) }, 1},
+		{"Shrink(1)", func(list *LinkedList[int]) { list.Shrink(1) }, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			list.PushAll(1, 2)
+			test.remove(list)
+
+			if list.First() != test.remain || list.Last() != test.remain {
+				t.Errorf("Expected only %d, got First=%d Last=%d", test.remain, list.First(), list.Last())
+			}
+
+			if val := list.PopRight(); val != test.remain || !list.IsEmpty() {
+				t.Errorf("PopRight should return %d and empty the list, got %d", test.remain, val)
+			}
+
+			list.Push(test.remain)
+			list.PushFront(0)
+			list.Push(9)
+			if !slices.Equal(list.ToSlice(), []int{0, test.remain, 9}) {
+				t.Errorf("Expected [0 %d 9], got %v", test.remain, list.ToSlice())
+			}
+
+			for _, expected := range []int{9, test.remain, 0} {
+				if val := list.PopRight(); val != expected {
+					t.Errorf("PopRight should return %d, got %d", expected, val)
+				}
+			}
+			if list.Size() != 0 || !list.IsEmpty() {
+				t.Errorf("List should be empty, size %d", list.Size())
+			}
+		})
+	}
+}
+
+func TestLinkedList_Edge_SingleElement_Backward(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2)
+	list.PopLeft()
+	list.PushFront(0)
+	list.PopRight()
+
+	var collected []int
+	for _, value := range list.Backward() {
+		collected = append(collected, value)
+	}
+
+	if !slices.Equal(collected, []int{0}) {
+		t.Errorf("Expected [0], got %v", collected)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Edge Cases: Negative Indices
 // ----------------------------------------------------------------------------
@@ -538,6 +635,38 @@ func TestLinkedList_Edge_Delete_Comprehensive(t *testing.T) {
 		verifySequence(t, list, expected)
 	})
 
+	// Delete consecutive matching elements
+	t.Run("DeleteByConsecutive", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.PushAll(1, 2, 2, 2, 3)
+		list.DeleteBy(func(v int) bool { return v == 2 })
+		expected := []int{1, 3}
+		verifySequence(t, list, expected)
+	})
+
+	// Delete consecutive matching elements at both ends
+	t.Run("DeleteByConsecutiveAtEnds", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.PushAll(2, 2, 1, 3, 2, 2)
+		list.DeleteBy(func(v int) bool { return v == 2 })
+		expected := []int{1, 3}
+		verifySequence(t, list, expected)
+		if list.Last() != 3 {
+			t.Errorf("Last should be 3, got %d", list.Last())
+		}
+	})
+
+	// Delete every element by predicate
+	t.Run("DeleteByAllMatching", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.PushAll(2, 2, 2, 2)
+		list.DeleteBy(func(v int) bool { return v == 2 })
+		verifySequence(t, list, []int{})
+		if !list.IsEmpty() {
+			t.Error("List should be empty after deleting every element")
+		}
+	})
+
 	// Delete all elements
 	t.Run("DeleteAll", func(t *testing.T) {
 		list := NewLinkedList[int]()
@@ -1584,31 +1713,1114 @@ func TestLinkedList_QuickSort_FloatComparison(t *testing.T) {
 }
 
 // ----------------------------------------------------------------------------
-// Helper Functions
+// Reverse Operations
 // ----------------------------------------------------------------------------
 
-func verifySequence(t *testing.T, list *LinkedList[int], expected []int) {
-	if list.Size() != len(expected) {
-		t.Errorf("Size mismatch: expected %d, got %d", len(expected), list.Size())
-		return
+func TestLinkedList_Reverse_EmptyList(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.Reverse()
+
+	verifySequence(t, list, []int{})
+}
+
+func TestLinkedList_Reverse_SingleElement(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.Push(42)
+	list.Reverse()
+
+	verifySequence(t, list, []int{42})
+	if list.First() != 42 || list.Last() != 42 {
+		t.Errorf("First/Last should be 42, got %d/%d", list.First(), list.Last())
 	}
+}
 
-	for i, exp := range expected {
-		if val := list.At(i); val != exp {
-			t.Errorf("At index %d: expected %d, got %d", i, exp, val)
-		}
+func TestLinkedList_Reverse_TwoElements(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2)
+	list.Reverse()
+
+	verifyTwoElements(t, list, 2, 1)
+}
+
+func TestLinkedList_Reverse_LargeList(t *testing.T) {
+	list := NewLinkedList[int]()
+	expected := make([]int, 1000)
+	for i := 0; i < 1000; i++ {
+		list.Push(i)
+		expected[999-i] = i
 	}
 
-	// Verify with ForEach
-	index := 0
-	list.ForEach(func(idx int, val int) bool {
-		if idx != index {
-			t.Errorf("ForEach index mismatch: expected %d, got %d", index, idx)
+	list.Reverse()
+
+	verifySequence(t, list, expected)
+	if list.First() != 999 || list.Last() != 0 {
+		t.Errorf("First/Last should be 999/0, got %d/%d", list.First(), list.Last())
+	}
+	if list.At(-2) != 1 {
+		t.Errorf("At(-2) should be 1, got %d", list.At(-2))
+	}
+}
+
+func TestLinkedList_Reverse_Twice(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2, 3, 4, 5)
+
+	list.Reverse()
+	list.Reverse()
+
+	verifySequence(t, list, []int{1, 2, 3, 4, 5})
+}
+
+func TestLinkedList_Reverse_ThenModify(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2, 3)
+	list.Reverse()
+
+	list.Push(0)
+	list.PushFront(4)
+	verifySequence(t, list, []int{4, 3, 2, 1, 0})
+
+	if val := list.PopRight(); val != 0 {
+		t.Errorf("PopRight should return 0, got %d", val)
+	}
+	if val := list.PopLeft(); val != 4 {
+		t.Errorf("PopLeft should return 4, got %d", val)
+	}
+	verifySequence(t, list, []int{3, 2, 1})
+}
+
+// ----------------------------------------------------------------------------
+// Rotate
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Rotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"Zero", 0, []int{1, 2, 3, 4, 5}},
+		{"Left", 2, []int{3, 4, 5, 1, 2}},
+		{"Right", -1, []int{5, 1, 2, 3, 4}},
+		{"LeftToLast", 4, []int{5, 1, 2, 3, 4}},
+		{"FullCycle", 5, []int{1, 2, 3, 4, 5}},
+		{"NegativeFullCycle", -10, []int{1, 2, 3, 4, 5}},
+		{"LargerThanSize", 7, []int{3, 4, 5, 1, 2}},
+		{"NegativeLargerThanSize", -7, []int{4, 5, 1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := FromSlice([]int{1, 2, 3, 4, 5})
+			list.Rotate(tt.n)
+
+			verifySequence(t, list, tt.expected)
+			if list.First() != tt.expected[0] || list.Last() != tt.expected[4] {
+				t.Errorf("First/Last should be %d/%d, got %d/%d", tt.expected[0], tt.expected[4], list.First(), list.Last())
+			}
+
+			// the links must stay consistent in both directions
+			var backward []int
+			for _, value := range list.Backward() {
+				backward = append(backward, value)
+			}
+			slices.Reverse(backward)
+			if !slices.Equal(backward, tt.expected) {
+				t.Errorf("Backward traversal should give %v, got %v", tt.expected, backward)
+			}
+		})
+	}
+}
+
+func TestLinkedList_Rotate_EmptyAndSingle(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.Rotate(3)
+	verifySequence(t, list, []int{})
+
+	list.Push(42)
+	list.Rotate(-3)
+	verifySequence(t, list, []int{42})
+	if list.First() != 42 || list.Last() != 42 {
+		t.Errorf("First/Last should be 42, got %d/%d", list.First(), list.Last())
+	}
+}
+
+func TestLinkedList_Rotate_KeepsNodes(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2)
+	node := list.Insert(3)
+
+	list.Rotate(1)
+	list.Push(4)
+	list.Remove(node)
+
+	verifySequence(t, list, []int{2, 1, 4})
+}
+
+func TestLinkedList_Rotate_RoundRobin(t *testing.T) {
+	list := FromSlice([]string{"a", "b", "c"})
+
+	var order []string
+	for i := 0; i < 5; i++ {
+		order = append(order, list.First())
+		list.Rotate(1)
+	}
+
+	if expected := []string{"a", "b", "c", "a", "b"}; !slices.Equal(order, expected) {
+		t.Errorf("Expected round-robin order %v, got %v", expected, order)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Iterators
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Iterator_All(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(10, 20, 30)
+
+	var indices, values []int
+	for i, val := range list.All() {
+		indices = append(indices, i)
+		values = append(values, val)
+	}
+
+	if !slices.Equal(indices, []int{0, 1, 2}) || !slices.Equal(values, []int{10, 20, 30}) {
+		t.Errorf("All yielded %v/%v, want [0 1 2]/[10 20 30]", indices, values)
+	}
+}
+
+func TestLinkedList_Iterator_Values(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2, 3, 4)
+
+	values := slices.Collect(list.Values())
+	if !slices.Equal(values, []int{1, 2, 3, 4}) {
+		t.Errorf("Values yielded %v, want [1 2 3 4]", values)
+	}
+}
+
+func TestLinkedList_Iterator_Backward(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(10, 20, 30)
+
+	var indices, values []int
+	for i, val := range list.Backward() {
+		indices = append(indices, i)
+		values = append(values, val)
+	}
+
+	if !slices.Equal(indices, []int{2, 1, 0}) || !slices.Equal(values, []int{30, 20, 10}) {
+		t.Errorf("Backward yielded %v/%v, want [2 1 0]/[30 20 10]", indices, values)
+	}
+}
+
+func TestLinkedList_Iterator_EarlyBreak(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2, 3, 4, 5)
+
+	t.Run("All", func(t *testing.T) {
+		var count int
+		for _, val := range list.All() {
+			count++
+			if val == 2 {
+				break
+			}
 		}
-		if val != expected[index] {
-			t.Errorf("ForEach value mismatch at %d: expected %d, got %d", index, expected[index], val)
+		if count != 2 {
+			t.Errorf("All should stop after 2 elements, visited %d", count)
+		}
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		var count int
+		for range list.Values() {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("Values should stop after 1 element, visited %d", count)
 		}
-		index++
-		return true
 	})
+
+	t.Run("Backward", func(t *testing.T) {
+		var visited []int
+		for _, val := range list.Backward() {
+			visited = append(visited, val)
+			if val == 4 {
+				break
+			}
+		}
+		if !slices.Equal(visited, []int{5, 4}) {
+			t.Errorf("Backward should stop after [5 4], visited %v", visited)
+		}
+	})
+}
+
+func TestLinkedList_Iterator_EmptyAndSingle(t *testing.T) {
+	empty := NewLinkedList[int]()
+	for range empty.All() {
+		t.Error("All should not yield on an empty list")
+	}
+	for range empty.Backward() {
+		t.Error("Backward should not yield on an empty list")
+	}
+
+	single := NewLinkedList[int]()
+	single.Push(42)
+
+	values := slices.Collect(single.Values())
+	if !slices.Equal(values, []int{42}) {
+		t.Errorf("Values yielded %v, want [42]", values)
+	}
+
+	for i, val := range single.Backward() {
+		if i != 0 || val != 42 {
+			t.Errorf("Backward yielded %d/%d, want 0/42", i, val)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Slice Conversions
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_ToSlice_EmptyList(t *testing.T) {
+	list := NewLinkedList[int]()
+	items := list.ToSlice()
+
+	if items == nil {
+		t.Error("ToSlice on empty list should return a non-nil slice")
+	}
+	if len(items) != 0 || cap(items) != 0 {
+		t.Errorf("ToSlice on empty list should have len/cap 0, got %d/%d", len(items), cap(items))
+	}
+}
+
+func TestLinkedList_ToSlice_ExactCapacity(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PushAll(1, 2, 3, 4, 5)
+	list.PopLeft()
+
+	items := list.ToSlice()
+	if !slices.Equal(items, []int{2, 3, 4, 5}) {
+		t.Errorf("ToSlice returned %v, want [2 3 4 5]", items)
+	}
+	if cap(items) != list.Size() {
+		t.Errorf("ToSlice capacity should be %d, got %d", list.Size(), cap(items))
+	}
+}
+
+func TestLinkedList_FromSlice(t *testing.T) {
+	items := []int{1, 2, 3}
+	list := FromSlice(items)
+
+	verifySequence(t, list, []int{1, 2, 3})
+
+	items[0] = 100
+	if list.First() != 1 {
+		t.Errorf("Modifying the source slice should not affect the list, got %d", list.First())
+	}
+
+	empty := FromSlice[int](nil)
+	if !empty.IsEmpty() {
+		t.Errorf("FromSlice(nil) should be empty, got size %d", empty.Size())
+	}
+}
+
+func TestLinkedList_SliceRoundTrip(t *testing.T) {
+	for _, items := range [][]int{{}, {1}, {1, 2}, {5, 4, 3, 2, 1}} {
+		list := FromSlice(items)
+		if result := FromSlice(list.ToSlice()).ToSlice(); !slices.Equal(result, items) {
+			t.Errorf("Round trip of %v returned %v", items, result)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Map and Reduce
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Map_IntToString(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	mapped := Map(list, strconv.Itoa)
+
+	if !slices.Equal(mapped.ToSlice(), []string{"1", "2", "3"}) {
+		t.Errorf("Map returned %v, want [1 2 3]", mapped.ToSlice())
+	}
+	verifySequence(t, list, []int{1, 2, 3})
+}
+
+func TestLinkedList_Map_EmptyList(t *testing.T) {
+	mapped := Map(NewLinkedList[int](), func(x int) int { return x * 2 })
+
+	if !mapped.IsEmpty() {
+		t.Errorf("Map of empty list should be empty, got size %d", mapped.Size())
+	}
+}
+
+func TestLinkedList_Reduce_Sum(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4, 5})
+
+	sum := Reduce(list, 0, func(acc, x int) int { return acc + x })
+	if sum != 15 {
+		t.Errorf("Reduce sum should be 15, got %d", sum)
+	}
+
+	if empty := Reduce(NewLinkedList[int](), 42, func(acc, x int) int { return acc + x }); empty != 42 {
+		t.Errorf("Reduce of empty list should return init 42, got %d", empty)
+	}
+}
+
+func TestLinkedList_Reduce_ChangesType(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	joined := Reduce(list, "", func(acc string, x int) string { return acc + strconv.Itoa(x) })
+	if joined != "123" {
+		t.Errorf("Reduce should preserve order and return 123, got %s", joined)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Clone Operations
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Clone_Independent(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4})
+	clone := list.Clone()
+
+	verifySequence(t, clone, []int{1, 2, 3, 4})
+
+	if val := clone.PopLeft(); val != 1 {
+		t.Errorf("PopLeft on clone should return 1, got %d", val)
+	}
+	clone.PopRight()
+	clone.Push(10)
+	clone.Reverse()
+
+	verifySequence(t, list, []int{1, 2, 3, 4})
+	verifySequence(t, clone, []int{10, 3, 2})
+}
+
+func TestLinkedList_Clone_DistinctNodes(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+	clone := list.Clone()
+
+	for original, copied := list.head, clone.head; original != nil; original, copied = original.right, copied.right {
+		if original == copied {
+			t.Fatal("Clone should not share nodes with the original list")
+		}
+	}
+}
+
+func TestLinkedList_Clone_EmptyAndSingle(t *testing.T) {
+	if clone := NewLinkedList[int]().Clone(); !clone.IsEmpty() {
+		t.Errorf("Clone of empty list should be empty, got size %d", clone.Size())
+	}
+
+	single := FromSlice([]int{42})
+	clone := single.Clone()
+	clone.PopLeft()
+
+	verifySequence(t, single, []int{42})
+}
+
+func TestLinkedList_Clone_SharesPointerData(t *testing.T) {
+	type item struct{ value int }
+
+	list := NewLinkedList[*item]()
+	list.Push(&item{value: 1})
+	clone := list.Clone()
+
+	clone.First().value = 2
+	if list.First().value != 2 {
+		t.Errorf("Clone should share pointed-to data, got %d", list.First().value)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// SubList
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_SubList(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4, 5})
+
+	tests := []struct {
+		name       string
+		start, end int
+		expected   []int
+	}{
+		{"Middle", 1, 3, []int{2, 3}},
+		{"Prefix", 0, 2, []int{1, 2}},
+		{"Single", 4, 5, []int{5}},
+		{"Full", 0, 5, []int{1, 2, 3, 4, 5}},
+		{"NegativeStart", -2, 5, []int{4, 5}},
+		{"NegativeBoth", -4, -1, []int{2, 3, 4}},
+		{"ClampedEnd", 3, 99, []int{4, 5}},
+		{"ClampedStart", -99, 2, []int{1, 2}},
+		{"ClampedBoth", -99, 99, []int{1, 2, 3, 4, 5}},
+		{"EmptyRange", 2, 2, []int{}},
+		{"ReversedRange", 3, 1, []int{}},
+		{"PastEnd", 5, 10, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifySequence(t, list.SubList(tt.start, tt.end), tt.expected)
+		})
+	}
+
+	verifySequence(t, list, []int{1, 2, 3, 4, 5})
+}
+
+func TestLinkedList_SubList_Independent(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4})
+	sub := list.SubList(1, 3)
+
+	sub.PopLeft()
+	sub.Push(10)
+
+	verifySequence(t, list, []int{1, 2, 3, 4})
+	verifySequence(t, sub, []int{3, 10})
+
+	if empty := NewLinkedList[int]().SubList(0, 10); !empty.IsEmpty() {
+		t.Errorf("SubList of an empty list should be empty, got size %d", empty.Size())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Partition
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Partition(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []int
+		matched []int
+		rest    []int
+	}{
+		{"Mixed", []int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}, []int{1, 3, 5}},
+		{"AllMatch", []int{2, 4}, []int{2, 4}, []int{}},
+		{"NoneMatch", []int{1, 3}, []int{}, []int{1, 3}},
+		{"Empty", []int{}, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := FromSlice(tt.items)
+			matched, rest := list.Partition(func(x int) bool { return x%2 == 0 })
+
+			verifySequence(t, matched, tt.matched)
+			verifySequence(t, rest, tt.rest)
+			verifySequence(t, list, tt.items)
+
+			if matched.Size()+rest.Size() != list.Size() {
+				t.Errorf("Partition sizes %d + %d should sum to %d", matched.Size(), rest.Size(), list.Size())
+			}
+		})
+	}
+}
+
+func TestLinkedList_Partition_Independent(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4})
+	calls := 0
+	matched, rest := list.Partition(func(x int) bool {
+		calls++
+		return x > 2
+	})
+
+	if calls != 4 {
+		t.Errorf("Partition should call the predicate once per element, got %d calls", calls)
+	}
+
+	matched.PopLeft()
+	rest.Push(10)
+
+	verifySequence(t, list, []int{1, 2, 3, 4})
+	verifySequence(t, matched, []int{4})
+	verifySequence(t, rest, []int{1, 2, 10})
+}
+
+// ----------------------------------------------------------------------------
+// Stable Sort
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Sort_Stable(t *testing.T) {
+	type entry struct {
+		key     int
+		payload string
+	}
+
+	list := NewLinkedList[entry]()
+	list.PushAll(
+		entry{2, "a"}, entry{1, "b"}, entry{2, "c"}, entry{0, "d"},
+		entry{1, "e"}, entry{2, "f"}, entry{0, "g"}, entry{1, "h"}, entry{2, "i"},
+	)
+
+	list.Sort(func(a, b entry) int {
+		return a.key - b.key
+	})
+
+	expected := []string{"d", "g", "b", "e", "h", "a", "c", "f", "i"}
+	for index, payload := range expected {
+		if got := list.At(index).payload; got != payload {
+			t.Errorf("At index %d: expected payload %s, got %s", index, payload, got)
+		}
+	}
+}
+
+func TestLinkedList_Sort_Links(t *testing.T) {
+	for size := 2; size <= 17; size++ {
+		list := NewLinkedList[int]()
+		for value := size; value > 0; value-- {
+			list.Push(value)
+		}
+
+		list.Sort(func(a, b int) int {
+			return a - b
+		})
+
+		expected := make([]int, size)
+		for index := range expected {
+			expected[index] = index + 1
+		}
+		verifySequence(t, list, expected)
+
+		backward := make([]int, 0, size)
+		for _, value := range list.Backward() {
+			backward = append(backward, value)
+		}
+		slices.Reverse(backward)
+		if !slices.Equal(backward, expected) {
+			t.Errorf("size %d: backward traversal %v, expected %v", size, backward, expected)
+		}
+
+		if list.First() != 1 || list.Last() != size {
+			t.Errorf("size %d: First/Last = %d/%d, expected 1/%d", size, list.First(), list.Last(), size)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Contains and Count
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Contains(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	if !list.Contains(func(x int) bool { return x == 3 }) {
+		t.Error("Contains should find 3")
+	}
+	if list.Contains(func(x int) bool { return x > 3 }) {
+		t.Error("Contains should not find elements greater than 3")
+	}
+	if NewLinkedList[int]().Contains(func(x int) bool { return true }) {
+		t.Error("Contains on empty list should be false")
+	}
+}
+
+func TestLinkedList_Count(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3, 4, 5, 6})
+
+	tests := []struct {
+		name      string
+		predicate func(int) bool
+		expected  int
+	}{
+		{"Even", func(x int) bool { return x%2 == 0 }, 3},
+		{"All", func(x int) bool { return true }, 6},
+		{"None", func(x int) bool { return x > 10 }, 0},
+		{"Ends", func(x int) bool { return x == 1 || x == 6 }, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if count := list.Count(tt.predicate); count != tt.expected {
+				t.Errorf("Count should be %d, got %d", tt.expected, count)
+			}
+		})
+	}
+
+	if count := NewLinkedList[int]().Count(func(x int) bool { return true }); count != 0 {
+		t.Errorf("Count on empty list should be 0, got %d", count)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// FindLast and LastIndexOf
+// ----------------------------------------------------------------------------
+
+type indexedValue struct {
+	id, value int
+}
+
+func TestLinkedList_FindLast(t *testing.T) {
+	list := FromSlice([]indexedValue{{0, 7}, {1, 3}, {2, 7}, {3, 5}, {4, 7}, {5, 1}})
+
+	found, exists := list.FindLast(func(x indexedValue) bool { return x.value == 7 })
+	if !exists || found.id != 4 {
+		t.Errorf("FindLast should return the last match with id 4, got %+v, %v", found, exists)
+	}
+
+	found, exists = list.FindLast(func(x indexedValue) bool { return x.value == 1 })
+	if !exists || found.id != 5 {
+		t.Errorf("FindLast should return the tail with id 5, got %+v, %v", found, exists)
+	}
+
+	if found, exists := list.FindLast(func(x indexedValue) bool { return x.value > 7 }); exists {
+		t.Errorf("FindLast should not find anything, got %+v", found)
+	}
+	if _, exists := NewLinkedList[int]().FindLast(func(x int) bool { return true }); exists {
+		t.Error("FindLast on empty list should not find anything")
+	}
+}
+
+func TestLinkedList_LastIndexOf(t *testing.T) {
+	list := FromSlice([]int{6, 2, 4, 3, 4, 1})
+
+	tests := []struct {
+		name      string
+		predicate func(int) bool
+		index     int
+		found     bool
+	}{
+		{"MultipleMatches", func(x int) bool { return x == 4 }, 4, true},
+		{"Even", func(x int) bool { return x%2 == 0 }, 4, true},
+		{"Head", func(x int) bool { return x == 6 }, 0, true},
+		{"Tail", func(x int) bool { return x < 2 }, 5, true},
+		{"None", func(x int) bool { return x > 10 }, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := list.LastIndexOf(tt.predicate)
+			if index != tt.index || found != tt.found {
+				t.Errorf("LastIndexOf should be %d, %v, got %d, %v", tt.index, tt.found, index, found)
+			}
+		})
+	}
+
+	if index, found := FromSlice([]int{9}).LastIndexOf(func(x int) bool { return x == 9 }); !found || index != 0 {
+		t.Errorf("LastIndexOf on single element list should be 0, true, got %d, %v", index, found)
+	}
+	if _, found := NewLinkedList[int]().LastIndexOf(func(x int) bool { return true }); found {
+		t.Error("LastIndexOf on empty list should not find anything")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// InsertAt
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_InsertAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []int
+		index    int
+		expected []int
+	}{
+		{"Front", []int{1, 2, 3}, 0, []int{0, 1, 2, 3}},
+		{"Middle", []int{1, 2, 3}, 1, []int{1, 0, 2, 3}},
+		{"BeforeLast", []int{1, 2, 3}, 2, []int{1, 2, 0, 3}},
+		{"End", []int{1, 2, 3}, 3, []int{1, 2, 3, 0}},
+		{"BeyondEnd", []int{1, 2, 3}, 10, []int{1, 2, 3, 0}},
+		{"NegativeLast", []int{1, 2, 3}, -1, []int{1, 2, 0, 3}},
+		{"NegativeFirst", []int{1, 2, 3}, -3, []int{0, 1, 2, 3}},
+		{"BeyondFront", []int{1, 2, 3}, -10, []int{0, 1, 2, 3}},
+		{"EmptyList", []int{}, 0, []int{0}},
+		{"EmptyListOutOfRange", []int{}, -5, []int{0}},
+		{"SingleElementEnd", []int{1}, 1, []int{1, 0}},
+		{"SingleElementFront", []int{1}, -1, []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := FromSlice(tt.initial)
+
+			node := list.InsertAt(tt.index, 0)
+			if node == nil || node.Data != 0 {
+				t.Fatalf("InsertAt should return the created node, got %v", node)
+			}
+
+			verifySequence(t, list, tt.expected)
+
+			if list.First() != tt.expected[0] || list.Last() != tt.expected[len(tt.expected)-1] {
+				t.Errorf("First/Last = %d/%d, expected %d/%d", list.First(), list.Last(), tt.expected[0], tt.expected[len(tt.expected)-1])
+			}
+		})
+	}
+}
+
+func TestLinkedList_InsertAt_Links(t *testing.T) {
+	list := FromSlice([]int{1, 5})
+	list.InsertAt(1, 3)
+	list.InsertAt(1, 2)
+	list.InsertAt(-1, 4)
+
+	expected := []int{1, 2, 3, 4, 5}
+	verifySequence(t, list, expected)
+
+	backward := make([]int, 0, list.Size())
+	for _, value := range list.Backward() {
+		backward = append(backward, value)
+	}
+	slices.Reverse(backward)
+
+	if !slices.Equal(backward, expected) {
+		t.Errorf("backward traversal %v, expected %v", backward, expected)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Equality
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Equal(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	if !Equal(list, FromSlice([]int{1, 2, 3})) {
+		t.Error("Lists with the same elements should be equal")
+	}
+	if !Equal(list, list) {
+		t.Error("A list should be equal to itself")
+	}
+	if !Equal(NewLinkedList[int](), NewLinkedList[int]()) {
+		t.Error("Empty lists should be equal")
+	}
+	if !Equal(list, NewConcurrentLinkedList[int]().Merge(list)) {
+		t.Error("Equality should hold across collection types")
+	}
+}
+
+func TestLinkedList_Equal_DifferentLength(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	if Equal(list, FromSlice([]int{1, 2})) || Equal(list, FromSlice([]int{1, 2, 3, 4})) {
+		t.Error("Lists of different lengths should not be equal")
+	}
+	if Equal(list, NewLinkedList[int]()) {
+		t.Error("A list should not equal an empty list")
+	}
+	if Equal(list, nil) {
+		t.Error("A list should not equal a nil collection")
+	}
+}
+
+func TestLinkedList_Equal_DifferentElements(t *testing.T) {
+	list := FromSlice([]int{1, 2, 3})
+
+	if Equal(list, FromSlice([]int{1, 2, 4})) {
+		t.Error("Lists with different elements should not be equal")
+	}
+	if Equal(list, FromSlice([]int{3, 2, 1})) {
+		t.Error("Lists with the same elements in a different order should not be equal")
+	}
+}
+
+func TestLinkedList_EqualBy(t *testing.T) {
+	list := FromSlice([][]int{{1}, {2, 3}})
+
+	if !list.EqualBy(FromSlice([][]int{{1}, {2, 3}}), slices.Equal[[]int]) {
+		t.Error("Lists with equal slices should be equal")
+	}
+	if list.EqualBy(FromSlice([][]int{{1}}), slices.Equal[[]int]) {
+		t.Error("Lists of different lengths should not be equal")
+	}
+	if list.EqualBy(FromSlice([][]int{{1}, {3, 2}}), slices.Equal[[]int]) {
+		t.Error("Lists with different slices should not be equal")
+	}
+
+	calls := 0
+	list.EqualBy(FromSlice([][]int{{0}, {2, 3}}), func(a, b []int) bool {
+		calls++
+		return slices.Equal(a, b)
+	})
+	if calls != 1 {
+		t.Errorf("Comparison should stop at the first mismatch, got %d calls", calls)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Duplicates
+// ----------------------------------------------------------------------------
+
+func TestRemoveDuplicates(t *testing.T) {
+	list := FromSlice([]int{1, 2, 1, 3, 2, 2, 4, 3, 1})
+
+	if removed := RemoveDuplicates(list); removed != 5 {
+		t.Errorf("Expected 5 removed elements, got %d", removed)
+	}
+	verifySequence(t, list, []int{1, 2, 3, 4})
+	if list.Last() != 4 {
+		t.Errorf("Expected the tail to be updated, got %d", list.Last())
+	}
+
+	list.Push(5)
+	verifySequence(t, list, []int{1, 2, 3, 4, 5})
+}
+
+func TestRemoveDuplicates_Ends(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Empty", []int{}, []int{}},
+		{"Single", []int{7}, []int{7}},
+		{"AllEqual", []int{7, 7, 7}, []int{7}},
+		{"RepeatedHead", []int{1, 1, 2, 3}, []int{1, 2, 3}},
+		{"RepeatedTail", []int{1, 2, 3, 3, 3}, []int{1, 2, 3}},
+		{"TailRepeatsHead", []int{1, 2, 3, 1}, []int{1, 2, 3}},
+		{"Sorted", []int{1, 1, 2, 2, 3, 4, 4}, []int{1, 2, 3, 4}},
+		{"Unique", []int{3, 1, 2}, []int{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := FromSlice(tt.input)
+			removed := RemoveDuplicates(list)
+
+			if removed != len(tt.input)-len(tt.expected) {
+				t.Errorf("Expected %d removed elements, got %d", len(tt.input)-len(tt.expected), removed)
+			}
+			verifySequence(t, list, tt.expected)
+		})
+	}
+}
+
+func TestRemoveDuplicatesBy(t *testing.T) {
+	type user struct {
+		id   int
+		tags []string
+	}
+
+	list := FromSlice([]user{{1, []string{"a"}}, {2, nil}, {1, []string{"b"}}, {3, nil}, {2, []string{"c"}}})
+
+	if removed := RemoveDuplicatesBy(list, func(u user) int { return u.id }); removed != 2 {
+		t.Errorf("Expected 2 removed elements, got %d", removed)
+	}
+
+	ids := Map(list, func(u user) int { return u.id })
+	verifySequence(t, ids, []int{1, 2, 3})
+	if tags := list.First().tags; len(tags) != 1 || tags[0] != "a" {
+		t.Errorf("Expected the first occurrence to be kept, got %v", tags)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Bounded List
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Bounded_PushEvictsFront(t *testing.T) {
+	list := NewBoundedLinkedList[int](3)
+	list.PushAll(1, 2, 3)
+
+	if evicted, ok := list.PushEvicting(4); !ok || evicted != 1 {
+		t.Errorf("Expected 1 to be evicted, got %d, %v", evicted, ok)
+	}
+	verifySequence(t, list, []int{2, 3, 4})
+
+	list.Push(5)
+	verifySequence(t, list, []int{3, 4, 5})
+}
+
+func TestLinkedList_Bounded_PushFrontEvictsBack(t *testing.T) {
+	list := NewBoundedLinkedList[int](3)
+	list.PushAll(1, 2, 3)
+
+	if evicted, ok := list.PushFrontEvicting(0); !ok || evicted != 3 {
+		t.Errorf("Expected 3 to be evicted, got %d, %v", evicted, ok)
+	}
+	verifySequence(t, list, []int{0, 1, 2})
+
+	list.PushFront(-1)
+	verifySequence(t, list, []int{-1, 0, 1})
+}
+
+func TestLinkedList_Bounded_NoEvictionBelowCapacity(t *testing.T) {
+	list := NewBoundedLinkedList[int](3)
+
+	for i := 1; i <= 3; i++ {
+		if evicted, ok := list.PushEvicting(i); ok {
+			t.Errorf("Expected no eviction below capacity, got %d", evicted)
+		}
+	}
+
+	if list.Cap() != 3 || list.Size() != 3 {
+		t.Errorf("Expected cap 3 and size 3, got %d and %d", list.Cap(), list.Size())
+	}
+	if NewLinkedList[int]().Cap() != 0 {
+		t.Error("Expected an unbounded list to report cap 0")
+	}
+	if NewBoundedLinkedList[int](0).Cap() != 1 {
+		t.Error("Expected the capacity to be raised to 1")
+	}
+}
+
+func TestLinkedList_Bounded_OtherInsertions(t *testing.T) {
+	list := NewBoundedLinkedList[int](3)
+	list.PushAll(1, 2, 3, 4, 5)
+	verifySequence(t, list, []int{3, 4, 5})
+
+	list.InsertAt(1, 10)
+	verifySequence(t, list, []int{10, 4, 5})
+
+	list.InsertFront(0)
+	verifySequence(t, list, []int{0, 10, 4})
+
+	list.Join(FromSlice([]int{7, 8}))
+	verifySequence(t, list, []int{4, 7, 8})
+
+	clone := list.Clone()
+	clone.Push(9)
+	if clone.Cap() != 3 || clone.Size() != 3 {
+		t.Errorf("Expected the clone to keep the bound, got cap %d and size %d", clone.Cap(), clone.Size())
+	}
+}
+
+func TestLinkedList_Bounded_Shrink(t *testing.T) {
+	list := NewBoundedLinkedList[int](4)
+	list.PushAll(1, 2, 3, 4)
+
+	list.Shrink(10)
+	verifySequence(t, list, []int{1, 2, 3, 4})
+
+	list.Shrink(2)
+	verifySequence(t, list, []int{1, 2})
+
+	if evicted, ok := list.PushEvicting(3); ok {
+		t.Errorf("Expected no eviction after shrinking, got %d", evicted)
+	}
+	list.PushAll(4, 5)
+	verifySequence(t, list, []int{2, 3, 4, 5})
+
+	if list.Cap() != 4 {
+		t.Errorf("Expected Shrink to keep the bound, got %d", list.Cap())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helper Functions
+// ----------------------------------------------------------------------------
+
+func verifySequence(t *testing.T, list *LinkedList[int], expected []int) {
+	if list.Size() != len(expected) {
+		t.Errorf("Size mismatch: expected %d, got %d", len(expected), list.Size())
+		return
+	}
+
+	for i, exp := range expected {
+		if val := list.At(i); val != exp {
+			t.Errorf("At index %d: expected %d, got %d", i, exp, val)
+		}
+	}
+
+	// Verify with ForEach
+	index := 0
+	list.ForEach(func(idx int, val int) bool {
+		if idx != index {
+			t.Errorf("ForEach index mismatch: expected %d, got %d", index, idx)
+		}
+		if val != expected[index] {
+			t.Errorf("ForEach value mismatch at %d: expected %d, got %d", index, expected[index], val)
+		}
+		index++
+		return true
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Pooled Nodes
+// ----------------------------------------------------------------------------
+
+func TestLinkedList_Pooled_BasicOperations(t *testing.T) {
+	list := NewLinkedListPooled[int]()
+
+	for round := 0; round < 3; round++ {
+		list.PushAll(1, 2, 3, 4, 5)
+		list.PushFront(0)
+
+		if val := list.PopLeft(); val != 0 {
+			t.Errorf("PopLeft should return 0, got %d", val)
+		}
+		if val := list.PopRight(); val != 5 {
+			t.Errorf("PopRight should return 5, got %d", val)
+		}
+		if val := list.Pop(1); val != 2 {
+			t.Errorf("Pop(1) should return 2, got %d", val)
+		}
+		list.Delete(0)
+		list.InsertAt(1, 7)
+
+		if !slices.Equal(list.ToSlice(), []int{3, 7, 4}) {
+			t.Errorf("Round %d: expected [3 7 4], got %v", round, list.ToSlice())
+		}
+
+		list.DeleteAll()
+		if !list.IsEmpty() {
+			t.Errorf("Round %d: list should be empty", round)
+		}
+	}
+}
+
+func TestLinkedList_Pooled_ReleasedNodesAreZeroed(t *testing.T) {
+	list := NewLinkedListPooled[*int]()
+	value := 42
+
+	node := list.Insert(&value)
+	list.Remove(node)
+
+	if node.Data != nil || node.left != nil || node.right != nil {
+		t.Error("Released node should not retain data or links")
+	}
+}
+
+func TestLinkedList_Pooled_InsertAtDrawsFromPool(t *testing.T) {
+	list := NewLinkedListPooled[int]()
+	list.PushAll(1, 3)
+
+	var drawn int
+	list.pool = &sync.Pool{
+		New: func() any {
+			drawn++
+			return &LinkedNode[int]{}
+		},
+	}
+
+	list.InsertAt(1, 2)
+
+	if drawn != 1 {
+		t.Errorf("InsertAt in the middle should draw its node from the pool, drew %d", drawn)
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", list.ToSlice())
+	}
+}
+
+func TestLinkedList_Pooled_MatchesDefault(t *testing.T) {
+	pooled := NewLinkedListPooled[int]()
+	plain := NewLinkedList[int]()
+
+	for j := 0; j < 500; j++ {
+		for _, list := range []*LinkedList[int]{pooled, plain} {
+			list.Push(j)
+			if j%3 == 0 {
+				list.PopLeft()
+			}
+			if j%5 == 0 {
+				list.PopRight()
+			}
+			if j%7 == 0 {
+				list.DeleteBy(func(v int) bool { return v%11 == 0 })
+			}
+			if j%50 == 0 {
+				list.Shrink(list.Size() / 2)
+			}
+		}
+	}
+
+	if !slices.Equal(pooled.ToSlice(), plain.ToSlice()) {
+		t.Errorf("Pooled list diverged from default list:\n%v\n%v", pooled.ToSlice(), plain.ToSlice())
+	}
 }
@@ -0,0 +1,239 @@
+package logger
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// LogEntry is a single log message recorded by a LogCapture.
+type LogEntry struct {
+	// Level is the log Level of the message (NONE for Logf, LogJSONf and LogObjectf)
+	Level LogLevel
+	// Source is the logger name, or empty if the logger has none
+	Source string
+	// Message is the formatted log message
+	Message string
+	// Fields holds the structured data of JSON and object logs, or nil for plain text logs
+	// and for JSON logs whose object isn't a JSON object. Numbers are decoded as float64.
+	Fields map[string]any
+	// Raw is the log line as written by the logger, without the trailing newline
+	Raw string
+}
+
+// LogCapture is an io.Writer that records every log written to it as a LogEntry,
+// so tests can assert on log output without parsing strings.
+// Plain text logs are split into level, source and message; JSON and object logs
+// are parsed back into their fields.
+//
+// A LogCapture is safe for concurrent use.
+type LogCapture struct {
+	// mutex guards entries
+	mutex sync.Mutex
+	// logger is the logger writing to the capture, used for its JSON key configuration
+	logger *Logger
+	// entries holds the recorded logs in the order they were written
+	entries []LogEntry
+}
+
+// NewTestLogger creates a logger whose output (including errors) is recorded by the returned LogCapture.
+// The logger logs every level without timestamps or coloring and is never registered
+// in the logger registry. It can be reconfigured with the usual With* methods.
+//
+// Returns:
+//   - The logger to pass to the code under test
+//   - The capture recording its output
+//
+// Example:
+//
+//	log, capture := logger.NewTestLogger()
+//	handler := NewHandler(log)
+//	handler.Serve(request)
+//
+//	if !capture.ContainsField("code", 500) {
+//	    t.Error("expected the failure to be logged")
+//	}
+func NewTestLogger() (*Logger, *LogCapture) {
+	capture := &LogCapture{}
+
+	logger := &Logger{
+		out:        capture,
+		err:        capture,
+		syncOut:    &sync.Mutex{},
+		syncErr:    &sync.Mutex{},
+		syncRoutes: &sync.Mutex{},
+	}
+	logger.configure(&Config{
+		Level:           NONE,
+		TimestampFormat: defaultConfig.TimestampFormat,
+		StackTraceLevel: ERROR,
+		StackTraceDepth: defaultStackTraceDepth,
+	})
+
+	capture.logger = logger
+
+	return logger, capture
+}
+
+// Write records the log message in data. It implements io.Writer and never fails.
+//
+// Parameters:
+//   - data: The log message as written by the logger
+//
+// Returns:
+//   - len(data) and nil
+func (capture *LogCapture) Write(data []byte) (int, error) {
+	keys := &defaultJSONKeys
+	if capture.logger != nil {
+		keys = capture.logger.keys()
+	}
+
+	// stack frames are appended to plain text logs as tab-indented lines
+	line, _, _ := strings.Cut(strings.TrimSuffix(string(data), "\n"), "\n\t")
+	entry := parseEntry(line, keys)
+
+	capture.mutex.Lock()
+	capture.entries = append(capture.entries, entry)
+	capture.mutex.Unlock()
+
+	return len(data), nil
+}
+
+// Entries returns a copy of all recorded logs, oldest first.
+func (capture *LogCapture) Entries() []LogEntry {
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	entries := make([]LogEntry, len(capture.entries))
+	copy(entries, capture.entries)
+	return entries
+}
+
+// LastEntry returns the most recently recorded log.
+//
+// Returns:
+//   - The last entry and true if any log was recorded
+//   - A zero LogEntry and false otherwise
+func (capture *LogCapture) LastEntry() (LogEntry, bool) {
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	if len(capture.entries) == 0 {
+		return LogEntry{}, false
+	}
+	return capture.entries[len(capture.entries)-1], true
+}
+
+// ContainsField reports whether any recorded log has a field with the given key and value.
+// Values are compared by their JSON representation, so ContainsField("code", 500)
+// matches a field decoded as float64(500).
+//
+// Parameters:
+//   - key: The field name
+//   - value: The expected field value
+//
+// Returns:
+//   - true if a matching field was logged, false otherwise
+func (capture *LogCapture) ContainsField(key string, value any) bool {
+	expected, ok := normalize(value)
+	if !ok {
+		return false
+	}
+
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	for _, entry := range capture.entries {
+		if actual, exists := entry.Fields[key]; exists && reflect.DeepEqual(actual, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset discards all recorded logs.
+func (capture *LogCapture) Reset() {
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	capture.entries = nil
+}
+
+// parseEntry converts a single log line into a LogEntry.
+//
+// Parameters:
+//   - line: The log line without the trailing newline and stack frames
+//   - keys: The JSON field names used by the logger
+//
+// Returns:
+//   - The parsed entry
+func parseEntry(line string, keys *JSONKeyConfig) LogEntry {
+	entry := LogEntry{Level: NONE, Raw: line}
+
+	if strings.HasPrefix(line, "{") {
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(line), &fields); err == nil {
+			entry.Level = ParseLogLevel(stringField(fields, keys.Level))
+			entry.Source = stringField(fields, keys.Source)
+			entry.Message = stringField(fields, keys.Message)
+			entry.Fields, _ = fields[keys.Object].(map[string]any)
+			return entry
+		}
+	}
+
+	// plain text logs look like "[timestamp ]LEVEL[ [source]]: message"
+	header, message, found := strings.Cut(line, ": ")
+	if !found {
+		entry.Message = line
+		return entry
+	}
+
+	words := strings.Fields(header)
+	if len(words) == 0 {
+		entry.Message = line
+		return entry
+	}
+
+	last := words[len(words)-1]
+	if len(words) > 1 && strings.HasPrefix(last, "[") && strings.HasSuffix(last, "]") {
+		entry.Source = last[1 : len(last)-1]
+		last = words[len(words)-2]
+	}
+
+	for _, level := range []LogLevel{ERROR, WARNING, INFO, DEBUG, TRACE} {
+		if last == level.String() {
+			entry.Level = level
+			entry.Message = message
+			return entry
+		}
+	}
+
+	entry.Source = ""
+	entry.Message = line
+	return entry
+}
+
+// stringField returns the string value of key in fields, or an empty string.
+func stringField(fields map[string]any, key string) string {
+	value, _ := fields[key].(string)
+	return value
+}
+
+// normalize converts value to the form produced by decoding its JSON representation into an any.
+//
+// Returns:
+//   - The normalized value and true on success
+//   - nil and false if value can't be represented as JSON
+func normalize(value any) (any, bool) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+
+	var normalized any
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, false
+	}
+	return normalized, true
+}
@@ -0,0 +1,206 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestNewTestLogger_PlainText tests that plain text logs are split into level, source and message.
+func TestNewTestLogger_PlainText(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	logger.Infof("started on port %d", 8080)
+	logger.Errorf("failed: %s", "timeout")
+	logger.Logf("info: not a level prefix")
+
+	entries := capture.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	if entries[0].Level != INFO || entries[0].Message != "started on port 8080" {
+		t.Errorf("Unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Level != ERROR || entries[1].Message != "failed: timeout" {
+		t.Errorf("Unexpected second entry: %+v", entries[1])
+	}
+	if entries[2].Level != NONE || entries[2].Message != "info: not a level prefix" {
+		t.Errorf("Unexpected third entry: %+v", entries[2])
+	}
+	if entries[0].Fields != nil {
+		t.Errorf("Plain text logs should have no fields, got %v", entries[0].Fields)
+	}
+	if entries[0].Raw != "INFO: started on port 8080" {
+		t.Errorf("Unexpected raw line %q", entries[0].Raw)
+	}
+}
+
+// TestNewTestLogger_PlainTextWithNameAndTimestamp tests parsing of prefixed plain text logs.
+func TestNewTestLogger_PlainTextWithNameAndTimestamp(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.name = "api"
+	logger.WithTimestamp()
+
+	logger.Warningf("slow request")
+
+	entry, ok := capture.LastEntry()
+	if !ok {
+		t.Fatal("Expected an entry")
+	}
+	if entry.Level != WARNING || entry.Source != "api" || entry.Message != "slow request" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+// TestNewTestLogger_JSON tests that JSON logs are parsed back into fields.
+func TestNewTestLogger_JSON(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	if err := logger.ErrorJSONf(map[string]any{"code": 500, "path": "/users"}, "request %s", "failed"); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, _ := capture.LastEntry()
+	if entry.Level != ERROR || entry.Message != "request failed" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if entry.Fields["path"] != "/users" || entry.Fields["code"] != float64(500) {
+		t.Errorf("Unexpected fields: %v", entry.Fields)
+	}
+}
+
+// TestNewTestLogger_JSONNonObject tests that a JSON log with a non-object payload has no fields.
+func TestNewTestLogger_JSONNonObject(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	_ = logger.InfoJSONf([]int{1, 2}, "list")
+
+	entry, _ := capture.LastEntry()
+	if entry.Level != INFO || entry.Message != "list" || entry.Fields != nil {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+// TestNewTestLogger_Object tests that object logs are parsed back into fields.
+func TestNewTestLogger_Object(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	logger.ErrorObjectf("request failed").
+		AssignInt("code", 500).
+		AssignString("method", "GET").
+		NestedStart("client").
+		AssignString("ip", "10.0.0.1").
+		NestedEnd().
+		Build()
+
+	entry, _ := capture.LastEntry()
+	if entry.Level != ERROR || entry.Message != "request failed" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if !capture.ContainsField("code", 500) {
+		t.Error("Expected field code=500")
+	}
+	if !capture.ContainsField("method", "GET") {
+		t.Error("Expected field method=GET")
+	}
+	if !capture.ContainsField("client", map[string]string{"ip": "10.0.0.1"}) {
+		t.Error("Expected nested field client.ip")
+	}
+	if capture.ContainsField("code", 404) || capture.ContainsField("missing", 500) {
+		t.Error("Unexpected field match")
+	}
+}
+
+// TestNewTestLogger_CustomJSONKeys tests that the capture follows the logger's JSON key configuration.
+func TestNewTestLogger_CustomJSONKeys(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.WithJSONKeys(JSONKeyConfig{Message: "msg", Object: "data"})
+
+	logger.InfoObjectf("renamed").AssignBool("ok", true).Build()
+
+	entry, _ := capture.LastEntry()
+	if entry.Message != "renamed" || entry.Fields["ok"] != true {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+// TestNewTestLogger_StackTrace tests that stack frames don't end up in the message.
+func TestNewTestLogger_StackTrace(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.WithStackTrace(ERROR)
+
+	logger.Errorf("boom")
+
+	entry, _ := capture.LastEntry()
+	if entry.Message != "boom" {
+		t.Errorf("Expected message without stack frames, got %q", entry.Message)
+	}
+}
+
+// TestLogCapture_LevelFiltering tests that filtered logs are not recorded.
+func TestLogCapture_LevelFiltering(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.WithLogLevel(WARNING)
+
+	logger.Debugf("hidden")
+	logger.Warningf("shown")
+
+	entries := capture.Entries()
+	if len(entries) != 1 || entries[0].Message != "shown" {
+		t.Errorf("Expected only the warning, got %+v", entries)
+	}
+}
+
+// TestLogCapture_EmptyAndReset tests LastEntry on an empty capture and Reset.
+func TestLogCapture_EmptyAndReset(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	if _, ok := capture.LastEntry(); ok {
+		t.Error("Empty capture should have no last entry")
+	}
+
+	logger.Infof("one")
+	capture.Reset()
+
+	if len(capture.Entries()) != 0 {
+		t.Error("Reset should discard all entries")
+	}
+	if _, ok := capture.LastEntry(); ok {
+		t.Error("Reset capture should have no last entry")
+	}
+}
+
+// TestLogCapture_EntriesIsCopy tests that Entries returns a snapshot.
+func TestLogCapture_EntriesIsCopy(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.Infof("one")
+
+	entries := capture.Entries()
+	entries[0].Message = "changed"
+
+	if entry, _ := capture.LastEntry(); entry.Message != "one" {
+		t.Errorf("Entries should return a copy, got %q", entry.Message)
+	}
+}
+
+// TestLogCapture_Concurrent tests that concurrent logging records every entry.
+func TestLogCapture_Concurrent(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				logger.Infof("info")
+				logger.Errorf("error")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(capture.Entries()) != 1600 {
+		t.Errorf("Expected 1600 entries, got %d", len(capture.Entries()))
+	}
+}
@@ -1,6 +1,6 @@
 package logger
 
-import "runtime"
+import "os"
 
 // Config holds configuration settings for a Logger instance.
 // It controls log filtering, formatting, and Async behavior.
@@ -12,17 +12,33 @@ type Config struct {
 	Timestamp bool `env:"LOG_TIMESTAMP" default:"true"`
 	// TimestampFormat is the Go time.Format string for Timestamp formatting
 	TimestampFormat string `env:"LOG_TIMESTAMP_FORMAT" default:"2006-01-02 15:04:05"`
+	// UTC formats timestamps in UTC instead of the local time zone
+	UTC bool `env:"LOG_UTC" default:"false"`
 	// UseRegistry enables/disables logger registry
 	UseRegistry bool `env:"LOG_USE_REGISTRY" default:"true"`
 	// Coloring enables/disables ANSI color codes for log levels
 	Coloring bool `env:"LOG_COLORING" default:"false"`
+	// ForceColor enables ANSI color codes even when the output is not a terminal
+	ForceColor bool `env:"LOG_FORCE_COLOR" default:"false"`
 	// Async enables/disables asynchronous logging mode
 	Async       bool `env:"LOG_ASYNC" default:"false"`
 	AsyncBuffer int  `env:"LOG_ASYNC_BUFFER" default:"100"`
+	// StackTrace enables stack trace capture for logs at or above StackTraceLevel severity
+	StackTrace bool `env:"LOG_STACK_TRACE" default:"false"`
+	// StackTraceLevel is the least severe log Level that captures a stack trace
+	StackTraceLevel LogLevel `env:"LOG_STACK_TRACE_LEVEL" default:"ERROR"`
+	// StackTraceDepth is the maximum number of frames captured in a stack trace
+	StackTraceDepth int `env:"LOG_STACK_TRACE_DEPTH" default:"32"`
+	// SeverityNumber adds the OpenTelemetry severity number of the log Level to JSON and object output
+	SeverityNumber bool `env:"LOG_SEVERITY_NUMBER" default:"false"`
 	// logs is the channel for buffering non-error log messages when Async is enabled
-	logs, errors chan []byte
-	// cancelAsync is used to shut down the Async logging goroutine
+	logs, errors chan asyncMessage
+	// cancelAsync is closed when the Async logging goroutine shuts down
 	cancelAsync chan struct{}
+	// stopAsync shuts down the Async logging goroutine, idempotently (nil unless this logger owns it)
+	stopAsync func()
+	// dispatcher is the shared Async writer replacing the channels above (see WithSharedAsync)
+	dispatcher *AsyncDispatcher
 }
 
 // defaultConfig contains default settings applied to all new Logger instances.
@@ -31,10 +47,16 @@ var defaultConfig = &Config{
 	Level:           NONE,
 	Timestamp:       false,
 	TimestampFormat: "2006-01-02 15:04:05",
+	UTC:             false,
 	UseRegistry:     true,
 	Coloring:        false,
+	ForceColor:      false,
 	Async:           false,
 	AsyncBuffer:     100,
+	StackTrace:      false,
+	StackTraceLevel: ERROR,
+	StackTraceDepth: defaultStackTraceDepth,
+	SeverityNumber:  false,
 }
 
 // WithDefaultLogLevel sets the default log Level for all newly created loggers.
@@ -82,15 +104,17 @@ func WithDefaultTimestampFormat(format string) {
 }
 
 // WithDefaultColoring enables ANSI color codes for all newly created loggers.
-// Colors are only enabled on non-Windows platforms.
+// On Windows, colors are enabled only if virtual terminal processing can be turned on
+// for the standard output or error console.
 // This does not affect existing logger instances.
 //
 // Example:
 //
 //	logger.WithDefaultColoring()
-//	logger := logger.NewLogger() // Logs will be colored on Unix/Linux/macOS
+//	logger := logger.NewLogger() // Logs will be colored on terminals supporting ANSI codes
 func WithDefaultColoring() {
-	if runtime.GOOS != "windows" {
+	stdout, stderr := enableVirtualTerminal(os.Stdout), enableVirtualTerminal(os.Stderr)
+	if defaultConfig.ForceColor || stdout || stderr {
 		defaultConfig.Coloring = true
 	}
 }
@@ -122,6 +146,9 @@ func WithConfig(config *Config) {
 //	// Later, retrieve the same instance
 //	apiLogger2 := logger.GetLogger("api") // Returns the same instance as apiLogger
 func WithLoggerRegistry() {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
 	defaultConfig.UseRegistry = true
 	if loggerRegistry == nil {
 		loggerRegistry = make(map[string]*Logger)
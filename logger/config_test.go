@@ -22,8 +22,8 @@ func TestWithDefaultLogLevel(t *testing.T) {
 
 			// Verify new loggers use this default
 			logger := NewLogger("test")
-			if logger.options.Level != level {
-				t.Errorf("New logger should have level %v, got %v", level, logger.options.Level)
+			if logger.config().Level != level {
+				t.Errorf("New logger should have level %v, got %v", level, logger.config().Level)
 			}
 		})
 	}
@@ -52,11 +52,11 @@ func TestWithDefaultTimestamp(t *testing.T) {
 
 	// Verify new loggers use this default
 	logger := NewLogger("test")
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("New logger should have timestamp enabled")
 	}
-	if logger.options.TimestampFormat != expectedFormat {
-		t.Errorf("New logger should have timestamp format '%s', got '%s'", expectedFormat, logger.options.TimestampFormat)
+	if logger.config().TimestampFormat != expectedFormat {
+		t.Errorf("New logger should have timestamp format '%s', got '%s'", expectedFormat, logger.config().TimestampFormat)
 	}
 }
 
@@ -92,17 +92,18 @@ func TestWithDefaultTimestampFormat(t *testing.T) {
 
 			// Verify new loggers use this default
 			logger := NewLogger("test")
-			if !logger.options.Timestamp {
+			if !logger.config().Timestamp {
 				t.Error("New logger should have timestamp enabled")
 			}
-			if logger.options.TimestampFormat != format {
-				t.Errorf("New logger should have timestamp format '%s', got '%s'", format, logger.options.TimestampFormat)
+			if logger.config().TimestampFormat != format {
+				t.Errorf("New logger should have timestamp format '%s', got '%s'", format, logger.config().TimestampFormat)
 			}
 		})
 	}
 }
 
 // TestWithDefaultColoring tests that coloring is enabled on non-Windows platforms.
+// On Windows the result depends on whether the standard streams are consoles.
 func TestWithDefaultColoring(t *testing.T) {
 	// Save original defaultConfig to restore after test
 	originalColoring := defaultConfig.Coloring
@@ -118,13 +119,9 @@ func TestWithDefaultColoring(t *testing.T) {
 
 		// Verify new loggers use this default
 		logger := NewLogger("test")
-		if !logger.options.Coloring {
+		if !logger.config().Coloring {
 			t.Error("New logger should have coloring enabled on non-Windows platforms")
 		}
-	} else {
-		if defaultConfig.Coloring {
-			t.Error("WithDefaultColoring should not enable coloring on Windows")
-		}
 	}
 }
 
@@ -308,16 +305,16 @@ func TestNewLogger_UsesDefaultConfig(t *testing.T) {
 
 	logger := NewLogger("test")
 
-	if logger.options.Level != WARNING {
-		t.Errorf("Logger should inherit level WARNING, got %v", logger.options.Level)
+	if logger.config().Level != WARNING {
+		t.Errorf("Logger should inherit level WARNING, got %v", logger.config().Level)
 	}
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Logger should inherit timestamp=true")
 	}
-	if logger.options.TimestampFormat != time.RFC3339 {
-		t.Errorf("Logger should inherit timestamp format %s, got %s", time.RFC3339, logger.options.TimestampFormat)
+	if logger.config().TimestampFormat != time.RFC3339 {
+		t.Errorf("Logger should inherit timestamp format %s, got %s", time.RFC3339, logger.config().TimestampFormat)
 	}
-	if !logger.options.Coloring {
+	if !logger.config().Coloring {
 		t.Error("Logger should inherit coloring=true")
 	}
 }
@@ -358,8 +355,8 @@ func TestDefaultConfig_Independence(t *testing.T) {
 	}
 
 	// Logger should have ERROR
-	if logger.options.Level != ERROR {
-		t.Errorf("Logger should have ERROR level, got %v", logger.options.Level)
+	if logger.config().Level != ERROR {
+		t.Errorf("Logger should have ERROR level, got %v", logger.config().Level)
 	}
 }
 
@@ -399,13 +396,13 @@ func TestConfig_MultipleDefaults(t *testing.T) {
 
 	// Verify new logger inherits all defaults
 	logger := NewLogger("test")
-	if logger.options.Level != DEBUG {
-		t.Errorf("Logger should have level DEBUG, got %v", logger.options.Level)
+	if logger.config().Level != DEBUG {
+		t.Errorf("Logger should have level DEBUG, got %v", logger.config().Level)
 	}
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Logger should have timestamp enabled")
 	}
-	if logger.options.Coloring != expectedOnNonWindows {
-		t.Errorf("Logger should have coloring %v, got %v", expectedOnNonWindows, logger.options.Coloring)
+	if logger.config().Coloring != expectedOnNonWindows {
+		t.Errorf("Logger should have coloring %v, got %v", expectedOnNonWindows, logger.config().Coloring)
 	}
 }
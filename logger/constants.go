@@ -1,6 +1,9 @@
 package logger
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // LogLevel represents the severity of a log message.
 // Lower numeric values indicate higher severity.
@@ -10,6 +13,18 @@ import "strings"
 // For example, if set to INFO, ERROR and WARNING messages are also logged, but DEBUG and TRACE are discarded.
 type LogLevel uint8
 
+// ByteEncoding selects how binary data is encoded by ObjectLogBuilder.AssignBytes.
+type ByteEncoding uint8
+
+const (
+	// Raw writes the bytes as an escaped string, interpreting them as UTF-8.
+	Raw ByteEncoding = iota
+	// Hex writes the bytes as a lowercase hexadecimal string.
+	Hex
+	// Base64 writes the bytes as a standard base64 string with padding.
+	Base64
+)
+
 // color is an internal type representing ANSI color escape sequences.
 // These are byte sequences that terminals interpret as formatting commands.
 type color []byte
@@ -97,19 +112,74 @@ func (level *LogLevel) String() string {
 //	Level := logger.ParseLogLevel("ERROR")   // Returns ERROR
 //	Level := logger.ParseLogLevel("invalid") // Returns NONE
 func ParseLogLevel(level string) LogLevel {
+	parsed, err := ParseLevel(level)
+	if err != nil {
+		return NONE
+	}
+	return parsed
+}
+
+// ParseLevel converts a string representation to a LogLevel, reporting unrecognized values.
+// The comparison is case-insensitive and surrounding whitespace is ignored.
+// Unlike ParseLogLevel, it allows callers to tell an invalid value apart from "NONE".
+//
+// Parameters:
+//   - Level: A string representation of the log Level
+//
+// Returns:
+//   - The corresponding LogLevel constant
+//   - Error if the string is not one of ERROR, WARNING, INFO, DEBUG, TRACE, or NONE
+//
+// Example:
+//
+//	Level, err := logger.ParseLevel("debug") // Returns DEBUG, nil
+//	Level, err := logger.ParseLevel("loud")  // Returns NONE, error
+func ParseLevel(level string) (LogLevel, error) {
+	level = strings.TrimSpace(level)
 	switch {
 	case strings.EqualFold(level, "ERROR"):
-		return ERROR
+		return ERROR, nil
 	case strings.EqualFold(level, "WARNING"):
-		return WARNING
+		return WARNING, nil
 	case strings.EqualFold(level, "INFO"):
-		return INFO
+		return INFO, nil
 	case strings.EqualFold(level, "DEBUG"):
-		return DEBUG
+		return DEBUG, nil
 	case strings.EqualFold(level, "TRACE"):
-		return TRACE
+		return TRACE, nil
+	case strings.EqualFold(level, "NONE"):
+		return NONE, nil
 	default:
-		return NONE
+		return NONE, fmt.Errorf("unknown log level %q", level)
+	}
+}
+
+// SeverityNumber returns the OpenTelemetry severity number of the log Level,
+// the first number of the level's range in the OpenTelemetry log data model:
+// TRACE=1 (1-4), DEBUG=5 (5-8), INFO=9 (9-12), WARNING=13 (13-16), ERROR=17 (17-20).
+//
+// Returns:
+//   - The severity number of the Level
+//   - 0 (unspecified) for NONE or unrecognized levels
+//
+// Example:
+//
+//	Level := logger.WARNING
+//	fmt.Println(Level.SeverityNumber()) // Output: 13
+func (level *LogLevel) SeverityNumber() int {
+	switch *level {
+	case ERROR:
+		return 17
+	case WARNING:
+		return 13
+	case INFO:
+		return 9
+	case DEBUG:
+		return 5
+	case TRACE:
+		return 1
+	default:
+		return 0
 	}
 }
 
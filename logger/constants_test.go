@@ -29,6 +29,30 @@ func TestLogLevel_String(t *testing.T) {
 	}
 }
 
+// TestLogLevel_SeverityNumber tests the SeverityNumber method of LogLevel type.
+// It verifies that each log level maps to the first number of its OpenTelemetry severity range.
+func TestLogLevel_SeverityNumber(t *testing.T) {
+	tests := []struct {
+		level    LogLevel
+		expected int
+	}{
+		{ERROR, 17},
+		{WARNING, 13},
+		{INFO, 9},
+		{DEBUG, 5},
+		{TRACE, 1},
+		{NONE, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			if result := tt.level.SeverityNumber(); result != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
 // TestLogLevel_color tests the color method of LogLevel type.
 // It verifies that each log level returns the correct ANSI color code.
 func TestLogLevel_color(t *testing.T) {
@@ -206,6 +230,38 @@ func TestParseLogLevel(t *testing.T) {
 	}
 }
 
+// TestParseLevel tests the ParseLevel function with valid and invalid inputs.
+// It verifies that unrecognized values are reported as errors.
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected LogLevel
+		wantErr  bool
+	}{
+		{"Uppercase ERROR", "ERROR", ERROR, false},
+		{"Lowercase warning", "warning", WARNING, false},
+		{"Mixed case Info", "Info", INFO, false},
+		{"Padded debug", " debug\n", DEBUG, false},
+		{"Lowercase trace", "trace", TRACE, false},
+		{"Explicit none", "none", NONE, false},
+		{"Invalid Level", "invalid", NONE, true},
+		{"Empty string", "", NONE, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseLevel(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLevel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseLevel(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 // TestLogLevel_UnmarshalText tests the UnmarshalText implementation for LogLevel.
 // This is used for parsing log levels from environment variables and configuration files.
 func TestLogLevel_UnmarshalText(t *testing.T) {
@@ -0,0 +1,563 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// contextKey is the type of context keys defined by this package.
+// Using a dedicated type prevents collisions with keys defined in other packages.
+type contextKey struct {
+	name string
+}
+
+var (
+	// loggerKey is the context key under which WithContext stores the logger
+	loggerKey = &contextKey{"logger"}
+	// TraceIDKey is the default context key the *Context methods read the trace ID from.
+	// Use ContextWithTraceID to store a trace ID under this key, or WithTraceIDKey
+	// to read it from a key defined by another package.
+	TraceIDKey any = &contextKey{"trace_id"}
+	// fallbackLogger returns the logger FromContext hands out for contexts without one, created on first use
+	fallbackLogger = sync.OnceValue(func() *Logger { return NewLogger("") })
+)
+
+// FromContext retrieves the logger stored in the context by WithContext.
+// If the context holds no logger, a shared fallback logger is returned, so the result is always safe to use.
+// The fallback is created once, with the default configuration at the time of the first such call.
+//
+// Parameters:
+//   - ctx: The context to retrieve the logger from
+//
+// Returns:
+//   - The Logger stored in the context, or the fallback logger
+//
+// Example:
+//
+//	func handle(ctx context.Context) {
+//	    logger.FromContext(ctx).InfoContext(ctx, "handling request")
+//	}
+func FromContext(ctx context.Context) *Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerKey).(*Logger); ok && logger != nil {
+			return logger
+		}
+	}
+	return fallbackLogger()
+}
+
+// ContextWithTraceID returns a copy of the context carrying the given trace ID under TraceIDKey.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - traceID: The correlation/trace identifier of the request
+//
+// Returns:
+//   - A derived context carrying the trace ID
+//
+// Example:
+//
+//	ctx := logger.ContextWithTraceID(r.Context(), r.Header.Get("X-Request-ID"))
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, TraceIDKey, traceID)
+}
+
+// WithContext returns a copy of the context carrying this logger.
+// The logger can be retrieved later with FromContext.
+//
+// Parameters:
+//   - ctx: The parent context
+//
+// Returns:
+//   - A derived context carrying the logger
+//
+// Example:
+//
+//	ctx := apiLogger.WithContext(r.Context())
+//	next.ServeHTTP(w, r.WithContext(ctx))
+func (logger *Logger) WithContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// WithTraceIDKey sets the context key the *Context methods read the trace ID from.
+// This allows reusing a key defined by tracing or middleware packages.
+//
+// Parameters:
+//   - key: The context key holding the trace ID; nil restores TraceIDKey
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithTraceIDKey(middleware.RequestIDKey)
+func (logger *Logger) WithTraceIDKey(key any) *Logger {
+	logger.traceIDKey = key
+	return logger
+}
+
+// traceID extracts the trace ID from the context using the configured key.
+//
+// Parameters:
+//   - ctx: The context to read the trace ID from
+//
+// Returns:
+//   - The trace ID formatted as a string, or "" if the context holds none
+func (logger *Logger) traceID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	key := logger.traceIDKey
+	if key == nil {
+		key = TraceIDKey
+	}
+
+	switch value := ctx.Value(key).(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// writeContext formats a message with the trace ID from the context and writes it.
+// In Async mode the send is abandoned as soon as the context is done,
+// so a full buffer never blocks a cancelled request.
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//   - ctx: The context carrying the trace ID and cancellation
+//   - level: The log Level
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) writeContext(ctx context.Context, options *Config, level LogLevel, msg string, args ...any) {
+	message := logger.format(msg, args...)
+	if traceID := logger.traceID(ctx); len(traceID) > 0 {
+		message = append(message, " trace_id="...)
+		message = append(message, traceID...)
+	}
+
+	stream, mutex := logger.stream(level)
+
+	if options.Async {
+		var done <-chan struct{}
+		if ctx != nil {
+			done = ctx.Done()
+		}
+
+		logger.sendToChannelUntil(options, level, logger.formatMessage(options, level, "%s", message), done)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger.writeStringToStream(options, stream, level, "%s", message)
+}
+
+// LogContext logs a message without a log Level prefix, attaching the trace ID from the context.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) LogContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(NONE)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, NONE, msg, args...)
+}
+
+// TraceContext logs a message at TRACE Level, attaching the trace ID from the context.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) TraceContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(TRACE)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, TRACE, msg, args...)
+}
+
+// DebugContext logs a message at DEBUG Level, attaching the trace ID from the context.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) DebugContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(DEBUG)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, DEBUG, msg, args...)
+}
+
+// InfoContext logs a message at INFO Level, attaching the trace ID from the context.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Example:
+//
+//	ctx := logger.ContextWithTraceID(r.Context(), "4bf92f35")
+//	log.InfoContext(ctx, "user %s logged in", user)
+//	// Output: "INFO [api]: user alice logged in trace_id=4bf92f35"
+func (logger *Logger) InfoContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(INFO)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, INFO, msg, args...)
+}
+
+// WarningContext logs a message at WARNING Level, attaching the trace ID from the context.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) WarningContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(WARNING)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, WARNING, msg, args...)
+}
+
+// ErrorContext logs a message at ERROR Level, attaching the trace ID from the context.
+// These logs are written to the error stream (stderr by default).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - msg: The message format string
+//   - args: Optional format arguments
+func (logger *Logger) ErrorContext(ctx context.Context, msg string, args ...any) {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return
+	}
+
+	logger.writeContext(ctx, options, ERROR, msg, args...)
+}
+
+// writeJSONContext formats a JSON log with a trace_id field holding the trace ID from the context and writes it.
+// In Async mode the send is abandoned as soon as the context is done, like writeContext.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - options: The options loaded by the log call
+//   - level: The log Level
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) writeJSONContext(ctx context.Context, options *Config, level LogLevel, object any, msg string, args ...any) error {
+	data, err := logger.formatJSONError(options, level, object, nil, logger.traceID(ctx), msg, args...)
+	if err != nil {
+		return err
+	}
+
+	if options.Async {
+		var done <-chan struct{}
+		if ctx != nil {
+			done = ctx.Done()
+		}
+
+		logger.sendToChannelUntil(options, level, data, done)
+		return nil
+	}
+
+	stream, mutex := logger.stream(level)
+	if stream == nil {
+		return errors.New("nil stream or object")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	_, err = stream.Write(data)
+	return err
+}
+
+// LogJSONContext logs a message with structured JSON data without a log Level,
+// attaching the trace ID from the context as the trace_id field.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) LogJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(NONE)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, NONE, object, msg, args...)
+}
+
+// TraceJSONContext logs a message with structured JSON data at TRACE Level,
+// attaching the trace ID from the context as the trace_id field.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) TraceJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(TRACE)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, TRACE, object, msg, args...)
+}
+
+// DebugJSONContext logs a message with structured JSON data at DEBUG Level,
+// attaching the trace ID from the context as the trace_id field.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) DebugJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(DEBUG)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, DEBUG, object, msg, args...)
+}
+
+// InfoJSONContext logs a message with structured JSON data at INFO Level,
+// attaching the trace ID from the context as the trace_id field.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+//
+// Example:
+//
+//	ctx := logger.ContextWithTraceID(r.Context(), "4bf92f35")
+//	log.InfoJSONContext(ctx, map[string]any{"user": "alice"}, "logged in")
+//	// Output: {"level":"INFO","source":"api","message":"logged in","trace_id":"4bf92f35","object":{"user":"alice"}}
+func (logger *Logger) InfoJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(INFO)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, INFO, object, msg, args...)
+}
+
+// WarningJSONContext logs a message with structured JSON data at WARNING Level,
+// attaching the trace ID from the context as the trace_id field.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) WarningJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(WARNING)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, WARNING, object, msg, args...)
+}
+
+// ErrorJSONContext logs a message with structured JSON data at ERROR Level,
+// attaching the trace ID from the context as the trace_id field.
+// These logs are written to the error stream (stderr by default).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails
+func (logger *Logger) ErrorJSONContext(ctx context.Context, object any, msg string, args ...any) error {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return nil
+	}
+
+	return logger.writeJSONContext(ctx, options, ERROR, object, msg, args...)
+}
+
+// newObjectContextBuilder creates an object log builder whose log carries the trace ID from the context.
+// In Async mode Build abandons the send as soon as the context is done, like writeContext.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID and cancellation
+//   - options: The options loaded by the log call
+//   - level: The log Level
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - A pooled ObjectLogBuilder ready for field assignment
+func (logger *Logger) newObjectContextBuilder(ctx context.Context, options *Config, level LogLevel, msg string, args ...any) *ObjectLogBuilder {
+	builder := newObjectLogBuilder(logger, options, level, logger.format(msg, args...), logger.traceID(ctx))
+	if ctx != nil {
+		builder.done = ctx.Done()
+	}
+	return builder
+}
+
+// LogObjectContext creates an object log builder at no specific Level (see InfoObjectContext).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
+func (logger *Logger) LogObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(NONE)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, NONE, msg, args...)
+}
+
+// TraceObjectContext creates an object log builder at TRACE Level (see InfoObjectContext).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
+func (logger *Logger) TraceObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(TRACE)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, TRACE, msg, args...)
+}
+
+// DebugObjectContext creates an object log builder at DEBUG Level (see InfoObjectContext).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
+func (logger *Logger) DebugObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(DEBUG)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, DEBUG, msg, args...)
+}
+
+// InfoObjectContext creates an object log builder at INFO Level whose log carries the trace ID
+// from the context as the trace_id field, next to the message.
+// In Async mode, Build stops waiting for room in a full queue once the context is done.
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
+//
+// Example:
+//
+//	log.InfoObjectContext(ctx, "request").AssignInt("status", 200).Build()
+//	// Output: {"level":"INFO","source":"api","message":"request","trace_id":"4bf92f35","object":{"status":200}}
+func (logger *Logger) InfoObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(INFO)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, INFO, msg, args...)
+}
+
+// WarningObjectContext creates an object log builder at WARNING Level (see InfoObjectContext).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
+func (logger *Logger) WarningObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(WARNING)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, WARNING, msg, args...)
+}
+
+// ErrorObjectContext creates an object log builder at ERROR Level (see InfoObjectContext).
+//
+// Parameters:
+//   - ctx: The context carrying the trace ID
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - An ObjectLogBuilder for constructing the error log, or nil if sampling discards this log
+func (logger *Logger) ErrorObjectContext(ctx context.Context, msg string, args ...any) *ObjectLogBuilder {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return nil
+	}
+
+	return logger.newObjectContextBuilder(ctx, options, ERROR, msg, args...)
+}
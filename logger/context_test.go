@@ -0,0 +1,175 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFromContext tests storing a logger in a context and retrieving it.
+func TestFromContext(t *testing.T) {
+	logger := NewLogger("context")
+	ctx := logger.WithContext(context.Background())
+
+	if FromContext(ctx) != logger {
+		t.Error("FromContext should return the logger stored by WithContext")
+	}
+
+	fallback := FromContext(context.Background())
+	if fallback == nil {
+		t.Fatal("FromContext should return a default logger for contexts without one")
+	}
+	if fallback == logger {
+		t.Error("FromContext should not return the stored logger for unrelated contexts")
+	}
+	if FromContext(context.Background()) != fallback {
+		t.Error("FromContext should return the same fallback logger on every miss")
+	}
+}
+
+// TestLogger_InfoContext tests that the trace ID from the context is attached to the message.
+func TestLogger_InfoContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+
+	ctx := ContextWithTraceID(context.Background(), "abc123")
+	logger.InfoContext(ctx, "user %s logged in", "alice")
+
+	if !strings.Contains(buf.String(), "INFO [test]: user alice logged in trace_id=abc123") {
+		t.Errorf("Expected message with trace_id, got %q", buf.String())
+	}
+
+	buf.Reset()
+	logger.InfoContext(context.Background(), "no trace")
+	if strings.Contains(buf.String(), "trace_id") {
+		t.Errorf("Expected no trace_id without a trace ID in context, got %q", buf.String())
+	}
+}
+
+// TestLogger_WithTraceIDKey tests reading the trace ID from a custom context key.
+func TestLogger_WithTraceIDKey(t *testing.T) {
+	type requestIDKey struct{}
+
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithTraceIDKey(requestIDKey{})
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, 42)
+	logger.ErrorContext(ctx, "failed")
+
+	if !strings.Contains(buf.String(), "failed trace_id=42") {
+		t.Errorf("Expected trace_id from custom key, got %q", buf.String())
+	}
+}
+
+// TestLogger_InfoJSONContext tests that JSON logs carry the trace ID as a trace_id field.
+func TestLogger_InfoJSONContext(t *testing.T) {
+	type requestIDKey struct{}
+
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithTraceIDKey(requestIDKey{})
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "abc123")
+	if err := logger.InfoJSONContext(ctx, map[string]int{"status": 200}, "user %s", "alice"); err != nil {
+		t.Fatalf("InfoJSONContext() error = %v", err)
+	}
+
+	expected := `{"level":"INFO","source":"test","message":"user alice","trace_id":"abc123","object":{"status":200}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	_ = logger.InfoJSONContext(context.Background(), nil, "no trace")
+	if strings.Contains(buf.String(), "trace_id") {
+		t.Errorf("Expected no trace_id without a trace ID in context, got %q", buf.String())
+	}
+}
+
+// TestLogger_InfoObjectContext tests that object logs carry the trace ID as a trace_id field.
+func TestLogger_InfoObjectContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).ErrorsTo(&buf)
+
+	ctx := ContextWithTraceID(context.Background(), "abc123")
+	logger.InfoObjectContext(ctx, "request").AssignInt("status", 200).Build()
+	logger.ErrorObjectContext(context.Background(), "failed").Build()
+
+	expected := `{"level":"INFO","source":"test","message":"request","trace_id":"abc123","object":{"status":200}}` + "\n" +
+		`{"level":"ERROR","source":"test","message":"failed","object":{}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// TestLogger_Context_LevelFiltering tests that context methods respect the log level.
+func TestLogger_Context_LevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithLogLevel(WARNING)
+
+	ctx := context.Background()
+	logger.TraceContext(ctx, "trace")
+	logger.DebugContext(ctx, "debug")
+	logger.InfoContext(ctx, "info")
+
+	if buf.Len() > 0 {
+		t.Errorf("Expected filtered messages to be discarded, got %q", buf.String())
+	}
+
+	logger.WarningContext(ctx, "warning")
+	logger.LogContext(ctx, "plain")
+	if !strings.Contains(buf.String(), "warning") || !strings.Contains(buf.String(), "plain") {
+		t.Errorf("Expected WARNING and plain messages, got %q", buf.String())
+	}
+}
+
+// TestLogger_InfoContext_AsyncCancelled tests that async sends return promptly once the context is done.
+func TestLogger_InfoContext_AsyncCancelled(t *testing.T) {
+	logger := NewLogger("test")
+	// a full, undrained channel makes every send block
+	logger.update(func(options *Config) {
+		options.Async = true
+		options.logs = make(chan asyncMessage)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		logger.InfoContext(ctx, "dropped")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("InfoContext should not block on a cancelled context")
+	}
+}
+
+// TestLogger_InfoObjectContext_AsyncCancelled tests that building an object log returns promptly once the context is done.
+func TestLogger_InfoObjectContext_AsyncCancelled(t *testing.T) {
+	logger := NewLogger("test")
+	// a full, undrained channel makes every send block
+	logger.update(func(options *Config) {
+		options.Async = true
+		options.logs = make(chan asyncMessage)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		logger.InfoObjectContext(ctx, "dropped").AssignInt("status", 200).Build()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Build should not block on a cancelled context")
+	}
+}
@@ -0,0 +1,184 @@
+package logger
+
+import "sync"
+
+// asyncMessage is a formatted log waiting in an AsyncDispatcher queue.
+type asyncMessage struct {
+	// logger is the logger that produced the message and owns the output streams
+	logger *Logger
+	// level selects the output stream (see Logger.stream)
+	level LogLevel
+	// data is the formatted log
+	data []byte
+	// barrier, if set, marks a position in the queue instead of a log and is closed once reached (see Logger.Sync)
+	barrier chan struct{}
+}
+
+// write writes the message to the output stream of its logger, or releases the goroutine waiting on its barrier.
+func (message asyncMessage) write() {
+	if message.barrier != nil {
+		close(message.barrier)
+		return
+	}
+	message.logger.writeByLevel(message.level, message.data)
+}
+
+// AsyncDispatcher writes the logs of several asynchronous loggers from a single goroutine.
+// All attached loggers share one ordered queue, so the goroutine count stays constant
+// regardless of the number of loggers and logs are written in the order they were queued.
+//
+// Loggers are attached with WithSharedAsync. Close drains the queue and stops the goroutine;
+// loggers still attached afterwards write synchronously.
+type AsyncDispatcher struct {
+	// messages is the queue shared by all attached loggers
+	messages chan asyncMessage
+	// mutex guards closed; senders hold the read lock while queueing
+	mutex sync.RWMutex
+	// closed is set by Close, after which messages are written synchronously
+	closed bool
+	// closing is closed by Close to ask the goroutine to drain the queue and exit
+	closing chan struct{}
+	// stopped is closed by the goroutine once it has exited
+	stopped chan struct{}
+	// once makes Close idempotent
+	once sync.Once
+}
+
+// NewAsyncDispatcher creates a dispatcher and starts its writer goroutine.
+//
+// Parameters:
+//   - capacity: The number of logs the shared queue buffers before senders block
+//
+// Returns:
+//   - A pointer to the running AsyncDispatcher
+//
+// Example:
+//
+//	dispatcher := logger.NewAsyncDispatcher(1000)
+//	defer dispatcher.Close()
+//
+//	api := logger.NewLogger("api").WithSharedAsync(dispatcher)
+//	db := logger.NewLogger("db").WithSharedAsync(dispatcher)
+func NewAsyncDispatcher(capacity int) *AsyncDispatcher {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	dispatcher := &AsyncDispatcher{
+		messages: make(chan asyncMessage, capacity),
+		closing:  make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	go dispatcher.run()
+
+	return dispatcher
+}
+
+// run writes queued logs until Close is called, then drains the queue and exits.
+func (dispatcher *AsyncDispatcher) run() {
+	defer close(dispatcher.stopped)
+
+	for {
+		select {
+		case message := <-dispatcher.messages:
+			message.write()
+		case <-dispatcher.closing:
+			for {
+				select {
+				case message := <-dispatcher.messages:
+					message.write()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// send queues a log, or writes it directly if the dispatcher is closed.
+// The send is abandoned once done is closed; a nil done channel waits until the log is queued.
+//
+// Parameters:
+//   - logger: The logger that produced the log
+//   - level: The log Level
+//   - data: The formatted log
+//   - done: A channel that cancels the send when closed
+func (dispatcher *AsyncDispatcher) send(logger *Logger, level LogLevel, data []byte, done <-chan struct{}) {
+	dispatcher.mutex.RLock()
+	defer dispatcher.mutex.RUnlock()
+
+	if dispatcher.closed {
+		logger.writeByLevel(level, data)
+		return
+	}
+
+	select {
+	case dispatcher.messages <- asyncMessage{logger: logger, level: level, data: data}:
+	case <-done:
+	}
+}
+
+// wait blocks until the logs queued before the call have been written.
+// It returns immediately if the dispatcher is closed, since its logs are then written synchronously.
+func (dispatcher *AsyncDispatcher) wait() {
+	barrier := make(chan struct{})
+
+	dispatcher.mutex.RLock()
+	if dispatcher.closed {
+		dispatcher.mutex.RUnlock()
+		return
+	}
+	dispatcher.messages <- asyncMessage{barrier: barrier}
+	dispatcher.mutex.RUnlock()
+
+	<-barrier
+}
+
+// Close writes all queued logs and stops the writer goroutine. It blocks until the queue is drained.
+// Logs sent by attached loggers after Close are written synchronously. Calling Close more than once is safe.
+func (dispatcher *AsyncDispatcher) Close() {
+	dispatcher.once.Do(func() {
+		// wait for in-flight sends, so nothing is queued after the drain
+		dispatcher.mutex.Lock()
+		dispatcher.closed = true
+		dispatcher.mutex.Unlock()
+
+		close(dispatcher.closing)
+	})
+
+	<-dispatcher.stopped
+}
+
+// WithSharedAsync makes the logger asynchronous using a dispatcher shared with other loggers,
+// instead of a goroutine of its own. A goroutine started by WithAsync is stopped.
+// Passing nil makes the logger synchronous again.
+//
+// Parameters:
+//   - dispatcher: The dispatcher writing the logs
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	dispatcher := logger.NewAsyncDispatcher(1000)
+//	defer dispatcher.Close()
+//
+//	for _, name := range []string{"api", "db", "cache"} {
+//	    logger.NewLogger(name).WithSharedAsync(dispatcher)
+//	}
+func (logger *Logger) WithSharedAsync(dispatcher *AsyncDispatcher) *Logger {
+	logger.update(func(options *Config) {
+		if options.stopAsync != nil {
+			options.stopAsync()
+		}
+		options.logs, options.errors = nil, nil
+		options.cancelAsync, options.stopAsync = nil, nil
+
+		options.dispatcher = dispatcher
+		options.Async = dispatcher != nil
+	})
+
+	return logger
+}
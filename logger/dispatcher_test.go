@@ -0,0 +1,149 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestAsyncDispatcher_SharedByLoggers tests that several loggers write through one dispatcher
+// in the order their logs were queued.
+func TestAsyncDispatcher_SharedByLoggers(t *testing.T) {
+	var out, errs bytes.Buffer
+	dispatcher := NewAsyncDispatcher(100)
+
+	loggers := make([]*Logger, 3)
+	for i := range loggers {
+		loggers[i] = NewLogger(fmt.Sprintf("shared-%d", i)).OutputTo(&out).ErrorsTo(&errs).WithSharedAsync(dispatcher)
+		if !loggers[i].config().Async {
+			t.Fatal("Logger should be asynchronous")
+		}
+	}
+
+	for round := 0; round < 10; round++ {
+		for i, logger := range loggers {
+			logger.Infof("round %d logger %d", round, i)
+		}
+	}
+	loggers[1].Errorf("failure")
+	loggers[2].InfoObjectf("object").AssignInt("round", 10).Build()
+
+	dispatcher.Close()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 31 {
+		t.Fatalf("Expected 31 lines, got %d:\n%s", len(lines), out.String())
+	}
+	for round := 0; round < 10; round++ {
+		for i := range loggers {
+			expected := fmt.Sprintf("INFO [shared-%d]: round %d logger %d", i, round, i)
+			if line := lines[round*3+i]; line != expected {
+				t.Fatalf("Expected %q, got %q", expected, line)
+			}
+		}
+	}
+	if !strings.Contains(lines[30], `"round":10`) {
+		t.Errorf("Expected object log, got %q", lines[30])
+	}
+	if errs.String() != "ERROR [shared-1]: failure\n" {
+		t.Errorf("Expected error on the error stream, got %q", errs.String())
+	}
+}
+
+// TestAsyncDispatcher_BoundedGoroutines tests that attaching loggers doesn't start goroutines.
+func TestAsyncDispatcher_BoundedGoroutines(t *testing.T) {
+	dispatcher := NewAsyncDispatcher(10)
+	defer dispatcher.Close()
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 50; i++ {
+		NewLogger(fmt.Sprintf("bounded-%d", i)).OutputTo(&bytes.Buffer{}).WithSharedAsync(dispatcher)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("Expected no new goroutines, got %d more", after-before)
+	}
+}
+
+// TestAsyncDispatcher_Concurrent tests that concurrent loggers lose no logs.
+func TestAsyncDispatcher_Concurrent(t *testing.T) {
+	var out bytes.Buffer
+	dispatcher := NewAsyncDispatcher(16)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		logger := NewLogger(fmt.Sprintf("concurrent-%d", g)).OutputTo(&out).WithSharedAsync(dispatcher)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				logger.Infof("message %d", i)
+			}
+		}()
+	}
+	wg.Wait()
+	dispatcher.Close()
+
+	if lines := strings.Count(out.String(), "\n"); lines != 800 {
+		t.Errorf("Expected 800 lines, got %d", lines)
+	}
+}
+
+// TestAsyncDispatcher_AfterClose tests that logs sent after Close are written synchronously.
+func TestAsyncDispatcher_AfterClose(t *testing.T) {
+	var out bytes.Buffer
+	dispatcher := NewAsyncDispatcher(1)
+	logger := NewLogger("").OutputTo(&out).WithSharedAsync(dispatcher)
+
+	dispatcher.Close()
+	dispatcher.Close()
+
+	logger.Infof("late")
+	if out.String() != "INFO: late\n" {
+		t.Errorf("Expected synchronous write after Close, got %q", out.String())
+	}
+}
+
+// TestAsyncDispatcher_ContextCancelled tests that a cancelled context abandons a blocked send.
+func TestAsyncDispatcher_ContextCancelled(t *testing.T) {
+	var out bytes.Buffer
+	// an unbuffered queue without a reader blocks every send
+	dispatcher := &AsyncDispatcher{
+		messages: make(chan asyncMessage),
+		closing:  make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	logger := NewLogger("").OutputTo(&out).WithSharedAsync(dispatcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	logger.InfoContext(ctx, "abandoned")
+
+	if out.Len() != 0 {
+		t.Errorf("Expected abandoned log, got %q", out.String())
+	}
+}
+
+// TestWithSharedAsync_Detach tests that passing nil makes the logger synchronous again.
+func TestWithSharedAsync_Detach(t *testing.T) {
+	var out bytes.Buffer
+	dispatcher := NewAsyncDispatcher(10)
+	defer dispatcher.Close()
+
+	logger, _ := NewLogger("").OutputTo(&out).WithAsync(true, 10)
+	logger.WithSharedAsync(dispatcher).WithSharedAsync(nil)
+
+	if logger.config().Async {
+		t.Fatal("Logger should be synchronous")
+	}
+
+	logger.Infof("sync")
+	if out.String() != "INFO: sync\n" {
+		t.Errorf("Expected synchronous write, got %q", out.String())
+	}
+}
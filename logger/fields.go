@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// WithFields adds base fields emitted with every JSON and object log of this logger,
+// e.g. the service name or version. Fields accumulate across calls, and a field set again replaces
+// the previous value. The fields are encoded once, so object logs stay free of allocations.
+//
+// Base fields are written first in the object of JSON and object logs, followed by the fields of
+// the call; a per-call field with the same name comes later and wins with most JSON parsers.
+// JSON logs whose object doesn't encode to a JSON object (e.g. a slice) are written without base fields.
+// Values that can't be encoded as JSON are written as their fmt.Sprint representation.
+//
+// Parameters:
+//   - fields: The base fields by name
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithFields(map[string]any{"service": "billing", "version": 3})
+//	logger.InfoObjectf("request").AssignInt("status", 200).Build()
+//	// Output: {"level":"INFO","source":"api","message":"request","object":{"service":"billing","version":3,"status":200}}
+func (logger *Logger) WithFields(fields map[string]any) *Logger {
+	if len(fields) == 0 {
+		return logger
+	}
+
+	// a new map keeps the fields of loggers derived by Named independent
+	merged := make(map[string]any, len(logger.fields)+len(fields))
+	maps.Copy(merged, logger.fields)
+	maps.Copy(merged, fields)
+
+	logger.fields = merged
+	logger.encodedFields = encodeFields(merged)
+	return logger
+}
+
+// encodeFields encodes fields as the members of a JSON object without the surrounding braces,
+// sorted by name so the output is deterministic.
+//
+// Parameters:
+//   - fields: The fields by name
+//
+// Returns:
+//   - The encoded members (e.g. "a":1,"b":"x")
+func encodeFields(fields map[string]any) []byte {
+	var encoded []byte
+
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		value, err := json.Marshal(fields[name])
+		if err != nil {
+			value, _ = json.Marshal(fmt.Sprint(fields[name]))
+		}
+
+		key, _ := json.Marshal(name)
+		if len(encoded) > 0 {
+			encoded = append(encoded, ',')
+		}
+		encoded = append(encoded, key...)
+		encoded = append(encoded, ':')
+		encoded = append(encoded, value...)
+	}
+
+	return encoded
+}
+
+// withFields merges encoded base fields into the JSON encoding of a log object.
+//
+// Parameters:
+//   - fields: The encoded base fields (see encodeFields)
+//   - object: The JSON encoding of the log object, or nil if there is none
+//
+// Returns:
+//   - The object with the base fields first, or object unchanged if it isn't a JSON object
+func withFields(fields, object []byte) []byte {
+	if object == nil || bytes.Equal(object, []byte("null")) {
+		return append(append([]byte{'{'}, fields...), '}')
+	}
+
+	if len(object) < 2 || object[0] != '{' {
+		return object
+	}
+
+	merged := append([]byte{'{'}, fields...)
+	if rest := object[1:]; rest[0] != '}' {
+		merged = append(merged, ',')
+	}
+	return append(merged, object[1:]...)
+}
@@ -0,0 +1,27 @@
+//go:build !race
+
+package logger
+
+import (
+	"io"
+	"testing"
+)
+
+// TestLogger_WithFields_ZeroAllocations tests that object logs allocate nothing with or without base fields.
+func TestLogger_WithFields_ZeroAllocations(t *testing.T) {
+	for _, logger := range []*Logger{
+		NewLogger("plain").OutputTo(io.Discard),
+		NewLogger("fields").OutputTo(io.Discard).WithFields(map[string]any{"service": "billing"}),
+	} {
+		// warm up the pool
+		logger.InfoObjectf("warmup").AssignInt("status", 200).Build()
+
+		allocations := testing.AllocsPerRun(100, func() {
+			logger.InfoObjectf("request").AssignInt("status", 200).Build()
+		})
+
+		if allocations != 0 {
+			t.Errorf("Expected 0 allocations for %s, got %v", logger.name, allocations)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// TestLogger_WithFields_Object tests that object logs carry the base fields before the per-call fields.
+func TestLogger_WithFields_Object(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("api").OutputTo(&buf).WithFields(map[string]any{"service": "billing", "version": 3})
+
+	logger.InfoObjectf("request").AssignInt("status", 200).AssignString("path", "/pay").Build()
+
+	expected := `{"level":"INFO","source":"api","message":"request","object":{"service":"billing","version":3,"status":200,"path":"/pay"}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	logger.InfoObjectf("empty").Build()
+	if !json.Valid(buf.Bytes()) || !bytes.Contains(buf.Bytes(), []byte(`"object":{"service":"billing","version":3}}`)) {
+		t.Errorf("Expected only the base fields, got %s", buf.String())
+	}
+
+	buf.Reset()
+	logger.InfoObjectf("nested").NestedStart("user").AssignInt("id", 7).NestedEnd().Build()
+	if !json.Valid(buf.Bytes()) || !bytes.Contains(buf.Bytes(), []byte(`"version":3,"user":{"id":7}`)) {
+		t.Errorf("Expected the nested object after the base fields, got %s", buf.String())
+	}
+}
+
+// TestLogger_WithFields_JSON tests that the base fields are merged into the objects of JSON logs.
+func TestLogger_WithFields_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).WithFields(map[string]any{"service": "billing"})
+
+	tests := []struct {
+		name     string
+		object   any
+		expected string
+	}{
+		{"Map", map[string]any{"status": 200}, `{"service":"billing","status":200}`},
+		{"Struct", struct {
+			Status int `json:"status"`
+		}{200}, `{"service":"billing","status":200}`},
+		{"Empty", map[string]any{}, `{"service":"billing"}`},
+		{"Nil", nil, `{"service":"billing"}`},
+		{"NotAnObject", []int{1, 2}, `[1,2]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf.Reset()
+			if err := logger.InfoJSONf(tt.object, "message"); err != nil {
+				t.Fatal(err)
+			}
+
+			var entry map[string]json.RawMessage
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+			}
+			if string(entry["object"]) != tt.expected {
+				t.Errorf("Expected object %s, got %s", tt.expected, entry["object"])
+			}
+		})
+	}
+}
+
+// TestLogger_WithFields_Merge tests that fields accumulate, later values replace earlier ones,
+// and derived loggers keep their own fields.
+func TestLogger_WithFields_Merge(t *testing.T) {
+	var buf bytes.Buffer
+	parent := NewLogger("api").OutputTo(&buf).
+		WithFields(map[string]any{"service": "billing", "region": "eu"}).
+		WithFields(map[string]any{"region": "us", "bad": func() {}})
+
+	child := parent.Named("db").WithFields(map[string]any{"table": "invoices"})
+
+	parent.InfoObjectf("parent").Build()
+	child.InfoObjectf("child").Build()
+
+	var entries []map[string]any
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var entry map[string]any
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry["object"].(map[string]any))
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0]["region"] != "us" || entries[0]["table"] != nil {
+		t.Errorf("Expected the parent fields to be unaffected by the child, got %v", entries[0])
+	}
+	if bad, ok := entries[0]["bad"].(string); !ok || len(bad) == 0 {
+		t.Errorf("Expected an unencodable value to be written as a string, got %v", entries[0]["bad"])
+	}
+	if entries[1]["service"] != "billing" || entries[1]["table"] != "invoices" {
+		t.Errorf("Expected the child to inherit and extend the fields, got %v", entries[1])
+	}
+}
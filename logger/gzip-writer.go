@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultGzipFlushInterval is the flush interval of a GzipWriter created with a non-positive interval.
+const defaultGzipFlushInterval = time.Second
+
+// GzipWriter is an io.WriteCloser that gzip-compresses everything written to it into an underlying writer.
+// Compressed data is buffered and flushed to the underlying writer periodically, so logs reach storage
+// even if the process crashes before Close, as a stream readable up to the last flush.
+//
+// The flush interval trades latency and compression for durability: every flush ends a compressed block,
+// so frequent flushes lose less data on a crash but compress worse and write more often.
+// Logs written since the last flush are lost on a crash.
+//
+// The writer is safe for concurrent use and can be passed to Logger.OutputTo, Logger.ErrorsTo and
+// Logger.RouteLevel. Logger.Flush and Logger.Close flush and close it.
+//
+// Example usage:
+//
+//	file, err := os.Create("app.log.gz")
+//	if err != nil {
+//	    panic(err)
+//	}
+//
+//	writer := logger.NewGzipWriter(file, 5*time.Second)
+//	log := logger.NewLogger("app").OutputTo(writer)
+//	defer log.Close() // writes the gzip footer and closes the file
+type GzipWriter struct {
+	// target receives the compressed data
+	target io.Writer
+	// compressor compresses the written data into target
+	compressor *gzip.Writer
+	// pending is set when data was written since the last flush
+	pending bool
+	// closed is set by Close, after which writes fail
+	closed bool
+	// mutex guards the compressor and the flags
+	mutex sync.Mutex
+	// stop is closed by Close to stop the flushing goroutine
+	stop chan struct{}
+	// stopped is closed by the flushing goroutine once it has exited
+	stopped chan struct{}
+}
+
+// NewGzipWriter creates a writer compressing into target and starts a goroutine
+// flushing the compressed data every interval while there are unflushed writes.
+//
+// Parameters:
+//   - target: The writer receiving the compressed data, e.g. a file
+//   - interval: The time between flushes (0 or less uses one second)
+//
+// Returns:
+//   - A pointer to the GzipWriter; call Close to stop it and write the gzip footer
+//
+// Example:
+//
+//	writer := logger.NewGzipWriter(file, time.Second)
+func NewGzipWriter(target io.Writer, interval time.Duration) *GzipWriter {
+	if interval <= 0 {
+		interval = defaultGzipFlushInterval
+	}
+
+	writer := &GzipWriter{
+		target:     target,
+		compressor: gzip.NewWriter(target),
+		stop:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+
+	go writer.run(interval)
+
+	return writer
+}
+
+// run flushes the writer every interval until Close is called.
+//
+// Parameters:
+//   - interval: The time between flushes
+func (writer *GzipWriter) run(interval time.Duration) {
+	defer close(writer.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = writer.Flush()
+		case <-writer.stop:
+			return
+		}
+	}
+}
+
+// Write compresses data into the underlying writer. It implements io.Writer.
+// The compressed data may be buffered until the next flush.
+//
+// Parameters:
+//   - data: The bytes to write
+//
+// Returns:
+//   - The number of bytes written
+//   - Error if the writer is closed or compression fails
+func (writer *GzipWriter) Write(data []byte) (int, error) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return 0, os.ErrClosed
+	}
+
+	writer.pending = true
+	return writer.compressor.Write(data)
+}
+
+// Flush writes all buffered compressed data to the underlying writer.
+// It does nothing if nothing was written since the last flush.
+//
+// Returns:
+//   - Error if the writer is closed or the underlying write fails
+func (writer *GzipWriter) Flush() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	if !writer.pending {
+		return nil
+	}
+
+	writer.pending = false
+	return writer.compressor.Flush()
+}
+
+// Sync flushes the writer like Flush and commits the underlying writer to stable storage
+// if it implements Sync() error, like *os.File.
+//
+// Returns:
+//   - Error if the writer is closed, or the flush or the sync fails
+func (writer *GzipWriter) Sync() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	writer.pending = false
+	if err := writer.compressor.Flush(); err != nil {
+		return err
+	}
+
+	if synced, ok := writer.target.(syncer); ok {
+		return synced.Sync()
+	}
+	return nil
+}
+
+// Close stops the periodic flushes, writes the remaining data and the gzip footer,
+// and closes the underlying writer if it implements io.Closer (except os.Stdout and os.Stderr).
+// It implements io.Closer.
+//
+// Returns:
+//   - Error if the writer is already closed, or finishing the stream or closing the underlying writer fails
+func (writer *GzipWriter) Close() error {
+	writer.mutex.Lock()
+	if writer.closed {
+		writer.mutex.Unlock()
+		return os.ErrClosed
+	}
+
+	writer.closed = true
+	err := writer.compressor.Close()
+	writer.mutex.Unlock()
+
+	close(writer.stop)
+	<-writer.stopped
+
+	if closer, ok := writer.target.(io.Closer); ok && writer.target != os.Stdout && writer.target != os.Stderr {
+		err = errors.Join(err, closer.Close())
+	}
+
+	return err
+}
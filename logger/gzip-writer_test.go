@@ -0,0 +1,157 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lockedBuffer is a bytes.Buffer safe for concurrent use, written by the flushing goroutine of a GzipWriter.
+type lockedBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (buffer *lockedBuffer) Write(data []byte) (int, error) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	return buffer.buffer.Write(data)
+}
+
+func (buffer *lockedBuffer) Bytes() []byte {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	return bytes.Clone(buffer.buffer.Bytes())
+}
+
+// decompress reads the gzip stream in data up to its end or, for an unfinished stream, up to the last flush.
+func decompress(t *testing.T, data []byte) string {
+	t.Helper()
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Invalid gzip stream: %v", err)
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Failed to decompress: %v", err)
+	}
+	return string(content)
+}
+
+// TestGzipWriter_WithLogger tests that logs written through a GzipWriter decompress back to the original lines
+// and that Logger.Close finishes the stream and closes the file.
+func TestGzipWriter_WithLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log.gz")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writer := NewGzipWriter(file, time.Hour)
+	logger := NewLogger("gzip").OutputTo(writer).ErrorsTo(writer)
+
+	var expected strings.Builder
+	for i := 0; i < 100; i++ {
+		logger.Infof("message %d", i)
+		fmt.Fprintf(&expected, "INFO [gzip]: message %d\n", i)
+	}
+	logger.Errorf("failure")
+	expected.WriteString("ERROR [gzip]: failure\n")
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Expected a complete gzip stream, got %v", err)
+	}
+	if string(content) != expected.String() {
+		t.Errorf("Expected the decompressed logs to match, got %q", string(content))
+	}
+
+	if _, err := writer.Write([]byte("closed")); err != os.ErrClosed {
+		t.Errorf("Expected os.ErrClosed after Close, got %v", err)
+	}
+	if _, err := file.Write([]byte("closed")); err == nil {
+		t.Error("Expected the file to be closed")
+	}
+}
+
+// TestGzipWriter_Flush tests that flushed logs can be read back before the stream is closed.
+func TestGzipWriter_Flush(t *testing.T) {
+	var buffer lockedBuffer
+	writer := NewGzipWriter(&buffer, time.Hour)
+	defer writer.Close()
+
+	logger := NewLogger("").OutputTo(writer)
+	logger.Infof("first")
+
+	if err := logger.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if content := decompress(t, buffer.Bytes()); content != "INFO: first\n" {
+		t.Errorf("Expected the flushed log, got %q", content)
+	}
+}
+
+// TestGzipWriter_PeriodicFlush tests that written logs reach the underlying writer without an explicit flush.
+func TestGzipWriter_PeriodicFlush(t *testing.T) {
+	var buffer lockedBuffer
+	writer := NewGzipWriter(&buffer, 5*time.Millisecond)
+	defer writer.Close()
+
+	NewLogger("").OutputTo(writer).Infof("periodic")
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if data := buffer.Bytes(); len(data) > 0 && decompress(t, data) == "INFO: periodic\n" {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Error("Expected the log to be flushed periodically")
+}
+
+// TestLogger_Close_Buffered tests that Logger.Close flushes buffered outputs and leaves standard streams open.
+func TestLogger_Close_Buffered(t *testing.T) {
+	var buffer bytes.Buffer
+	buffered := bufio.NewWriter(&buffer)
+	logger := NewLogger("").OutputTo(buffered).ErrorsTo(os.Stderr)
+
+	logger.Infof("buffered")
+	if buffer.Len() != 0 {
+		t.Fatalf("Expected the log to be buffered, got %q", buffer.String())
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buffer.String() != "INFO: buffered\n" {
+		t.Errorf("Expected Close to flush the buffered output, got %q", buffer.String())
+	}
+	if _, err := os.Stderr.Stat(); err != nil {
+		t.Errorf("Expected os.Stderr to stay open, got %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// JSONArrayWriter is an io.WriteCloser that writes the JSON logs written to it as the elements of a single
+// JSON array, so a log file can be parsed as a whole instead of as newline-delimited JSON.
+// It writes "[" before the first log, a comma between logs and "]" on Close; the array is only valid
+// once the writer is closed. Every write must be a single JSON value, as written by the JSON and
+// object logs of a Logger; text logs would make the array invalid.
+//
+// The writer is safe for concurrent use. Logger.WithJSONArray wraps the outputs of a logger with it,
+// and Logger.Close closes it after the logs queued by an asynchronous logger are written.
+//
+// Example usage:
+//
+//	file, err := os.Create("app.json")
+//	if err != nil {
+//	    panic(err)
+//	}
+//
+//	log := logger.NewLogger("app").OutputTo(logger.NewJSONArrayWriter(file))
+//	defer log.Close() // writes "]" and closes the file
+//	log.InfoObjectf("started").AssignInt("port", 8080).Build()
+type JSONArrayWriter struct {
+	// target receives the array
+	target io.Writer
+	// started is set once the opening bracket has been written
+	started bool
+	// closed is set by Close, after which writes fail
+	closed bool
+	// mutex guards target and the flags
+	mutex sync.Mutex
+}
+
+// NewJSONArrayWriter creates a writer writing the logs written to it as a JSON array into target.
+//
+// Parameters:
+//   - target: The writer receiving the array, e.g. a file
+//
+// Returns:
+//   - A pointer to the JSONArrayWriter; call Close to terminate the array
+//
+// Example:
+//
+//	writer := logger.NewJSONArrayWriter(file)
+func NewJSONArrayWriter(target io.Writer) *JSONArrayWriter {
+	return &JSONArrayWriter{target: target}
+}
+
+// Write writes data as the next element of the array. It implements io.Writer.
+// A trailing newline is trimmed, the elements are separated by a comma and a newline.
+//
+// Parameters:
+//   - data: A single JSON value
+//
+// Returns:
+//   - The length of data on success
+//   - Error if the writer is closed or the underlying write fails
+func (writer *JSONArrayWriter) Write(data []byte) (int, error) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return 0, os.ErrClosed
+	}
+
+	element := bytes.TrimSuffix(data, []byte{ln})
+	if len(bytes.TrimSpace(element)) == 0 {
+		return len(data), nil
+	}
+
+	separator := []byte{',', ln}
+	if !writer.started {
+		separator = []byte{'[', ln}
+	}
+
+	payload := make([]byte, 0, len(separator)+len(element))
+	payload = append(append(payload, separator...), element...)
+
+	if _, err := writer.target.Write(payload); err != nil {
+		return 0, err
+	}
+
+	writer.started = true
+	return len(data), nil
+}
+
+// Flush flushes the underlying writer if it buffers data. The array is not terminated,
+// since logs written afterwards are appended to it.
+//
+// Returns:
+//   - Error if the writer is closed or the underlying flush fails
+func (writer *JSONArrayWriter) Flush() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	if buffered, ok := writer.target.(flusher); ok {
+		return buffered.Flush()
+	}
+	return nil
+}
+
+// Sync commits the underlying writer to stable storage if it implements Sync() error, like *os.File,
+// otherwise flushes it like Flush. The array is not terminated.
+//
+// Returns:
+//   - Error if the writer is closed or the underlying sync fails
+func (writer *JSONArrayWriter) Sync() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	if synced, ok := writer.target.(syncer); ok {
+		return synced.Sync()
+	}
+	if buffered, ok := writer.target.(flusher); ok {
+		return buffered.Flush()
+	}
+	return nil
+}
+
+// Close terminates the array, writing "[]" if nothing was written, and closes the underlying writer
+// if it implements io.Closer (except os.Stdout and os.Stderr), otherwise flushes it if it buffers data.
+// It implements io.Closer.
+//
+// Returns:
+//   - Error if the writer is already closed, or terminating the array or closing the underlying writer fails
+func (writer *JSONArrayWriter) Close() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.closed {
+		return os.ErrClosed
+	}
+	writer.closed = true
+
+	terminator := []byte{ln, ']', ln}
+	if !writer.started {
+		terminator = []byte{'[', ']', ln}
+	}
+	_, err := writer.target.Write(terminator)
+
+	closer, closable := writer.target.(io.Closer)
+	if closable && writer.target != os.Stdout && writer.target != os.Stderr {
+		err = errors.Join(err, closer.Close())
+	} else if buffered, ok := writer.target.(flusher); ok {
+		err = errors.Join(err, buffered.Flush())
+	}
+
+	return err
+}
+
+// WithJSONArray wraps the outputs of the logger (the output and error streams and the writers routed
+// with RouteLevel) with JSONArrayWriter, so each output receives its logs as a single JSON array
+// instead of newline-delimited JSON. Outputs shared by several streams are wrapped once.
+// Call it after setting the outputs and before deriving loggers with Named, and Close the logger
+// to terminate the arrays. Only JSON and object logs should be written in this mode.
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	file, _ := os.Create("app.json")
+//	log := logger.NewLogger("app").OutputTo(file).ErrorsTo(file).WithJSONArray()
+//	defer log.Close()
+//
+//	log.InfoJSONf(map[string]any{"port": 8080}, "started")
+//	// app.json holds [{"level":"INFO","source":"app","message":"started","object":{"port":8080}}] once closed
+func (logger *Logger) WithJSONArray() *Logger {
+	var wrapped []*JSONArrayWriter
+
+	wrap := func(output io.Writer) io.Writer {
+		if output == nil {
+			return nil
+		}
+		if _, ok := output.(*JSONArrayWriter); ok {
+			return output
+		}
+		for _, writer := range wrapped {
+			if sameWriter(writer.target, output) {
+				return writer
+			}
+		}
+
+		writer := NewJSONArrayWriter(output)
+		wrapped = append(wrapped, writer)
+		return writer
+	}
+
+	logger.syncOut.Lock()
+	logger.out, logger.outTerminal = wrap(logger.out), false
+	logger.syncOut.Unlock()
+
+	logger.syncErr.Lock()
+	logger.err, logger.errTerminal = wrap(logger.err), false
+	logger.syncErr.Unlock()
+
+	logger.syncRoutes.Lock()
+	for level, route := range logger.routes {
+		logger.routes[level], logger.routeTerminals[level] = wrap(route), false
+	}
+	logger.syncRoutes.Unlock()
+
+	return logger
+}
@@ -0,0 +1,167 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLogger_WithJSONArray tests that a file written in JSON array mode parses as an array of the logged entries.
+func TestLogger_WithJSONArray(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.json")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := NewLogger("app").OutputTo(file).ErrorsTo(file).WithJSONArray()
+	if err := logger.InfoJSONf(map[string]any{"port": 8080}, "started"); err != nil {
+		t.Fatal(err)
+	}
+	logger.Named("db").WarningObjectf("slow query").AssignInt("ms", 250).Build()
+	if err := logger.ErrorJSONf(nil, "failed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []map[string]any
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("Expected a JSON array, got %v:\n%s", err, data)
+	}
+
+	expected := []struct {
+		level, source, message string
+	}{
+		{"INFO", "app", "started"},
+		{"WARNING", "app.db", "slow query"},
+		{"ERROR", "app", "failed"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d:\n%s", len(expected), len(entries), data)
+	}
+	for i, entry := range entries {
+		if entry["level"] != expected[i].level || entry["source"] != expected[i].source || entry["message"] != expected[i].message {
+			t.Errorf("Entry %d = %v, want %+v", i, entry, expected[i])
+		}
+	}
+	if port := entries[0]["object"].(map[string]any)["port"]; port != float64(8080) {
+		t.Errorf("Expected the object of the first entry, got %v", entries[0]["object"])
+	}
+}
+
+// TestLogger_WithJSONArray_Async tests that closing an asynchronous logger writes the queued entries before the closing bracket.
+func TestLogger_WithJSONArray_Async(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.json")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger, cancel := NewLogger("app").OutputTo(file).ErrorsTo(file).WithJSONArray().WithAsync(true, 500)
+	defer cancel()
+
+	for i := 0; i < 200; i++ {
+		if err := logger.InfoJSONf(map[string]int{"event": i}, "queued"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []map[string]any
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("Expected a JSON array, got %v:\n%s", err, data)
+	}
+	if len(entries) != 200 {
+		t.Errorf("Expected 200 entries, got %d", len(entries))
+	}
+}
+
+// TestJSONArrayWriter_Empty tests that closing a writer without logs writes an empty array.
+func TestJSONArrayWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewJSONArrayWriter(&buf)
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("Expected an empty array, got %q", buf.String())
+	}
+
+	if _, err := writer.Write([]byte("{}\n")); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("Expected os.ErrClosed after Close, got %v", err)
+	}
+	if err := writer.Close(); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("Expected os.ErrClosed on the second Close, got %v", err)
+	}
+}
+
+// TestJSONArrayWriter_Format tests the separators written between the entries.
+func TestJSONArrayWriter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(NewJSONArrayWriter(&buf))
+
+	logger.InfoObjectf("first").Build()
+	if buf.String() != "[\n{\"level\":\"INFO\",\"message\":\"first\",\"object\":{}}" {
+		t.Errorf("Expected the opening bracket before the first entry, got %q", buf.String())
+	}
+
+	logger.InfoObjectf("second").Build()
+	if err := logger.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[\n{\"level\":\"INFO\",\"message\":\"first\",\"object\":{}},\n{\"level\":\"INFO\",\"message\":\"second\",\"object\":{}}\n]\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// TestLogger_WithJSONArray_SharedOutput tests that an output shared by several streams is wrapped once.
+func TestLogger_WithJSONArray_SharedOutput(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).ErrorsTo(&buf).RouteLevel(DEBUG, &buf).WithJSONArray()
+
+	if logger.out != logger.err || logger.out != logger.routes[DEBUG] {
+		t.Fatal("Expected the streams to share a single JSONArrayWriter")
+	}
+	if logger.WithJSONArray().out != logger.err {
+		t.Error("Expected WithJSONArray not to wrap a JSONArrayWriter again")
+	}
+
+	logger.InfoObjectf("info").Build()
+	logger.ErrorObjectf("error").Build()
+	logger.DebugObjectf("debug").Build()
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil || len(entries) != 3 {
+		t.Errorf("Expected an array of 3 entries, got %v:\n%s", err, buf.String())
+	}
+}
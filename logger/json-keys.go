@@ -0,0 +1,215 @@
+package logger
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"github.com/0x626f/go-kit/json"
+)
+
+// JSONKeyConfig holds the field names used for the standard fields of JSON and object log output.
+// Empty fields fall back to the defaults: "source", "level", "timestamp", "message", and "object".
+type JSONKeyConfig struct {
+	// Source is the key of the logger name field
+	Source string
+	// Level is the key of the log Level field
+	Level string
+	// Timestamp is the key of the Timestamp field
+	Timestamp string
+	// Message is the key of the formatted message field
+	Message string
+	// Object is the key of the structured data field
+	Object string
+}
+
+// severityNumberKey is the field name of the OpenTelemetry severity number (see WithSeverityNumber).
+const severityNumberKey = "severity_number"
+
+// errorKey is the field name of the error attached with ErrorWithJSONf.
+const errorKey = "error"
+
+// traceIDField is the field name of the trace ID attached by the JSON and object *Context methods.
+const traceIDField = "trace_id"
+
+// errorField is the JSON representation of an error attached to a log.
+type errorField struct {
+	// Message is the message of the error
+	Message string `json:"message"`
+	// Chain holds the messages of the wrapped errors, outermost first (omitted if nothing is wrapped)
+	Chain []string `json:"chain,omitempty"`
+}
+
+// newErrorField describes an error and the errors it wraps.
+//
+// Parameters:
+//   - err: The error to describe
+//
+// Returns:
+//   - The error field, or nil if err is nil
+func newErrorField(err error) *errorField {
+	if err == nil {
+		return nil
+	}
+	return &errorField{Message: err.Error(), Chain: errorChain(err)}
+}
+
+// errorChain returns the messages of the errors wrapped by err, unwrapping one layer at a time
+// with errors.Unwrap. Errors joining several errors (errors.Join) end the chain.
+//
+// Parameters:
+//   - err: The outermost error
+//
+// Returns:
+//   - The messages of the wrapped errors, outermost first, or nil if err wraps nothing
+func errorChain(err error) []string {
+	var chain []string
+	for wrapped := errors.Unwrap(err); wrapped != nil; wrapped = errors.Unwrap(wrapped) {
+		chain = append(chain, wrapped.Error())
+	}
+	return chain
+}
+
+// defaultJSONKeys contains the field names used when no custom keys are configured.
+var defaultJSONKeys = JSONKeyConfig{
+	Source:    "source",
+	Level:     "level",
+	Timestamp: "timestamp",
+	Message:   "message",
+	Object:    "object",
+}
+
+// withDefaults returns a copy of the key configuration with empty keys replaced by their defaults.
+//
+// Returns:
+//   - The complete key configuration
+func (keys JSONKeyConfig) withDefaults() JSONKeyConfig {
+	if len(keys.Source) == 0 {
+		keys.Source = defaultJSONKeys.Source
+	}
+	if len(keys.Level) == 0 {
+		keys.Level = defaultJSONKeys.Level
+	}
+	if len(keys.Timestamp) == 0 {
+		keys.Timestamp = defaultJSONKeys.Timestamp
+	}
+	if len(keys.Message) == 0 {
+		keys.Message = defaultJSONKeys.Message
+	}
+	if len(keys.Object) == 0 {
+		keys.Object = defaultJSONKeys.Object
+	}
+	return keys
+}
+
+// WithJSONKeys renames the standard fields of JSON and object log output.
+// This is useful to match the conventions expected by log aggregators, e.g. "msg" or "@timestamp".
+// Keys left empty keep their default names.
+//
+// Parameters:
+//   - keys: The field names to use
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithJSONKeys(logger.JSONKeyConfig{
+//	    Timestamp: "@timestamp",
+//	    Message:   "msg",
+//	})
+//	logger.InfoJSONf(nil, "started")
+//	// Output: {"level":"INFO","@timestamp":"...","source":"api","msg":"started"}
+func (logger *Logger) WithJSONKeys(keys JSONKeyConfig) *Logger {
+	logger.jsonKeys = keys.withDefaults()
+	return logger
+}
+
+// keys returns the JSON field names configured for this logger.
+//
+// Returns:
+//   - The complete key configuration
+func (logger *Logger) keys() *JSONKeyConfig {
+	if len(logger.jsonKeys.Level) == 0 {
+		return &defaultJSONKeys
+	}
+	return &logger.jsonKeys
+}
+
+// marshal assembles the JSON representation of the log entry using the given field names.
+// Fields are written in the same order as object logs:
+// level, severity number, timestamp, source, message, trace ID, object, error, stack.
+// Empty fields are omitted.
+// The fixed fields are appended with the JSON encoder, only the object, error and stack go through encoding/json.
+//
+// Parameters:
+//   - keys: The field names to use
+//
+// Returns:
+//   - The JSON-encoded log entry without trailing newline
+//   - Error if marshaling the object fails
+func (log *jsonLog) marshal(keys *JSONKeyConfig) ([]byte, error) {
+	encoder := json.NewJSONEncoder()
+	encoder.AppendObjectStart()
+
+	appendKey := func(key string) *json.JSONEncoder {
+		if len(encoder.Data()) > 1 {
+			encoder.AppendDelimiter()
+		}
+		return encoder.AppendKey(key)
+	}
+
+	if len(log.Level) > 0 {
+		appendKey(keys.Level).AppendString(log.Level)
+	}
+
+	if log.SeverityNumber > 0 {
+		appendKey(severityNumberKey).AppendInt(log.SeverityNumber)
+	}
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{keys.Timestamp, log.Timestamp},
+		{keys.Source, log.Source},
+		{keys.Message, log.Message},
+		{traceIDField, log.TraceID},
+	}
+
+	for _, field := range fields {
+		if len(field.value) > 0 {
+			appendKey(field.key).AppendEscapedBytes([]byte(field.value))
+		}
+	}
+
+	if log.Object != nil || len(log.fields) > 0 {
+		object, err := stdjson.Marshal(log.Object)
+		if err != nil {
+			return nil, err
+		}
+		if len(log.fields) > 0 {
+			object = withFields(log.fields, object)
+		}
+		if log.transform != nil {
+			object = transformFields(log.transform, object)
+		}
+		appendKey(keys.Object).AppendObject(object)
+	}
+
+	if log.Error != nil {
+		raw, err := stdjson.Marshal(log.Error)
+		if err != nil {
+			return nil, err
+		}
+		appendKey(errorKey).AppendObject(raw)
+	}
+
+	if len(log.Stack) > 0 {
+		raw, err := stdjson.Marshal(log.Stack)
+		if err != nil {
+			return nil, err
+		}
+		appendKey("stack").AppendObject(raw)
+	}
+
+	return encoder.AppendObjectEnd().Data(), nil
+}
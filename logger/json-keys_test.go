@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestLogger_JSONKeys_Defaults tests that JSON output uses lowercase default keys.
+func TestLogger_JSONKeys_Defaults(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithTimestamp()
+
+	_ = logger.InfoJSONf(map[string]int{"port": 8080}, "started")
+
+	output := buf.String()
+	for _, key := range []string{`"level":"INFO"`, `"timestamp":"`, `"source":"test"`, `"message":"started"`, `"object":{"port":8080}`} {
+		if !strings.Contains(output, key) {
+			t.Errorf("Expected %s in output, got: %s", key, output)
+		}
+	}
+}
+
+// TestLogger_WithJSONKeys tests that renamed keys appear in JSON output.
+func TestLogger_WithJSONKeys(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithTimestamp().WithJSONKeys(JSONKeyConfig{
+		Source:    "logger",
+		Level:     "severity",
+		Timestamp: "@timestamp",
+		Message:   "msg",
+		Object:    "data",
+	})
+
+	_ = logger.WarningJSONf(map[string]string{"user": "alice"}, "slow request")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+	}
+
+	expected := map[string]any{"logger": "test", "severity": "WARNING", "msg": "slow request"}
+	for key, value := range expected {
+		if entry[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, entry[key])
+		}
+	}
+	if _, exists := entry["@timestamp"]; !exists {
+		t.Error("Expected renamed timestamp key")
+	}
+	if data, ok := entry["data"].(map[string]any); !ok || data["user"] != "alice" {
+		t.Errorf("Expected renamed object key, got %v", entry)
+	}
+	for _, key := range []string{"source", "level", "timestamp", "message", "object"} {
+		if _, exists := entry[key]; exists {
+			t.Errorf("Expected default key %q to be replaced", key)
+		}
+	}
+}
+
+// TestLogger_WithJSONKeys_Partial tests that empty keys keep their defaults.
+func TestLogger_WithJSONKeys_Partial(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithJSONKeys(JSONKeyConfig{Message: "msg"})
+
+	_ = logger.InfoJSONf(nil, "partial")
+
+	output := buf.String()
+	if !strings.Contains(output, `"msg":"partial"`) || !strings.Contains(output, `"level":"INFO"`) {
+		t.Errorf("Expected renamed message and default level keys, got: %s", output)
+	}
+}
+
+// TestLogger_WithJSONKeys_Object tests that renamed keys apply to object logs.
+func TestLogger_WithJSONKeys_Object(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithJSONKeys(JSONKeyConfig{
+		Level:   "severity",
+		Message: "msg",
+		Object:  "fields",
+	})
+
+	logger.InfoObjectf("request").AssignInt("status", 200).Build()
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+	}
+	if entry["severity"] != "INFO" || entry["msg"] != "request" || entry["source"] != "test" {
+		t.Errorf("Expected renamed keys in object log, got %v", entry)
+	}
+	if fields, ok := entry["fields"].(map[string]any); !ok || fields["status"] != float64(200) {
+		t.Errorf("Expected renamed object key, got %v", entry)
+	}
+}
+
+// TestLogger_JSONf_EscapedMessage tests that messages with quotes and control characters stay valid JSON.
+func TestLogger_JSONf_EscapedMessage(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+
+	_ = logger.InfoJSONf(nil, "say %q\n\tdone", "hi")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+	}
+	if entry["message"] != "say \"hi\"\n\tdone" {
+		t.Errorf("Expected escaped message to round-trip, got %q", entry["message"])
+	}
+	if _, exists := entry["object"]; exists {
+		t.Errorf("Expected no object for a nil value, got %v", entry)
+	}
+}
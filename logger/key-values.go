@@ -0,0 +1,190 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BadKey is the key given to a value of a key/value list that has no key: the last value
+// of an odd-length list, or a value found where a string key was expected.
+const BadKey = "!BADKEY"
+
+// encodeKeyValues encodes alternating keys and values as a JSON object, keeping the pairs in order.
+// A value without a string key is stored under BadKey, and consumes a single element of the list.
+// Errors are written as their message, and values that can't be encoded as JSON as their fmt.Sprint representation.
+//
+// Parameters:
+//   - kv: Alternating keys and values, e.g. "user", "alice", "attempts", 3
+//
+// Returns:
+//   - The encoded object (e.g. {"user":"alice","attempts":3})
+func encodeKeyValues(kv []any) json.RawMessage {
+	encoded := []byte{'{'}
+
+	for len(kv) > 0 {
+		key, ok := kv[0].(string)
+		var value any
+
+		if ok && len(kv) > 1 {
+			value, kv = kv[1], kv[2:]
+		} else {
+			key, value, kv = BadKey, kv[0], kv[1:]
+		}
+
+		if err, isError := value.(error); isError && err != nil {
+			value = err.Error()
+		}
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			data, _ = json.Marshal(fmt.Sprint(value))
+		}
+
+		name, _ := json.Marshal(key)
+		if len(encoded) > 1 {
+			encoded = append(encoded, ',')
+		}
+		encoded = append(encoded, name...)
+		encoded = append(encoded, ':')
+		encoded = append(encoded, data...)
+	}
+
+	return append(encoded, '}')
+}
+
+// writeKeyValues writes a JSON log whose object holds the given key/value pairs.
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//   - level: The log Level
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+func (logger *Logger) writeKeyValues(options *Config, level LogLevel, msg string, kv []any) {
+	data, err := logger.formatJSONMessage(options, level, encodeKeyValues(kv), "%s", msg)
+	if err != nil {
+		return
+	}
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, level, data)
+		return
+	}
+
+	stream, mutex := logger.stream(level)
+	if stream == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	_, _ = stream.Write(data)
+}
+
+// Logw logs a message with alternating keys and values at no specific Level.
+// The pairs are written in order as the fields of the object of a JSON log, after the base fields
+// set with WithFields. This is a shorthand for the object builder for simple cases.
+//
+// Keys must be strings. A value without a key (the last value of an odd-length list, or a value found
+// where a key was expected) is written under BadKey instead of being dropped.
+//
+// Parameters:
+//   - msg: The message, written as-is (not a format string)
+//   - kv: Alternating keys and values
+//
+// Example:
+//
+//	logger.Logw("cache warmed", "entries", 1024, "took", "1.2s")
+//	// Output: {"message":"cache warmed","object":{"entries":1024,"took":"1.2s"}}
+func (logger *Logger) Logw(msg string, kv ...any) {
+	options := logger.enabled(NONE)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, NONE, msg, kv)
+}
+
+// Tracew logs a message with alternating keys and values at TRACE Level (see Logw).
+//
+// Parameters:
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+func (logger *Logger) Tracew(msg string, kv ...any) {
+	options := logger.enabled(TRACE)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, TRACE, msg, kv)
+}
+
+// Debugw logs a message with alternating keys and values at DEBUG Level (see Logw).
+//
+// Parameters:
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+func (logger *Logger) Debugw(msg string, kv ...any) {
+	options := logger.enabled(DEBUG)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, DEBUG, msg, kv)
+}
+
+// Infow logs a message with alternating keys and values at INFO Level (see Logw).
+//
+// Parameters:
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+//
+// Example:
+//
+//	logger.Infow("user logged in", "user", "alice", "attempts", 2)
+//	// Output: {"level":"INFO","source":"api","message":"user logged in","object":{"user":"alice","attempts":2}}
+//
+//	logger.Infow("odd", "user", "alice", 42)
+//	// Output: {"level":"INFO","source":"api","message":"odd","object":{"user":"alice","!BADKEY":42}}
+func (logger *Logger) Infow(msg string, kv ...any) {
+	options := logger.enabled(INFO)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, INFO, msg, kv)
+}
+
+// Warningw logs a message with alternating keys and values at WARNING Level (see Logw).
+//
+// Parameters:
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+func (logger *Logger) Warningw(msg string, kv ...any) {
+	options := logger.enabled(WARNING)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, WARNING, msg, kv)
+}
+
+// Errorw logs a message with alternating keys and values at ERROR Level (see Logw).
+// These logs are written to the error stream (stderr by default).
+//
+// Parameters:
+//   - msg: The message, written as-is
+//   - kv: Alternating keys and values
+//
+// Example:
+//
+//	logger.Errorw("payment failed", "order", 42, "error", err)
+//	// Output: {"level":"ERROR","message":"payment failed","object":{"order":42,"error":"card declined"}}
+func (logger *Logger) Errorw(msg string, kv ...any) {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return
+	}
+
+	logger.writeKeyValues(options, ERROR, msg, kv)
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// TestLogger_Infow tests that key/value pairs are written in order as the fields of a JSON log.
+func TestLogger_Infow(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("api").OutputTo(&buf)
+
+	logger.Infow("user logged in 100%", "user", "alice", "attempts", 2, "admin", false)
+
+	expected := `{"level":"INFO","source":"api","message":"user logged in 100%","object":{"user":"alice","attempts":2,"admin":false}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// TestLogger_Infow_OddArguments tests that values without a key are written under BadKey.
+func TestLogger_Infow_OddArguments(t *testing.T) {
+	tests := []struct {
+		name   string
+		kv     []any
+		object string
+	}{
+		{"None", nil, `{}`},
+		{"LoneValue", []any{42}, `{"!BADKEY":42}`},
+		{"TrailingValue", []any{"user", "alice", 42}, `{"user":"alice","!BADKEY":42}`},
+		{"NonStringKey", []any{7, "user", "alice"}, `{"!BADKEY":7,"user":"alice"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			NewLogger("").OutputTo(&buf).Infow("event", tt.kv...)
+
+			var log struct {
+				Object json.RawMessage `json:"object"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+				t.Fatalf("Expected valid JSON, got %q: %v", buf.String(), err)
+			}
+			if string(log.Object) != tt.object {
+				t.Errorf("Expected object %s, got %s", tt.object, log.Object)
+			}
+		})
+	}
+}
+
+// TestLogger_Errorw tests that errors are written as their message to the error stream,
+// after the base fields, and that values that can't be encoded as JSON fall back to fmt.Sprint.
+func TestLogger_Errorw(t *testing.T) {
+	var out, errs bytes.Buffer
+	logger := NewLogger("").OutputTo(&out).ErrorsTo(&errs).WithFields(map[string]any{"service": "billing"})
+
+	logger.Errorw("payment failed", "error", errors.New("card declined"), "callback", func() {})
+
+	if out.Len() != 0 {
+		t.Errorf("Expected nothing on the output stream, got %q", out.String())
+	}
+
+	expected := `"object":{"service":"billing","error":"card declined","callback":"0x`
+	if !bytes.Contains(errs.Bytes(), []byte(expected)) || !json.Valid(errs.Bytes()) {
+		t.Errorf("Expected %s in a valid JSON log, got %s", expected, errs.String())
+	}
+}
+
+// TestLogger_Infow_Level tests that key/value logs below the logger level are discarded.
+func TestLogger_Infow_Level(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).WithLogLevel(WARNING)
+
+	logger.Infow("ignored", "key", "value")
+	logger.Debugw("ignored", "key", "value")
+	if buf.Len() != 0 {
+		t.Errorf("Expected logs below WARNING to be discarded, got %q", buf.String())
+	}
+
+	logger.Warningw("kept", "key", "value")
+	if !bytes.Contains(buf.Bytes(), []byte(`"level":"WARNING"`)) {
+		t.Errorf("Expected the WARNING log, got %q", buf.String())
+	}
+}
@@ -1,6 +1,6 @@
 // Package logger provides a high-performance structured logging system with support for:
 //   - Multiple log levels (ERROR, WARNING, INFO, DEBUG, TRACE)
-//   - Colored output (Unix/Linux only)
+//   - Colored output (Unix/Linux/macOS and Windows 10+ consoles)
 //   - Timestamping with customizable formats
 //   - JSON structured logging
 //   - Zero-allocation object logging using sync.Pool
@@ -36,13 +36,12 @@
 package logger
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -50,6 +49,9 @@ import (
 // It is initialized by calling WithLoggerRegistry() and accessed via GetLogger().
 var loggerRegistry map[string]*Logger
 
+// registryMutex guards loggerRegistry against concurrent lookups and registrations.
+var registryMutex sync.Mutex
+
 // GetLogger retrieves a logger instance from the registry by name.
 // Returns nil if the registry is not initialized (via WithLoggerRegistry)
 // or if no logger with the given name exists.
@@ -68,15 +70,69 @@ var loggerRegistry map[string]*Logger
 //	    logger.Infof("Using existing logger")
 //	}
 func GetLogger(name string) *Logger {
+	registryMutex.Lock()
 	if loggerRegistry != nil {
 		if logger, exists := loggerRegistry[name]; exists {
+			registryMutex.Unlock()
 			return logger
 		}
 	}
+	registryMutex.Unlock()
 
 	return NewLogger(name)
 }
 
+// ReconfigureLogger applies a new configuration to the registered logger with the given name.
+// Unlike NewLogger, which returns a registered instance unchanged and ignores the passed config,
+// this function replaces the options of the existing instance. The options are published atomically
+// and every log call reads them once, so each log line is written entirely with either the previous
+// or the new configuration, never a partially applied one.
+//
+// If the registry is not initialized or no logger with the given name exists,
+// a new logger is created with the config (and registered if the registry is enabled).
+//
+// Output writers are kept. If the previous configuration was asynchronous, its background
+// goroutine is stopped after writing the messages already queued.
+//
+// Parameters:
+//   - name: The name of the logger to reconfigure
+//   - config: The configuration to apply (nil applies the default configuration)
+//
+// Returns:
+//   - The reconfigured (or newly created) Logger instance
+//
+// Example:
+//
+//	logger.WithLoggerRegistry()
+//	api := logger.NewLogger("api", &logger.Config{Level: logger.INFO})
+//
+//	// Later, switch the registered instance to TRACE
+//	logger.ReconfigureLogger("api", &logger.Config{Level: logger.TRACE})
+//	api.Tracef("now visible")
+func ReconfigureLogger(name string, config *Config) *Logger {
+	registryMutex.Lock()
+	var logger *Logger
+	if loggerRegistry != nil {
+		logger = loggerRegistry[name]
+	}
+	registryMutex.Unlock()
+
+	if logger == nil {
+		if config == nil {
+			return NewLogger(name)
+		}
+		return NewLogger(name, config)
+	}
+
+	if config == nil {
+		config = defaultConfig
+	}
+
+	logger.configure(config)
+
+	return logger
+}
+
 // Logger is a structured logger with support for multiple output formats and log levels.
 // It provides thread-safe logging through mutex-protected output streams.
 //
@@ -88,25 +144,68 @@ type Logger struct {
 	name string
 	// out is the standard output stream for INFO, DEBUG, TRACE logs
 	out, err io.Writer
-	// syncOut and syncErr provide thread-safe access to output streams
-	syncOut, syncErr sync.Mutex
-	// options holds the logger configuration
-	options *Config
+	// syncOut and syncErr provide thread-safe access to output streams, shared with loggers derived by Named
+	syncOut, syncErr *sync.Mutex
+	// routes holds the writers of levels routed with RouteLevel, indexed by level (nil if not routed)
+	routes [NONE + 1]io.Writer
+	// outTerminal and errTerminal cache whether out and err are terminals, detected when they are set
+	outTerminal, errTerminal bool
+	// routeTerminals caches whether the routed writers are terminals, indexed like routes
+	routeTerminals [NONE + 1]bool
+	// syncRoutes provides thread-safe access to the routed writers
+	syncRoutes *sync.Mutex
+	// options holds the logger configuration; it is replaced as a whole and never modified once published
+	options atomic.Pointer[Config]
+	// syncOptions serializes the updates of options
+	syncOptions sync.Mutex
+	// sampling holds per-level sampling rates and counters (see WithSampling)
+	sampling sampling
+	// throttle holds the per-key emission times for rate-limited logging (see LogEveryf)
+	throttle throttle
+	// nop disables all write paths (see NewNopLogger)
+	nop bool
+	// jsonKeys holds the field names of JSON and object output (defaults if empty, see WithJSONKeys)
+	jsonKeys JSONKeyConfig
+	// traceIDKey is the context key the *Context methods read the trace ID from (TraceIDKey if nil)
+	traceIDKey any
+	// clock is the time source of timestamps and throttling (time.Now if nil, see WithClock)
+	clock func() time.Time
+	// fields holds the base fields of JSON and object logs (see WithFields)
+	fields map[string]any
+	// encodedFields holds fields encoded as the members of a JSON object
+	encodedFields []byte
+	// nameSeparator joins the name with the suffixes passed to Named ("." if empty, see WithNameSeparator)
+	nameSeparator string
+	// transform rewrites the fields of JSON and object logs (nil if not set, see WithFieldTransform)
+	transform FieldTransform
 }
 
 // jsonLog represents the structure of JSON-formatted log output.
 // It matches common structured logging standards for easy parsing by log aggregators.
+// The field names are configurable with WithJSONKeys; marshal writes the fields under the configured keys.
 type jsonLog struct {
 	// Source is the logger name (omitted if not set)
-	Source string `json:"source,omitempty"`
+	Source string
 	// Level is the log Level as a string (ERROR, WARNING, INFO, DEBUG, TRACE)
-	Level string `json:"Level,omitempty"`
+	Level string
+	// SeverityNumber is the OpenTelemetry severity number of the Level (omitted unless enabled)
+	SeverityNumber int
 	// Timestamp is the log Timestamp in the configured format (omitted if timestamping disabled)
-	Timestamp string `json:"Timestamp,omitempty"`
+	Timestamp string
 	// Message is the formatted log message
-	Message string `json:"message,omitempty"`
+	Message string
+	// TraceID is the trace ID read from the context by the *Context methods (omitted if there is none)
+	TraceID string
 	// Object contains structured data (can be any JSON-serializable value)
-	Object any `json:"object,omitempty"`
+	Object any
+	// Error describes the error attached with ErrorWithJSONf (omitted if there is none)
+	Error *errorField
+	// Stack contains the captured stack frames (omitted if stack traces are disabled)
+	Stack []string
+	// fields holds the encoded base fields merged into Object (see WithFields)
+	fields []byte
+	// transform rewrites the fields of Object (see WithFieldTransform)
+	transform FieldTransform
 }
 
 // NewLogger creates a new Logger instance with the specified name and default configuration.
@@ -116,7 +215,9 @@ type jsonLog struct {
 //   - Has no Timestamp, no Coloring, and operates synchronously
 //
 // If the logger registry is enabled (via WithLoggerRegistry), this function will:
-//   - Return the existing logger instance if one with the given name already exists
+//   - Return the existing logger instance if one with the given name already exists.
+//     The first config wins: a config passed for an existing name is ignored,
+//     use ReconfigureLogger to apply it to the registered instance
 //   - Create and register a new logger if no instance with this name exists
 //
 // Parameters:
@@ -137,6 +238,9 @@ type jsonLog struct {
 //	apiLogger := logger.NewLogger("api")
 //	sameLogger := logger.NewLogger("api") // Returns apiLogger
 func NewLogger(name string, config ...*Config) *Logger {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
 	if loggerRegistry != nil {
 		if logger, exists := loggerRegistry[name]; exists {
 			return logger
@@ -149,20 +253,17 @@ func NewLogger(name string, config ...*Config) *Logger {
 	}
 
 	logger := &Logger{
-		name: name,
-		out:  os.Stdout,
-		err:  os.Stderr,
-		options: &Config{
-			Level:           localConfig.Level,
-			Coloring:        localConfig.Coloring,
-			Timestamp:       localConfig.Timestamp,
-			TimestampFormat: localConfig.TimestampFormat,
-			Async:           localConfig.Async,
-			AsyncBuffer:     localConfig.AsyncBuffer,
-		},
+		name:        name,
+		out:         os.Stdout,
+		err:         os.Stderr,
+		outTerminal: isTerminal(os.Stdout),
+		errTerminal: isTerminal(os.Stderr),
+		syncOut:     &sync.Mutex{},
+		syncErr:     &sync.Mutex{},
+		syncRoutes:  &sync.Mutex{},
 	}
 
-	logger.WithAsync(logger.options.Async, logger.options.AsyncBuffer)
+	logger.configure(localConfig)
 
 	if loggerRegistry != nil {
 		loggerRegistry[name] = logger
@@ -171,17 +272,183 @@ func NewLogger(name string, config ...*Config) *Logger {
 	return logger
 }
 
+// NewNopLogger creates a logger that discards every message.
+// All write paths return before formatting, so logging through it allocates nothing,
+// including Logf and LogJSONf which otherwise log regardless of the log Level.
+// The *Objectf methods return a nil builder, which is safe to chain and Build().
+//
+// The nop logger is never registered in the logger registry.
+//
+// Returns:
+//   - A pointer to a Logger that produces no output
+//
+// Example:
+//
+//	type Client struct {
+//	    log *logger.Logger
+//	}
+//
+//	func NewClient(log *logger.Logger) *Client {
+//	    if log == nil {
+//	        log = logger.NewNopLogger()
+//	    }
+//	    return &Client{log: log}
+//	}
+func NewNopLogger() *Logger {
+	logger := &Logger{
+		out:        io.Discard,
+		err:        io.Discard,
+		syncOut:    &sync.Mutex{},
+		syncErr:    &sync.Mutex{},
+		syncRoutes: &sync.Mutex{},
+		nop:        true,
+	}
+
+	logger.options.Store(&Config{
+		Level:           NONE,
+		TimestampFormat: defaultConfig.TimestampFormat,
+	})
+
+	return logger
+}
+
+// enabled reports whether a message at the given level should be emitted, returning the options
+// to format it with. Log methods load the options once through enabled, so a message is formatted
+// with a single configuration even if the logger is reconfigured concurrently.
+// Messages are discarded by nop loggers, by log Level filtering, and by sampling.
+// Messages without a level (NONE) are only discarded by nop loggers.
+//
+// Parameters:
+//   - level: The log Level of the message
+//
+// Returns:
+//   - The current options if the message should be emitted, nil otherwise
+func (logger *Logger) enabled(level LogLevel) *Config {
+	if logger.nop {
+		return nil
+	}
+
+	options := logger.config()
+	if level != NONE && (options.Level < level || !logger.sampling.sample(level)) {
+		return nil
+	}
+
+	return options
+}
+
+// config returns the current options of the logger. The returned options must not be modified,
+// use update to change them.
+//
+// Returns:
+//   - The current options
+func (logger *Logger) config() *Config {
+	return logger.options.Load()
+}
+
+// update applies change to a copy of the options of the logger and publishes the copy,
+// so concurrent log calls see either the previous or the new options.
+//
+// Parameters:
+//   - change: The function modifying the copy, called once while updates are serialized
+func (logger *Logger) update(change func(options *Config)) {
+	logger.syncOptions.Lock()
+	defer logger.syncOptions.Unlock()
+
+	options := *logger.config()
+	change(&options)
+	logger.options.Store(&options)
+}
+
+// Enabled reports whether messages at the given level pass the log Level filtering of this logger,
+// so callers can skip building expensive log arguments. Sampling isn't applied, so a message
+// reported as enabled may still be dropped by WithSampling; nop loggers report every level as disabled.
+//
+// Parameters:
+//   - level: The log Level to check
+//
+// Returns:
+//   - true if messages at the level would be logged, false otherwise
+//
+// Example:
+//
+//	if logger.Enabled(logger.DEBUG) {
+//	    logger.Debugf("state: %s", state.Dump())
+//	}
+func (logger *Logger) Enabled(level LogLevel) bool {
+	if logger.nop {
+		return false
+	}
+
+	return level == NONE || logger.config().Level >= level
+}
+
+// IfLevel calls fn with this logger only if messages at the given level are enabled (see Enabled),
+// guarding costly serialization of log arguments.
+//
+// Parameters:
+//   - level: The log Level to check
+//   - fn: The function emitting the logs
+//
+// Example:
+//
+//	logger.IfLevel(logger.DEBUG, func(l *logger.Logger) {
+//	    l.Debugf("request: %s", dump(request))
+//	})
+func (logger *Logger) IfLevel(level LogLevel, fn func(*Logger)) {
+	if logger.Enabled(level) {
+		fn(logger)
+	}
+}
+
+// configure replaces the logger options with a copy of the given configuration,
+// starting the Async goroutine first if the configuration requires it and stopping
+// the goroutine of the previous options once they are replaced.
+//
+// Parameters:
+//   - config: The configuration to copy options from
+func (logger *Logger) configure(config *Config) {
+	options := &Config{
+		Level:           config.Level,
+		Coloring:        config.Coloring,
+		ForceColor:      config.ForceColor,
+		Timestamp:       config.Timestamp,
+		TimestampFormat: config.TimestampFormat,
+		UTC:             config.UTC,
+		Async:           config.Async,
+		AsyncBuffer:     config.AsyncBuffer,
+		StackTrace:      config.StackTrace,
+		StackTraceLevel: config.StackTraceLevel,
+		StackTraceDepth: config.StackTraceDepth,
+		SeverityNumber:  config.SeverityNumber,
+	}
+
+	if options.Async {
+		startAsync(options, options.AsyncBuffer)
+	}
+
+	logger.syncOptions.Lock()
+	previous := logger.options.Swap(options)
+	logger.syncOptions.Unlock()
+
+	if previous != nil && previous.stopAsync != nil {
+		previous.stopAsync()
+	}
+}
+
 // formatMessage formats a log message with optional Timestamp, Level, and logger name.
 // Returns the formatted message as a byte slice ready for writing to output.
 //
+// Coloring is applied only if the destination stream supports it (see shouldPaint).
+//
 // Parameters:
-//   - Level: The log Level (used for filtering and formatting)
+//   - options: The options loaded by the log call
+//   - Level: The log Level (used for filtering, formatting and finding the destination stream)
 //   - msg: The message format string
 //   - args: Optional format arguments for msg
 //
 // Returns:
 //   - Formatted message bytes with newline
-func (logger *Logger) formatMessage(level LogLevel, msg string, args ...any) []byte {
+func (logger *Logger) formatMessage(options *Config, level LogLevel, msg string, args ...any) []byte {
 	message := logger.format(msg, args...)
 	if level == NONE {
 		return append(message, ln)
@@ -189,8 +456,8 @@ func (logger *Logger) formatMessage(level LogLevel, msg string, args ...any) []b
 
 	var payload []byte
 
-	if logger.options.Timestamp {
-		timestamp := time.Now().Format(logger.options.TimestampFormat)
+	if options.Timestamp {
+		timestamp := logger.timestamp(options)
 		payload = append(payload, []byte(timestamp)...)
 	}
 
@@ -210,16 +477,40 @@ func (logger *Logger) formatMessage(level LogLevel, msg string, args ...any) []b
 	payload = append(payload, space)
 	payload = append(payload, message...)
 
-	if logger.options.Coloring {
+	for _, frame := range logger.captureStack(options, level) {
+		payload = append(payload, ln, '\t')
+		payload = append(payload, frame...)
+	}
+
+	if logger.shouldPaint(options, level) {
 		payload = level.paint(payload)
 	}
 
 	return append(payload, ln)
 }
 
+// shouldPaint reports whether ANSI color codes should be written to the stream of a log Level.
+// The decision is made per writer, so a logger writing logs to a terminal and errors
+// to a file colors only the terminal output. Whether a writer is a terminal is detected
+// once when it is set (see terminal), not on every log.
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//   - level: The log Level whose stream to check
+//
+// Returns:
+//   - true if Coloring is enabled and the stream is a terminal or coloring is forced
+func (logger *Logger) shouldPaint(options *Config, level LogLevel) bool {
+	if !options.Coloring {
+		return false
+	}
+	return options.ForceColor || logger.terminal(level)
+}
+
 // formatJSONMessage formats a log entry as JSON with message and structured object data.
 //
 // Parameters:
+//   - options: The options loaded by the log call
 //   - Level: The log Level
 //   - object: Structured data to include in the JSON output
 //   - msg: The message format string
@@ -228,30 +519,53 @@ func (logger *Logger) formatMessage(level LogLevel, msg string, args ...any) []b
 // Returns:
 //   - JSON-formatted log bytes with newline
 //   - Error if JSON marshaling fails
-func (logger *Logger) formatJSONMessage(level LogLevel, object any, msg string, args ...any) ([]byte, error) {
+func (logger *Logger) formatJSONMessage(options *Config, level LogLevel, object any, msg string, args ...any) ([]byte, error) {
+	return logger.formatJSONError(options, level, object, nil, "", msg, args...)
+}
+
+// formatJSONError formats a log entry as JSON like formatJSONMessage, with an error field
+// describing cause (see ErrorWithJSONf) and a trace ID field (see InfoJSONContext).
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//   - Level: The log Level
+//   - object: Structured data to include in the JSON output
+//   - cause: The error to attach, or nil for none
+//   - traceID: The trace ID to attach, or "" for none
+//   - msg: The message format string
+//   - args: Optional format arguments for msg
+//
+// Returns:
+//   - JSON-formatted log bytes with newline
+//   - Error if JSON marshaling fails
+func (logger *Logger) formatJSONError(options *Config, level LogLevel, object any, cause error, traceID string, msg string, args ...any) ([]byte, error) {
 	log := jsonLog{
-		Level:   level.String(),
-		Message: fmt.Sprintf(msg, args...),
-		Object:  object,
+		Level:     level.String(),
+		Message:   fmt.Sprintf(msg, args...),
+		TraceID:   traceID,
+		Object:    object,
+		Error:     newErrorField(cause),
+		fields:    logger.encodedFields,
+		transform: logger.transform,
 	}
 
-	if level == NONE {
-		raw, err := json.Marshal(log)
-		if err != nil {
-			return nil, err
+	if level != NONE {
+		if options.Timestamp {
+			log.Timestamp = logger.timestamp(options)
 		}
-		return append(raw, ln), nil
-	}
 
-	if logger.options.Timestamp {
-		log.Timestamp = time.Now().Format(logger.options.TimestampFormat)
-	}
+		if len(logger.name) > 0 {
+			log.Source = logger.name
+		}
 
-	if len(logger.name) > 0 {
-		log.Source = logger.name
+		if options.SeverityNumber {
+			log.SeverityNumber = level.SeverityNumber()
+		}
+
+		log.Stack = logger.captureStack(options, level)
 	}
 
-	raw, err := json.Marshal(log)
+	raw, err := log.marshal(logger.keys())
 	if err != nil {
 		return nil, err
 	}
@@ -277,20 +591,22 @@ func (logger *Logger) format(msg string, args ...any) []byte {
 // writeStringToStream writes a formatted text log message to the specified stream.
 //
 // Parameters:
+//   - options: The options loaded by the log call
 //   - stream: The output writer
 //   - Level: The log Level
 //   - msg: The message format string
 //   - args: Optional format arguments
-func (logger *Logger) writeStringToStream(stream io.Writer, level LogLevel, msg string, args ...any) {
+func (logger *Logger) writeStringToStream(options *Config, stream io.Writer, level LogLevel, msg string, args ...any) {
 	if stream == nil {
 		return
 	}
-	_, _ = stream.Write(logger.formatMessage(level, msg, args...))
+	_, _ = stream.Write(logger.formatMessage(options, level, msg, args...))
 }
 
 // writeJSONToStream writes a JSON-formatted log message to the specified stream.
 //
 // Parameters:
+//   - options: The options loaded by the log call
 //   - stream: The output writer
 //   - Level: The log Level
 //   - object: Structured data to include
@@ -299,12 +615,12 @@ func (logger *Logger) writeStringToStream(stream io.Writer, level LogLevel, msg
 //
 // Returns:
 //   - Error if stream is nil or JSON marshaling fails
-func (logger *Logger) writeJSONToStream(stream io.Writer, level LogLevel, object any, msg string, args ...any) error {
+func (logger *Logger) writeJSONToStream(options *Config, stream io.Writer, level LogLevel, object any, msg string, args ...any) error {
 	if stream == nil {
 		return errors.New("nil stream or object")
 	}
 
-	payload, err := logger.formatJSONMessage(level, object, msg, args...)
+	payload, err := logger.formatJSONMessage(options, level, object, msg, args...)
 
 	if err != nil {
 		return err
@@ -315,39 +631,107 @@ func (logger *Logger) writeJSONToStream(stream io.Writer, level LogLevel, object
 	return err
 }
 
-// writeByLevel writes data to the appropriate output stream based on log Level.
-// ERROR logs go to stderr, all others go to stdout.
+// writeByLevel writes data to the appropriate output stream based on log Level (see stream).
 // This method handles locking for thread safety.
 //
 // Parameters:
 //   - Level: The log Level (determines output stream)
 //   - data: The formatted log data to write
 func (logger *Logger) writeByLevel(level LogLevel, data []byte) {
+	stream, mutex := logger.stream(level)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	_, _ = stream.Write(data)
+}
+
+// stream returns the output stream of a log Level and the mutex guarding it:
+// the writer routed with RouteLevel if any, otherwise the error stream for ERROR logs
+// and the output stream for all others.
+//
+// Parameters:
+//   - Level: The log Level
+//
+// Returns:
+//   - The writer for logs of the Level
+//   - The mutex to hold while writing to it
+func (logger *Logger) stream(level LogLevel) (io.Writer, *sync.Mutex) {
+	if level <= NONE && logger.routes[level] != nil {
+		return logger.routes[level], logger.syncRoutes
+	}
+
 	if level == ERROR {
-		logger.syncErr.Lock()
-		defer logger.syncErr.Unlock()
-		_, _ = logger.err.Write(data)
-		return
+		return logger.err, logger.syncErr
+	}
+	return logger.out, logger.syncOut
+}
+
+// terminal reports whether the stream of a log Level, chosen like stream, is a terminal.
+// The result was cached when the writer was set by OutputTo, ErrorsTo or RouteLevel.
+//
+// Parameters:
+//   - Level: The log Level
+//
+// Returns:
+//   - true if the writer for logs of the Level is a terminal
+func (logger *Logger) terminal(level LogLevel) bool {
+	if level <= NONE && logger.routes[level] != nil {
+		return logger.routeTerminals[level]
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
-	_, _ = logger.out.Write(data)
+	if level == ERROR {
+		return logger.errTerminal
+	}
+	return logger.outTerminal
 }
 
-// sendToChannelByLevel sends log data to the appropriate Async channel based on log Level.
+// sendToChannelByLevel sends log data to the appropriate Async channel based on log Level,
+// or to the shared dispatcher if one is attached (see WithSharedAsync).
 // Used when Async logging is enabled.
 //
 // Parameters:
+//   - options: The options loaded by the log call
+//   - Level: The log Level (determines which channel to use)
+//   - data: The formatted log data to send
+func (logger *Logger) sendToChannelByLevel(options *Config, level LogLevel, data []byte) {
+	logger.sendToChannelUntil(options, level, data, nil)
+}
+
+// sendToChannelUntil sends log data like sendToChannelByLevel, abandoning the send
+// once done is closed. A nil done channel waits until the data is accepted.
+//
+// Parameters:
+//   - options: The options loaded by the log call
 //   - Level: The log Level (determines which channel to use)
 //   - data: The formatted log data to send
-func (logger *Logger) sendToChannelByLevel(level LogLevel, data []byte) {
+//   - done: A channel that cancels the send when closed
+func (logger *Logger) sendToChannelUntil(options *Config, level LogLevel, data []byte, done <-chan struct{}) {
+	if dispatcher := options.dispatcher; dispatcher != nil {
+		dispatcher.send(logger, level, data, done)
+		return
+	}
+
+	channel := options.logs
 	if level == ERROR {
-		logger.options.errors <- data
+		channel = options.errors
+	}
+
+	message := asyncMessage{logger: logger, level: level, data: data}
+
+	// the options may have been replaced and their goroutine stopped since they were loaded
+	select {
+	case <-options.cancelAsync:
+		message.write()
 		return
+	default:
 	}
 
-	logger.options.logs <- data
+	select {
+	case channel <- message:
+	case <-options.cancelAsync:
+		message.write()
+	case <-done:
+	}
 }
 
 // OutputTo sets the output stream for INFO, DEBUG, TRACE, and WARNING logs.
@@ -364,7 +748,7 @@ func (logger *Logger) sendToChannelByLevel(level LogLevel, data []byte) {
 //	logger := logger.NewLogger().OutputTo(file)
 func (logger *Logger) OutputTo(target io.Writer) *Logger {
 	if target != nil {
-		logger.out = target
+		logger.out, logger.outTerminal = target, isTerminal(target)
 	}
 	return logger
 }
@@ -383,7 +767,37 @@ func (logger *Logger) OutputTo(target io.Writer) *Logger {
 //	logger := logger.NewLogger().ErrorsTo(file)
 func (logger *Logger) ErrorsTo(target io.Writer) *Logger {
 	if target != nil {
-		logger.err = target
+		logger.err, logger.errTerminal = target, isTerminal(target)
+	}
+	return logger
+}
+
+// RouteLevel sends the logs of a single level to a dedicated writer instead of the output
+// or error stream, e.g. DEBUG logs to a file while INFO logs go to stdout.
+// Levels without a route keep writing to the streams set by OutputTo and ErrorsTo.
+// Use io.MultiWriter to send a level to several destinations. Logs without a level
+// (Logf, LogJSONf, LogObjectf) are routed with NONE.
+//
+// Routed writers are shared by all routed levels under a single lock, and asynchronous
+// loggers write each log to the route of its level.
+//
+// Parameters:
+//   - level: The log Level to route
+//   - target: The writer for logs of the level (nil removes the route)
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	debugFile, _ := os.Create("debug.log")
+//	errorFile, _ := os.Create("errors.log")
+//	logger := logger.NewLogger("api").
+//	    RouteLevel(logger.DEBUG, debugFile).
+//	    RouteLevel(logger.ERROR, io.MultiWriter(os.Stderr, errorFile))
+func (logger *Logger) RouteLevel(level LogLevel, target io.Writer) *Logger {
+	if level <= NONE {
+		logger.routes[level], logger.routeTerminals[level] = target, isTerminal(target)
 	}
 	return logger
 }
@@ -408,47 +822,79 @@ func (logger *Logger) ErrorsTo(target io.Writer) *Logger {
 func (logger *Logger) WithAsync(option bool, capacity int) (*Logger, func()) {
 	cancel := func() {}
 	if option {
-		if logger.options.logs != nil {
-			close(logger.options.logs)
-		}
-
-		if logger.options.errors != nil {
-			close(logger.options.errors)
-		}
-
-		if logger.options.cancelAsync != nil {
-			close(logger.options.cancelAsync)
-		}
+		logger.update(func(options *Config) {
+			// Loggers derived by Named share the channels of their parent without owning them.
+			// The previous goroutine is only cancelled: closing its channels would hand it zero messages,
+			// and derived loggers may still send to them
+			if options.stopAsync != nil {
+				options.stopAsync()
+			}
 
-		logger.options.Async = true
-		logger.options.logs, logger.options.errors = make(chan []byte, capacity), make(chan []byte, capacity)
-		logger.options.cancelAsync = make(chan struct{})
+			cancel = startAsync(options, capacity)
+		})
+	}
+	return logger, cancel
+}
 
-		cancel = func() {
-			if logger.options.cancelAsync != nil {
-				close(logger.options.cancelAsync)
+// startAsync creates the Async channels of the options and starts the goroutine writing them.
+// The options take ownership of the goroutine, which is stopped by their stopAsync function.
+//
+// Parameters:
+//   - options: The options to set up, not yet published
+//   - capacity: The buffer size for the Async channels
+//
+// Returns:
+//   - The idempotent function stopping the goroutine after it writes the queued messages
+func startAsync(options *Config, capacity int) func() {
+	stop, done := make(chan struct{}), make(chan struct{})
+	// waiting for the drain keeps the queued messages ahead of the ones written after the cancel
+	cancel := sync.OnceFunc(func() {
+		close(stop)
+		<-done
+	})
+
+	options.Async = true
+	options.dispatcher = nil
+	options.logs, options.errors = make(chan asyncMessage, capacity), make(chan asyncMessage, capacity)
+	options.cancelAsync, options.stopAsync = stop, cancel
+
+	go func(logs, errs chan asyncMessage, cancel chan struct{}) {
+		defer close(done)
+
+		for {
+			select {
+			// messages carry their logger, which may be derived by Named and route levels differently
+			case message := <-logs:
+				message.write()
+			case message := <-errs:
+				message.write()
+			case <-cancel:
+				drainAsync(logs, errs)
+				return
 			}
 		}
+	}(options.logs, options.errors, stop)
 
-		go func(logs, errs chan []byte, cancel chan struct{}) {
-			for {
-				select {
-				case log := <-logs:
-					logger.syncOut.Lock()
-					_, _ = logger.out.Write(log)
-					logger.syncOut.Unlock()
-				case err := <-errs:
-					logger.syncErr.Lock()
-					_, _ = logger.err.Write(err)
-					logger.syncErr.Unlock()
-				case <-cancel:
-					return
-				}
-			}
-		}(logger.options.logs, logger.options.errors, logger.options.cancelAsync)
+	return cancel
+}
 
+// drainAsync writes the messages already queued in the channels of a cancelled Async goroutine,
+// so replacing or cancelling the goroutine doesn't lose them.
+//
+// Parameters:
+//   - logs: The channel of non-error messages
+//   - errs: The channel of error messages
+func drainAsync(logs, errs chan asyncMessage) {
+	for {
+		select {
+		case message := <-logs:
+			message.write()
+		case message := <-errs:
+			message.write()
+		default:
+			return
+		}
 	}
-	return logger, cancel
 }
 
 // WithLogLevel sets the minimum log Level for this logger.
@@ -467,10 +913,38 @@ func (logger *Logger) WithAsync(option bool, capacity int) (*Logger, func()) {
 //	logger.Debugf("This won't be logged") // DEBUG < INFO
 //	logger.Infof("This will be logged")   // INFO >= INFO
 func (logger *Logger) WithLogLevel(level LogLevel) *Logger {
-	logger.options.Level = level
+	logger.update(func(options *Config) {
+		options.Level = level
+	})
 	return logger
 }
 
+// WithLevelFromEnv sets the minimum log Level from an environment variable.
+// The value is parsed case-insensitively with ParseLevel, e.g. LOG_LEVEL=debug.
+// The fallback Level is used when the variable is unset or holds an unrecognized value.
+//
+// Parameters:
+//   - varName: The name of the environment variable to read
+//   - fallback: The log Level to use when the variable is unset or invalid
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	// LOG_LEVEL=DEBUG
+//	logger := logger.NewLogger("api").WithLevelFromEnv("LOG_LEVEL", logger.INFO)
+//	logger.Debugf("This will be logged")
+func (logger *Logger) WithLevelFromEnv(varName string, fallback LogLevel) *Logger {
+	level := fallback
+	if value, exists := os.LookupEnv(varName); exists {
+		if parsed, err := ParseLevel(value); err == nil {
+			level = parsed
+		}
+	}
+	return logger.WithLogLevel(level)
+}
+
 // WithTimestamp enables Timestamp prefixes on log messages.
 // Uses the default format "2006-01-02 15:04:05".
 //
@@ -482,8 +956,10 @@ func (logger *Logger) WithLogLevel(level LogLevel) *Logger {
 //	logger := logger.NewLogger().WithTimestamp()
 //	logger.Infof("Message") // Output: "2025-12-06 10:30:45 INFO Message"
 func (logger *Logger) WithTimestamp() *Logger {
-	logger.options.Timestamp = true
-	logger.options.TimestampFormat = "2006-01-02 15:04:05"
+	logger.update(func(options *Config) {
+		options.Timestamp = true
+		options.TimestampFormat = "2006-01-02 15:04:05"
+	})
 	return logger
 }
 
@@ -501,13 +977,104 @@ func (logger *Logger) WithTimestamp() *Logger {
 //	logger := logger.NewLogger().WithTimestampFormat(time.RFC3339)
 //	logger.Infof("Message") // Output: "2025-12-06T10:30:45Z INFO Message"
 func (logger *Logger) WithTimestampFormat(format string) *Logger {
-	logger.options.Timestamp = true
-	logger.options.TimestampFormat = format
+	logger.update(func(options *Config) {
+		options.Timestamp = true
+		options.TimestampFormat = format
+	})
 	return logger
 }
 
+// WithUTC formats timestamps in UTC instead of the local time zone,
+// which keeps logs of services running in different regions comparable.
+// It applies to plain text, JSON and object logs.
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithTimestampFormat(time.RFC3339).WithUTC()
+//	logger.Infof("Message") // Output: "2025-12-06T08:30:45Z INFO [api]: Message"
+func (logger *Logger) WithUTC() *Logger {
+	logger.update(func(options *Config) {
+		options.UTC = true
+	})
+	return logger
+}
+
+// WithSeverityNumber adds a numeric "severity_number" field to JSON and object output,
+// holding the OpenTelemetry severity number of the log Level (see LogLevel.SeverityNumber).
+// The textual level is kept, so logs can be ingested by OpenTelemetry pipelines without a transform step.
+// Logs without a level (LogJSONf, LogObjectf) have no severity number.
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithSeverityNumber()
+//	logger.WarningJSONf(nil, "slow request")
+//	// Output: {"level":"WARNING","severity_number":13,"source":"api","message":"slow request"}
+func (logger *Logger) WithSeverityNumber() *Logger {
+	logger.update(func(options *Config) {
+		options.SeverityNumber = true
+	})
+	return logger
+}
+
+// WithClock replaces the time source of timestamps and of the intervals of the *Everyf methods
+// (time.Now by default). This is mainly useful in tests asserting deterministic timestamps.
+//
+// Parameters:
+//   - clock: The function returning the current time (nil restores time.Now)
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	fixed := time.Date(2025, 12, 6, 10, 30, 45, 0, time.UTC)
+//	logger := logger.NewLogger("test").WithTimestamp().WithClock(func() time.Time { return fixed })
+//	logger.Infof("Message") // Output: "2025-12-06 10:30:45 INFO [test]: Message"
+func (logger *Logger) WithClock(clock func() time.Time) *Logger {
+	logger.clock = clock
+	return logger
+}
+
+// now returns the current time read from the configured clock (see WithClock).
+//
+// Returns:
+//   - The current time
+func (logger *Logger) now() time.Time {
+	if logger.clock != nil {
+		return logger.clock()
+	}
+	return time.Now()
+}
+
+// timestamp returns the current time formatted with the configured Timestamp format,
+// read from the configured clock and converted to UTC if enabled.
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//
+// Returns:
+//   - The formatted Timestamp
+func (logger *Logger) timestamp(options *Config) string {
+	current := logger.now()
+	if options.UTC {
+		current = current.UTC()
+	}
+
+	return current.Format(options.TimestampFormat)
+}
+
 // WithColoring enables ANSI color codes for different log levels.
-// Colors are only enabled on non-Windows platforms.
+// Colors are written only to outputs that are terminals, so logs redirected to files
+// or buffers stay free of escape sequences unless forced with WithForceColor.
+// On Windows, virtual terminal processing is enabled for console outputs first;
+// coloring stays disabled if that fails or the outputs are not consoles,
+// unless it was forced with WithForceColor.
 //   - ERROR: Red
 //   - WARNING: Yellow
 //   - INFO: Green
@@ -520,11 +1087,36 @@ func (logger *Logger) WithTimestampFormat(format string) *Logger {
 // Example:
 //
 //	logger := logger.NewLogger().WithColoring()
-//	logger.Errorf("Error message") // Displayed in red on Unix terminals
+//	logger.Errorf("Error message") // Displayed in red on ANSI-capable terminals
 func (logger *Logger) WithColoring() *Logger {
-	if runtime.GOOS != "windows" {
-		logger.options.Coloring = true
-	}
+	out, err := enableVirtualTerminal(logger.out), enableVirtualTerminal(logger.err)
+	logger.update(func(options *Config) {
+		if options.ForceColor || out || err {
+			options.Coloring = true
+		}
+	})
+	return logger
+}
+
+// WithForceColor forces ANSI color codes regardless of the output destination.
+// This is useful when piping logs to files or tools that render escape sequences.
+//
+// Parameters:
+//   - option: If true, coloring is enabled unconditionally; if false, coloring is disabled,
+//     including coloring enabled for terminals (call WithColoring to enable it for terminals again)
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	file, _ := os.Create("app.log")
+//	logger := logger.NewLogger("app").OutputTo(file).WithForceColor(true)
+func (logger *Logger) WithForceColor(option bool) *Logger {
+	logger.update(func(options *Config) {
+		options.ForceColor = option
+		options.Coloring = option
+	})
 	return logger
 }
 
@@ -539,15 +1131,22 @@ func (logger *Logger) WithColoring() *Logger {
 //
 //	logger.Logf("Server started on port %d", 8080)
 func (logger *Logger) Logf(msg string, args ...any) {
-	if logger.options.Async {
-		logger.options.logs <- logger.formatMessage(NONE, msg, args...)
+	options := logger.enabled(NONE)
+	if options == nil {
 		return
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(NONE)
 
-	logger.writeStringToStream(logger.out, NONE, msg, args...)
+	if options.Async {
+		logger.sendToChannelByLevel(options, NONE, logger.formatMessage(options, NONE, msg, args...))
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger.writeStringToStream(options, stream, NONE, msg, args...)
 }
 
 // Tracef logs a message at TRACE Level.
@@ -557,19 +1156,22 @@ func (logger *Logger) Logf(msg string, args ...any) {
 //   - msg: The message format string
 //   - args: Optional format arguments
 func (logger *Logger) Tracef(msg string, args ...any) {
-	if logger.options.Level < TRACE {
+	options := logger.enabled(TRACE)
+	if options == nil {
 		return
 	}
 
-	if logger.options.Async {
-		logger.options.logs <- logger.formatMessage(TRACE, msg, args...)
+	stream, mutex := logger.stream(TRACE)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, TRACE, logger.formatMessage(options, TRACE, msg, args...))
 		return
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	logger.writeStringToStream(logger.out, TRACE, msg, args...)
+	logger.writeStringToStream(options, stream, TRACE, msg, args...)
 }
 
 // Debugf logs a message at DEBUG Level.
@@ -579,19 +1181,22 @@ func (logger *Logger) Tracef(msg string, args ...any) {
 //   - msg: The message format string
 //   - args: Optional format arguments
 func (logger *Logger) Debugf(msg string, args ...any) {
-	if logger.options.Level < DEBUG {
+	options := logger.enabled(DEBUG)
+	if options == nil {
 		return
 	}
 
-	if logger.options.Async {
-		logger.options.logs <- logger.formatMessage(DEBUG, msg, args...)
+	stream, mutex := logger.stream(DEBUG)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, DEBUG, logger.formatMessage(options, DEBUG, msg, args...))
 		return
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	logger.writeStringToStream(logger.out, DEBUG, msg, args...)
+	logger.writeStringToStream(options, stream, DEBUG, msg, args...)
 }
 
 // Infof logs a message at INFO Level.
@@ -601,19 +1206,22 @@ func (logger *Logger) Debugf(msg string, args ...any) {
 //   - msg: The message format string
 //   - args: Optional format arguments
 func (logger *Logger) Infof(msg string, args ...any) {
-	if logger.options.Level < INFO {
+	options := logger.enabled(INFO)
+	if options == nil {
 		return
 	}
 
-	if logger.options.Async {
-		logger.options.logs <- logger.formatMessage(INFO, msg, args...)
+	stream, mutex := logger.stream(INFO)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, INFO, logger.formatMessage(options, INFO, msg, args...))
 		return
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	logger.writeStringToStream(logger.out, INFO, msg, args...)
+	logger.writeStringToStream(options, stream, INFO, msg, args...)
 }
 
 // Warningf logs a message at WARNING Level.
@@ -623,19 +1231,22 @@ func (logger *Logger) Infof(msg string, args ...any) {
 //   - msg: The message format string
 //   - args: Optional format arguments
 func (logger *Logger) Warningf(msg string, args ...any) {
-	if logger.options.Level < WARNING {
+	options := logger.enabled(WARNING)
+	if options == nil {
 		return
 	}
 
-	if logger.options.Async {
-		logger.options.logs <- logger.formatMessage(WARNING, msg, args...)
+	stream, mutex := logger.stream(WARNING)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, WARNING, logger.formatMessage(options, WARNING, msg, args...))
 		return
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	logger.writeStringToStream(logger.out, WARNING, msg, args...)
+	logger.writeStringToStream(options, stream, WARNING, msg, args...)
 }
 
 // Errorf logs a message at ERROR Level.
@@ -646,15 +1257,59 @@ func (logger *Logger) Warningf(msg string, args ...any) {
 //   - msg: The message format string
 //   - args: Optional format arguments
 func (logger *Logger) Errorf(msg string, args ...any) {
-	if logger.options.Async {
-		logger.options.errors <- logger.formatMessage(ERROR, msg, args...)
+	options := logger.enabled(ERROR)
+	if options == nil {
 		return
 	}
 
-	logger.syncErr.Lock()
-	defer logger.syncErr.Unlock()
+	stream, mutex := logger.stream(ERROR)
 
-	logger.writeStringToStream(logger.err, ERROR, msg, args...)
+	if options.Async {
+		logger.sendToChannelByLevel(options, ERROR, logger.formatMessage(options, ERROR, msg, args...))
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger.writeStringToStream(options, stream, ERROR, msg, args...)
+}
+
+// ErrorWithf logs a message at ERROR Level with an error appended after it, separated by ": ",
+// so the message doesn't have to format the error itself. A nil error logs the message alone.
+// Use ErrorWithJSONf or AssignError to keep the error as a separate field in structured output.
+//
+// Parameters:
+//   - err: The error to append
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Example:
+//
+//	logger.ErrorWithf(err, "failed to load user %d", id)
+//	// Output: "ERROR: failed to load user 42: query users: connection refused"
+func (logger *Logger) ErrorWithf(err error, msg string, args ...any) {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return
+	}
+
+	message := logger.format(msg, args...)
+	if err != nil {
+		message = append(append(message, ": "...), err.Error()...)
+	}
+
+	stream, mutex := logger.stream(ERROR)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, ERROR, logger.formatMessage(options, ERROR, "%s", message))
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger.writeStringToStream(options, stream, ERROR, "%s", message)
 }
 
 // LogJSONf logs a message with structured JSON data at no specific Level.
@@ -672,19 +1327,25 @@ func (logger *Logger) Errorf(msg string, args ...any) {
 //
 //	logger.LogJSONf(map[string]any{"user": "alice", "action": "login"}, "User activity")
 func (logger *Logger) LogJSONf(object any, msg string, args ...any) error {
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(NONE, object, msg, args...)
+	options := logger.enabled(NONE)
+	if options == nil {
+		return nil
+	}
+
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, NONE, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.logs <- data
+		logger.sendToChannelByLevel(options, NONE, data)
 		return nil
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(NONE)
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	return logger.writeJSONToStream(logger.out, NONE, object, msg, args...)
+	return logger.writeJSONToStream(options, stream, NONE, object, msg, args...)
 }
 
 // TraceJSONf logs a message with structured JSON data at TRACE Level.
@@ -697,23 +1358,25 @@ func (logger *Logger) LogJSONf(object any, msg string, args ...any) error {
 // Returns:
 //   - Error if JSON marshaling fails
 func (logger *Logger) TraceJSONf(object any, msg string, args ...any) error {
-	if logger.options.Level < TRACE {
+	options := logger.enabled(TRACE)
+	if options == nil {
 		return nil
 	}
 
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(TRACE, object, msg, args...)
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, TRACE, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.logs <- data
+		logger.sendToChannelByLevel(options, TRACE, data)
 		return nil
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(TRACE)
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	return logger.writeJSONToStream(logger.out, TRACE, object, msg, args...)
+	return logger.writeJSONToStream(options, stream, TRACE, object, msg, args...)
 }
 
 // DebugJSONf logs a message with structured JSON data at DEBUG Level.
@@ -726,23 +1389,25 @@ func (logger *Logger) TraceJSONf(object any, msg string, args ...any) error {
 // Returns:
 //   - Error if JSON marshaling fails
 func (logger *Logger) DebugJSONf(object any, msg string, args ...any) error {
-	if logger.options.Level < DEBUG {
+	options := logger.enabled(DEBUG)
+	if options == nil {
 		return nil
 	}
 
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(DEBUG, object, msg, args...)
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, DEBUG, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.logs <- data
+		logger.sendToChannelByLevel(options, DEBUG, data)
 		return nil
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(DEBUG)
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	return logger.writeJSONToStream(logger.out, DEBUG, object, msg, args...)
+	return logger.writeJSONToStream(options, stream, DEBUG, object, msg, args...)
 }
 
 // InfoJSONf logs a message with structured JSON data at INFO Level.
@@ -755,23 +1420,25 @@ func (logger *Logger) DebugJSONf(object any, msg string, args ...any) error {
 // Returns:
 //   - Error if JSON marshaling fails
 func (logger *Logger) InfoJSONf(object any, msg string, args ...any) error {
-	if logger.options.Level < INFO {
+	options := logger.enabled(INFO)
+	if options == nil {
 		return nil
 	}
 
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(INFO, object, msg, args...)
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, INFO, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.logs <- data
+		logger.sendToChannelByLevel(options, INFO, data)
 		return nil
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(INFO)
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	return logger.writeJSONToStream(logger.out, INFO, object, msg, args...)
+	return logger.writeJSONToStream(options, stream, INFO, object, msg, args...)
 }
 
 // WarningJSONf logs a message with structured JSON data at WARNING Level.
@@ -784,23 +1451,25 @@ func (logger *Logger) InfoJSONf(object any, msg string, args ...any) error {
 // Returns:
 //   - Error if JSON marshaling fails
 func (logger *Logger) WarningJSONf(object any, msg string, args ...any) error {
-	if logger.options.Level < WARNING {
+	options := logger.enabled(WARNING)
+	if options == nil {
 		return nil
 	}
 
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(WARNING, object, msg, args...)
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, WARNING, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.logs <- data
+		logger.sendToChannelByLevel(options, WARNING, data)
 		return nil
 	}
 
-	logger.syncOut.Lock()
-	defer logger.syncOut.Unlock()
+	stream, mutex := logger.stream(WARNING)
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	return logger.writeJSONToStream(logger.out, WARNING, object, msg, args...)
+	return logger.writeJSONToStream(options, stream, WARNING, object, msg, args...)
 }
 
 // ErrorJSONf logs a message with structured JSON data at ERROR Level.
@@ -813,19 +1482,72 @@ func (logger *Logger) WarningJSONf(object any, msg string, args ...any) error {
 // Returns:
 //   - Error if JSON marshaling fails
 func (logger *Logger) ErrorJSONf(object any, msg string, args ...any) error {
-	if logger.options.Async {
-		data, err := logger.formatJSONMessage(ERROR, object, msg, args...)
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return nil
+	}
+
+	if options.Async {
+		data, err := logger.formatJSONMessage(options, ERROR, object, msg, args...)
 		if err != nil {
 			return err
 		}
-		logger.options.errors <- data
+		logger.sendToChannelByLevel(options, ERROR, data)
+		return nil
+	}
+
+	stream, mutex := logger.stream(ERROR)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return logger.writeJSONToStream(options, stream, ERROR, object, msg, args...)
+}
+
+// ErrorWithJSONf logs a message with structured JSON data at ERROR Level and attaches an error
+// as a separate "error" field holding its message and the messages of the errors it wraps,
+// unwrapped one layer at a time with errors.Unwrap.
+//
+// Parameters:
+//   - err: The error to attach (nil omits the field)
+//   - object: Any JSON-serializable value
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - Error if JSON marshaling fails or the write fails
+//
+// Example:
+//
+//	err := fmt.Errorf("load user: %w", sql.ErrNoRows)
+//	logger.ErrorWithJSONf(err, map[string]int{"id": 42}, "request failed")
+//	// Output: {"level":"ERROR","message":"request failed","object":{"id":42},
+//	//          "error":{"message":"load user: sql: no rows in result set","chain":["sql: no rows in result set"]}}
+func (logger *Logger) ErrorWithJSONf(err error, object any, msg string, args ...any) error {
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return nil
+	}
+
+	data, formatErr := logger.formatJSONError(options, ERROR, object, err, "", msg, args...)
+	if formatErr != nil {
+		return formatErr
+	}
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, ERROR, data)
 		return nil
 	}
 
-	logger.syncErr.Lock()
-	defer logger.syncErr.Unlock()
+	stream, mutex := logger.stream(ERROR)
+	if stream == nil {
+		return errors.New("nil stream or object")
+	}
 
-	return logger.writeJSONToStream(logger.err, ERROR, object, msg, args...)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	_, writeErr := stream.Write(data)
+	return writeErr
 }
 
 // LogObjectf creates a zero-allocation object log builder at no specific Level.
@@ -845,7 +1567,12 @@ func (logger *Logger) ErrorJSONf(object any, msg string, args ...any) error {
 //	    AssignInt("status", 200).
 //	    Build()
 func (logger *Logger) LogObjectf(msg string, args ...any) *ObjectLogBuilder {
-	return newObjectLogBuilder(logger, NONE, logger.format(msg, args...))
+	options := logger.enabled(NONE)
+	if options == nil {
+		return nil
+	}
+
+	return newObjectLogBuilder(logger, options, NONE, logger.format(msg, args...), "")
 }
 
 // TraceObjectf creates a zero-allocation object log builder at TRACE Level.
@@ -855,13 +1582,14 @@ func (logger *Logger) LogObjectf(msg string, args ...any) *ObjectLogBuilder {
 //   - args: Optional format arguments
 //
 // Returns:
-//   - An ObjectLogBuilder, or nil if log Level filtering discards this log
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
 func (logger *Logger) TraceObjectf(msg string, args ...any) *ObjectLogBuilder {
-	if logger.options.Level < TRACE {
+	options := logger.enabled(TRACE)
+	if options == nil {
 		return nil
 	}
 
-	return newObjectLogBuilder(logger, TRACE, logger.format(msg, args...))
+	return newObjectLogBuilder(logger, options, TRACE, logger.format(msg, args...), "")
 
 }
 
@@ -872,13 +1600,14 @@ func (logger *Logger) TraceObjectf(msg string, args ...any) *ObjectLogBuilder {
 //   - args: Optional format arguments
 //
 // Returns:
-//   - An ObjectLogBuilder, or nil if log Level filtering discards this log
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
 func (logger *Logger) DebugObjectf(msg string, args ...any) *ObjectLogBuilder {
-	if logger.options.Level < DEBUG {
+	options := logger.enabled(DEBUG)
+	if options == nil {
 		return nil
 	}
 
-	return newObjectLogBuilder(logger, DEBUG, logger.format(msg, args...))
+	return newObjectLogBuilder(logger, options, DEBUG, logger.format(msg, args...), "")
 
 }
 
@@ -889,13 +1618,14 @@ func (logger *Logger) DebugObjectf(msg string, args ...any) *ObjectLogBuilder {
 //   - args: Optional format arguments
 //
 // Returns:
-//   - An ObjectLogBuilder, or nil if log Level filtering discards this log
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
 func (logger *Logger) InfoObjectf(msg string, args ...any) *ObjectLogBuilder {
-	if logger.options.Level < INFO {
+	options := logger.enabled(INFO)
+	if options == nil {
 		return nil
 	}
 
-	return newObjectLogBuilder(logger, INFO, logger.format(msg, args...))
+	return newObjectLogBuilder(logger, options, INFO, logger.format(msg, args...), "")
 
 }
 
@@ -906,13 +1636,14 @@ func (logger *Logger) InfoObjectf(msg string, args ...any) *ObjectLogBuilder {
 //   - args: Optional format arguments
 //
 // Returns:
-//   - An ObjectLogBuilder, or nil if log Level filtering discards this log
+//   - An ObjectLogBuilder, or nil if log Level filtering or sampling discards this log
 func (logger *Logger) WarningObjectf(msg string, args ...any) *ObjectLogBuilder {
-	if logger.options.Level < WARNING {
+	options := logger.enabled(WARNING)
+	if options == nil {
 		return nil
 	}
 
-	return newObjectLogBuilder(logger, WARNING, logger.format(msg, args...))
+	return newObjectLogBuilder(logger, options, WARNING, logger.format(msg, args...), "")
 
 }
 
@@ -923,8 +1654,13 @@ func (logger *Logger) WarningObjectf(msg string, args ...any) *ObjectLogBuilder
 //   - args: Optional format arguments
 //
 // Returns:
-//   - An ObjectLogBuilder for constructing the error log
+//   - An ObjectLogBuilder for constructing the error log, or nil if sampling discards this log
 func (logger *Logger) ErrorObjectf(msg string, args ...any) *ObjectLogBuilder {
-	return newObjectLogBuilder(logger, ERROR, logger.format(msg, args...))
+	options := logger.enabled(ERROR)
+	if options == nil {
+		return nil
+	}
+
+	return newObjectLogBuilder(logger, options, ERROR, logger.format(msg, args...), "")
 
 }
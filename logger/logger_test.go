@@ -2,8 +2,15 @@ package logger
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestNewLogger tests basic logger creation and initialization.
@@ -13,7 +20,7 @@ func TestNewLogger(t *testing.T) {
 	if logger == nil {
 		t.Fatal("NewLogger() returned nil")
 	}
-	if logger.options == nil {
+	if logger.config() == nil {
 		t.Error("Logger options are nil")
 	}
 	if logger.name != "TestNewLogger" {
@@ -42,23 +49,23 @@ func TestNewLogger_WithCustomConfig(t *testing.T) {
 	logger := NewLogger("complete-config-test", customConfig)
 
 	// Verify all config fields
-	if logger.options.Level != TRACE {
-		t.Errorf("Expected level TRACE, got %v", logger.options.Level)
+	if logger.config().Level != TRACE {
+		t.Errorf("Expected level TRACE, got %v", logger.config().Level)
 	}
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Expected timestamp enabled")
 	}
-	if logger.options.TimestampFormat != "2006/01/02 15:04:05.000" {
-		t.Errorf("Expected custom timestamp format, got '%s'", logger.options.TimestampFormat)
+	if logger.config().TimestampFormat != "2006/01/02 15:04:05.000" {
+		t.Errorf("Expected custom timestamp format, got '%s'", logger.config().TimestampFormat)
 	}
-	if logger.options.Coloring {
+	if logger.config().Coloring {
 		t.Error("Expected coloring disabled")
 	}
-	if logger.options.Async {
+	if logger.config().Async {
 		t.Error("Expected async disabled")
 	}
-	if logger.options.AsyncBuffer != 200 {
-		t.Errorf("Expected async buffer 200, got %d", logger.options.AsyncBuffer)
+	if logger.config().AsyncBuffer != 200 {
+		t.Errorf("Expected async buffer 200, got %d", logger.config().AsyncBuffer)
 	}
 
 	// Verify logger works with all TRACE level
@@ -148,6 +155,129 @@ func TestGetLogger_NonExistent(t *testing.T) {
 	loggerRegistry = nil
 }
 
+// TestNewLogger_WithRegistry_DifferentConfig tests re-requesting a registered logger with a different config.
+// It verifies that the first config wins and the cached instance is returned unchanged.
+func TestNewLogger_WithRegistry_DifferentConfig(t *testing.T) {
+	loggerRegistry = nil
+	WithLoggerRegistry()
+	defer func() { loggerRegistry = nil }()
+
+	first := NewLogger("reconfigured", &Config{Level: INFO})
+	second := NewLogger("reconfigured", &Config{Level: TRACE, Timestamp: true})
+
+	if first != second {
+		t.Fatal("NewLogger should return the registered instance")
+	}
+	if second.config().Level != INFO {
+		t.Errorf("Expected first config to win with level INFO, got %v", second.config().Level)
+	}
+	if second.config().Timestamp {
+		t.Error("Expected timestamp from the second config to be ignored")
+	}
+}
+
+// TestReconfigureLogger tests applying a new config to a registered logger.
+// It verifies that the same instance is updated and keeps its output writers.
+func TestReconfigureLogger(t *testing.T) {
+	loggerRegistry = nil
+	WithLoggerRegistry()
+	defer func() { loggerRegistry = nil }()
+
+	var buf bytes.Buffer
+	created := NewLogger("reconfigured", &Config{Level: INFO}).OutputTo(&buf)
+
+	created.Tracef("hidden")
+	if buf.Len() > 0 {
+		t.Fatal("TRACE should be filtered before reconfiguration")
+	}
+
+	reconfigured := ReconfigureLogger("reconfigured", &Config{Level: TRACE})
+	if reconfigured != created {
+		t.Fatal("ReconfigureLogger should return the registered instance")
+	}
+	if NewLogger("reconfigured") != created {
+		t.Error("Registry should still hold the reconfigured instance")
+	}
+
+	created.Tracef("visible")
+	if !strings.Contains(buf.String(), "visible") {
+		t.Error("TRACE should be logged to the original writer after reconfiguration")
+	}
+}
+
+// TestReconfigureLogger_Concurrent tests reconfiguring a logger while other goroutines log through it.
+// Each line must be formatted with a single configuration; run with -race to detect unsynchronized access.
+func TestReconfigureLogger_Concurrent(t *testing.T) {
+	loggerRegistry = nil
+	WithLoggerRegistry()
+	defer func() { loggerRegistry = nil }()
+
+	capture := &LogCapture{}
+	logger := NewLogger("concurrent", &Config{Level: INFO}).OutputTo(capture)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				logger.Infof("message")
+				logger.InfoObjectf("object").AssignInt("id", 1).Build()
+			}
+		}()
+	}
+
+	for i := range 50 {
+		ReconfigureLogger("concurrent", &Config{Level: INFO, Timestamp: i%2 == 0, TimestampFormat: time.RFC3339})
+	}
+	wg.Wait()
+
+	if entries := capture.Entries(); len(entries) != 800 {
+		t.Errorf("Expected 800 entries, got %d", len(entries))
+	}
+}
+
+// TestReconfigureLogger_StopsAsync tests that replacing an asynchronous configuration stops its goroutine
+// after writing the queued messages, and that later logs are written with the new configuration.
+func TestReconfigureLogger_StopsAsync(t *testing.T) {
+	loggerRegistry = nil
+	WithLoggerRegistry()
+	defer func() { loggerRegistry = nil }()
+
+	capture := &LogCapture{}
+	logger := NewLogger("async", &Config{Level: INFO, Async: true, AsyncBuffer: 10}).OutputTo(capture)
+	previous := logger.config()
+
+	logger.Infof("queued")
+	ReconfigureLogger("async", &Config{Level: INFO})
+
+	select {
+	case <-previous.cancelAsync:
+	default:
+		t.Error("Expected the previous async goroutine to be stopped")
+	}
+
+	logger.Infof("synchronous")
+
+	if entries := capture.Entries(); len(entries) != 2 || entries[0].Message != "queued" {
+		t.Errorf("Expected the queued and the synchronous messages in order, got %+v", entries)
+	}
+}
+
+// TestReconfigureLogger_NotRegistered tests ReconfigureLogger for names that are not registered.
+// It verifies that a new logger is created with the given config.
+func TestReconfigureLogger_NotRegistered(t *testing.T) {
+	loggerRegistry = nil
+
+	logger := ReconfigureLogger("fresh", &Config{Level: DEBUG})
+	if logger == nil {
+		t.Fatal("ReconfigureLogger should create a logger when none is registered")
+	}
+	if logger.config().Level != DEBUG {
+		t.Errorf("Expected level DEBUG, got %v", logger.config().Level)
+	}
+}
+
 // TestGetLogger_NoRegistry tests GetLogger when registry is not initialized.
 func TestGetLogger_NoRegistry(t *testing.T) {
 	loggerRegistry = nil
@@ -239,21 +369,42 @@ func TestLogger_WithLogLevel(t *testing.T) {
 	tests := []LogLevel{ERROR, WARNING, INFO, DEBUG, TRACE, NONE}
 	for _, level := range tests {
 		logger := NewLogger("test").WithLogLevel(level)
-		if logger.options.Level != level {
-			t.Errorf("Expected log Level %v, got %v", level, logger.options.Level)
+		if logger.config().Level != level {
+			t.Errorf("Expected log Level %v, got %v", level, logger.config().Level)
 		}
 	}
 }
 
+// TestLogger_WithLevelFromEnv tests reading the log level from an environment variable.
+// It verifies that the fallback is used when the variable is unset or invalid.
+func TestLogger_WithLevelFromEnv(t *testing.T) {
+	t.Setenv("TEST_LOG_LEVEL", "debug")
+	logger := NewLogger("test").WithLevelFromEnv("TEST_LOG_LEVEL", WARNING)
+	if logger.config().Level != DEBUG {
+		t.Errorf("Expected level DEBUG from env, got %v", logger.config().Level)
+	}
+
+	t.Setenv("TEST_LOG_LEVEL", "verbose")
+	logger.WithLevelFromEnv("TEST_LOG_LEVEL", WARNING)
+	if logger.config().Level != WARNING {
+		t.Errorf("Expected fallback WARNING for invalid value, got %v", logger.config().Level)
+	}
+
+	logger.WithLevelFromEnv("TEST_LOG_LEVEL_UNSET", INFO)
+	if logger.config().Level != INFO {
+		t.Errorf("Expected fallback INFO for unset variable, got %v", logger.config().Level)
+	}
+}
+
 // TestLogger_WithTimestamp tests the WithTimestamp configuration method.
 // It verifies that timestamps are enabled with the default format.
 func TestLogger_WithTimestamp(t *testing.T) {
 	logger := NewLogger("test").WithTimestamp()
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Timestamp not enabled")
 	}
-	if logger.options.TimestampFormat != "2006-01-02 15:04:05" {
-		t.Errorf("Expected default Timestamp format, got '%s'", logger.options.TimestampFormat)
+	if logger.config().TimestampFormat != "2006-01-02 15:04:05" {
+		t.Errorf("Expected default Timestamp format, got '%s'", logger.config().TimestampFormat)
 	}
 }
 
@@ -262,11 +413,127 @@ func TestLogger_WithTimestamp(t *testing.T) {
 func TestLogger_WithTimestampFormat(t *testing.T) {
 	customFormat := "2006/01/02"
 	logger := NewLogger("test").WithTimestampFormat(customFormat)
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Timestamp not enabled")
 	}
-	if logger.options.TimestampFormat != customFormat {
-		t.Errorf("Expected Timestamp format '%s', got '%s'", customFormat, logger.options.TimestampFormat)
+	if logger.config().TimestampFormat != customFormat {
+		t.Errorf("Expected Timestamp format '%s', got '%s'", customFormat, logger.config().TimestampFormat)
+	}
+}
+
+// TestLogger_WithClock tests that an injected clock produces deterministic timestamps
+// on the plain text, JSON and object paths.
+func TestLogger_WithClock(t *testing.T) {
+	fixed := time.Date(2025, 12, 6, 10, 30, 45, 0, time.UTC)
+
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).WithTimestampFormat(time.RFC3339).WithClock(func() time.Time { return fixed })
+
+	logger.Infof("text")
+	_ = logger.InfoJSONf(nil, "json")
+	logger.InfoObjectf("object").Build()
+
+	expected := "2025-12-06T10:30:45Z INFO: text\n" +
+		`{"level":"INFO","timestamp":"2025-12-06T10:30:45Z","message":"json"}` + "\n" +
+		`{"level":"INFO","timestamp":"2025-12-06T10:30:45Z","message":"object","object":{}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// TestLogger_WithUTC tests that timestamps are converted to UTC on the plain text, JSON and object paths.
+func TestLogger_WithUTC(t *testing.T) {
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	local := time.Date(2025, 12, 6, 12, 30, 45, 0, zone)
+
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).WithTimestampFormat("15:04:05 MST").WithClock(func() time.Time { return local })
+
+	logger.Infof("before")
+	if !strings.HasPrefix(buf.String(), "12:30:45 UTC+2 INFO") {
+		t.Errorf("Expected local time without WithUTC, got %q", buf.String())
+	}
+
+	buf.Reset()
+	logger.WithUTC()
+	if !logger.config().UTC {
+		t.Error("UTC not enabled")
+	}
+
+	logger.Infof("text")
+	_ = logger.InfoJSONf(nil, "json")
+	logger.InfoObjectf("object").Build()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "10:30:45 UTC INFO") {
+		t.Errorf("Expected UTC text timestamp, got %q", lines[0])
+	}
+	for _, line := range lines[1:] {
+		if !strings.Contains(line, `"timestamp":"10:30:45 UTC"`) {
+			t.Errorf("Expected UTC JSON timestamp, got %q", line)
+		}
+	}
+}
+
+// TestLogger_WithSeverityNumber tests that JSON and object logs carry the OpenTelemetry severity number
+// of every level next to the textual level, and only once enabled.
+func TestLogger_WithSeverityNumber(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).ErrorsTo(&buf)
+
+	_ = logger.InfoJSONf(nil, "disabled")
+	logger.InfoObjectf("disabled").Build()
+	if strings.Contains(buf.String(), "severity_number") {
+		t.Errorf("Expected no severity number by default, got %q", buf.String())
+	}
+
+	logger.WithSeverityNumber()
+	if !logger.config().SeverityNumber {
+		t.Error("SeverityNumber not enabled")
+	}
+
+	tests := []struct {
+		level    LogLevel
+		json     func(object any, msg string, args ...any) error
+		object   func(msg string, args ...any) *ObjectLogBuilder
+		expected float64
+	}{
+		{ERROR, logger.ErrorJSONf, logger.ErrorObjectf, 17},
+		{WARNING, logger.WarningJSONf, logger.WarningObjectf, 13},
+		{INFO, logger.InfoJSONf, logger.InfoObjectf, 9},
+		{DEBUG, logger.DebugJSONf, logger.DebugObjectf, 5},
+		{TRACE, logger.TraceJSONf, logger.TraceObjectf, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			buf.Reset()
+			_ = tt.json(nil, "json")
+			tt.object("object").Build()
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("Expected 2 lines, got %q", buf.String())
+			}
+			for _, line := range lines {
+				var entry map[string]any
+				if err := json.Unmarshal([]byte(line), &entry); err != nil {
+					t.Fatalf("Invalid JSON output %q: %v", line, err)
+				}
+				if entry["severity_number"] != tt.expected || entry["level"] != tt.level.String() {
+					t.Errorf("Expected severity number %v and level %s, got %q", tt.expected, tt.level.String(), line)
+				}
+			}
+		})
+	}
+
+	buf.Reset()
+	_ = logger.LogJSONf(nil, "no level")
+	if strings.Contains(buf.String(), "severity_number") {
+		t.Errorf("Expected no severity number without a level, got %q", buf.String())
 	}
 }
 
@@ -281,6 +548,105 @@ func TestLogger_WithColoring(t *testing.T) {
 	}
 }
 
+// TestLogger_WithForceColor tests that forced coloring paints output written to a non-terminal writer.
+func TestLogger_WithForceColor(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithForceColor(true)
+
+	if !logger.config().ForceColor || !logger.config().Coloring {
+		t.Fatal("WithForceColor(true) should enable coloring")
+	}
+
+	logger.Infof("colored")
+	if !bytes.HasPrefix(buf.Bytes(), colorGreen) {
+		t.Errorf("Expected output to start with green color code, got %q", buf.String())
+	}
+
+	logger.WithForceColor(false)
+	if logger.config().ForceColor || logger.config().Coloring {
+		t.Error("WithForceColor(false) should disable coloring")
+	}
+}
+
+// TestLogger_WithForceColor_DisablesTerminalColoring tests that WithForceColor(false) also turns off
+// coloring that was enabled for a terminal.
+func TestLogger_WithForceColor_DisablesTerminalColoring(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+	// pretend the output is a terminal that WithColoring enabled coloring for
+	logger.outTerminal = true
+	logger.update(func(options *Config) { options.Coloring = true })
+
+	logger.WithForceColor(false)
+	logger.Infof("plain")
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("Expected no color codes after WithForceColor(false), got %q", buf.String())
+	}
+}
+
+// TestLogger_WithColoring_NonTerminal tests that color codes are skipped for non-terminal writers.
+// It verifies that the decision is made per writer, so forced and detected coloring can differ.
+func TestLogger_WithColoring_NonTerminal(t *testing.T) {
+	var out, errs bytes.Buffer
+	logger := NewLogger("test").OutputTo(&out).ErrorsTo(&errs)
+	logger.update(func(options *Config) { options.Coloring = true })
+
+	logger.Infof("plain info")
+	logger.Errorf("plain error")
+
+	if strings.Contains(out.String(), "\x1b[") {
+		t.Errorf("Expected no color codes in buffered output, got %q", out.String())
+	}
+	if strings.Contains(errs.String(), "\x1b[") {
+		t.Errorf("Expected no color codes in buffered error output, got %q", errs.String())
+	}
+}
+
+// TestIsTerminal tests terminal detection for writers that are not terminals.
+func TestIsTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	if isTerminal(&buf) {
+		t.Error("bytes.Buffer should not be detected as a terminal")
+	}
+
+	file, err := os.CreateTemp(t.TempDir(), "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if isTerminal(file) {
+		t.Error("Regular file should not be detected as a terminal")
+	}
+}
+
+// TestLogger_TerminalCached tests that terminal detection runs when a writer is set, not on every log.
+func TestLogger_TerminalCached(t *testing.T) {
+	var out, errs, debug bytes.Buffer
+	logger := NewLogger("test").OutputTo(&out).ErrorsTo(&errs).RouteLevel(DEBUG, &debug).WithColoring()
+
+	if logger.outTerminal || logger.errTerminal || logger.routeTerminals[DEBUG] {
+		t.Fatal("Buffers should not be cached as terminals")
+	}
+
+	// pretend the output was detected as a terminal: the cached result decides the coloring
+	logger.outTerminal = true
+	logger.Infof("painted")
+	logger.Errorf("plain")
+	logger.Debugf("plain")
+
+	if !strings.Contains(out.String(), "\x1b[") {
+		t.Errorf("Expected color codes on the cached terminal, got %q", out.String())
+	}
+	if strings.Contains(errs.String()+debug.String(), "\x1b[") {
+		t.Errorf("Expected no color codes on the other writers, got %q and %q", errs.String(), debug.String())
+	}
+
+	if derived := logger.Named("child"); !derived.outTerminal {
+		t.Error("Expected Named to copy the cached terminal detection")
+	}
+}
+
 // TestLogger_OutputTo tests the OutputTo configuration method.
 // It verifies that the output writer is correctly set.
 func TestLogger_OutputTo(t *testing.T) {
@@ -301,6 +667,62 @@ func TestLogger_ErrorsTo(t *testing.T) {
 	}
 }
 
+// TestLogger_RouteLevel tests that each level lands in its routed writer and unrouted levels
+// fall back to the output and error streams.
+func TestLogger_RouteLevel(t *testing.T) {
+	var out, errs, debug, errorFile bytes.Buffer
+	logger := NewLogger("test").OutputTo(&out).ErrorsTo(&errs).
+		RouteLevel(DEBUG, &debug).
+		RouteLevel(ERROR, io.MultiWriter(&errs, &errorFile))
+
+	logger.Debugf("debug")
+	logger.Infof("info")
+	logger.Errorf("error")
+	_ = logger.DebugJSONf(nil, "debug json")
+	logger.DebugObjectf("debug object").Build()
+
+	if lines := strings.Count(debug.String(), "\n"); lines != 3 || !strings.HasPrefix(debug.String(), "DEBUG [test]: debug\n") {
+		t.Errorf("Expected the DEBUG logs in the routed writer, got %q", debug.String())
+	}
+	if out.String() != "INFO [test]: info\n" {
+		t.Errorf("Expected only INFO in the output stream, got %q", out.String())
+	}
+	if errs.String() != "ERROR [test]: error\n" || errorFile.String() != errs.String() {
+		t.Errorf("Expected ERROR in both error writers, got %q and %q", errs.String(), errorFile.String())
+	}
+
+	logger.RouteLevel(DEBUG, nil)
+	logger.Debugf("unrouted")
+	if !strings.Contains(out.String(), "DEBUG [test]: unrouted") {
+		t.Errorf("Expected DEBUG to fall back to the output stream, got %q", out.String())
+	}
+}
+
+// TestLogger_RouteLevel_Async tests that the background writer writes each level to its route.
+func TestLogger_RouteLevel_Async(t *testing.T) {
+	out, warnings, errs := &LogCapture{}, &LogCapture{}, &LogCapture{}
+	logger, cancel := NewLogger("test").OutputTo(out).ErrorsTo(errs).RouteLevel(WARNING, warnings).WithAsync(true, 10)
+	defer cancel()
+
+	logger.Infof("info")
+	logger.Warningf("warning")
+	logger.Errorf("error")
+
+	for _, tt := range []struct {
+		capture *LogCapture
+		level   LogLevel
+	}{{out, INFO}, {warnings, WARNING}, {errs, ERROR}} {
+		entry, ok := waitForEntry(tt.capture, func(LogEntry) bool { return true })
+		if !ok || entry.Level != tt.level {
+			t.Errorf("Expected a %s entry, got %+v", tt.level.String(), entry)
+		}
+	}
+
+	if entries := out.Entries(); len(entries) != 1 {
+		t.Errorf("Expected a single entry in the output stream, got %+v", entries)
+	}
+}
+
 // TestLogger_WithAsync tests the WithAsync configuration method.
 // It verifies that asynchronous logging mode is properly enabled with channels created.
 func TestLogger_WithAsync(t *testing.T) {
@@ -308,17 +730,38 @@ func TestLogger_WithAsync(t *testing.T) {
 	logger, cancel := NewLogger("test").OutputTo(&buf).WithAsync(true, 10)
 	defer cancel()
 
-	if !logger.options.Async {
+	if !logger.config().Async {
 		t.Error("WithAsync did not enable Async mode")
 	}
-	if logger.options.logs == nil {
+	if logger.config().logs == nil {
 		t.Error("WithAsync did not create logs channel")
 	}
-	if logger.options.errors == nil {
+	if logger.config().errors == nil {
 		t.Error("WithAsync did not create errors channel")
 	}
 }
 
+// TestLogger_WithAsync_Twice tests that enabling asynchronous mode again replaces the background writer
+// without crashing it, keeps the messages queued before, and that both cancel functions are safe to call.
+func TestLogger_WithAsync_Twice(t *testing.T) {
+	capture := &LogCapture{}
+	logger, cancelFirst := NewLogger("test").OutputTo(capture).WithAsync(true, 10)
+
+	logger.Infof("first")
+	_, cancelSecond := logger.WithAsync(true, 10)
+	logger.Infof("second")
+
+	for _, message := range []string{"first", "second"} {
+		if _, ok := waitForEntry(capture, func(entry LogEntry) bool { return entry.Message == message }); !ok {
+			t.Errorf("Expected %q to be written", message)
+		}
+	}
+
+	cancelFirst()
+	cancelSecond()
+	cancelSecond()
+}
+
 // TestLogger_Logf tests the Logf method for logging messages without a level.
 // It verifies that the message is written to the output stream.
 func TestLogger_Logf(t *testing.T) {
@@ -483,6 +926,120 @@ func TestLogger_LogLevelFiltering_Warning(t *testing.T) {
 	}
 }
 
+// TestLogger_Enabled tests that Enabled follows the log level and ignores sampling.
+func TestLogger_Enabled(t *testing.T) {
+	logger := NewLogger("test").WithLogLevel(INFO).WithSamplingPer(INFO, 100)
+
+	for _, level := range []LogLevel{ERROR, WARNING, INFO, NONE} {
+		if !logger.Enabled(level) {
+			t.Errorf("Expected %s to be enabled at level INFO", level.String())
+		}
+	}
+	for _, level := range []LogLevel{DEBUG, TRACE} {
+		if logger.Enabled(level) {
+			t.Errorf("Expected %s to be disabled at level INFO", level.String())
+		}
+	}
+
+	if NewNopLogger().Enabled(ERROR) {
+		t.Error("Expected a nop logger to disable every level")
+	}
+}
+
+// TestLogger_IfLevel tests that IfLevel only calls the function for enabled levels.
+func TestLogger_IfLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithLogLevel(INFO)
+
+	calls := 0
+	expensive := func() string {
+		calls++
+		return "expensive"
+	}
+
+	logger.IfLevel(DEBUG, func(l *Logger) { l.Debugf("%s", expensive()) })
+	if calls != 0 || buf.Len() != 0 {
+		t.Errorf("Expected the disabled branch to be skipped, got %d calls and %q", calls, buf.String())
+	}
+
+	logger.IfLevel(INFO, func(l *Logger) {
+		if l != logger {
+			t.Error("Expected the function to receive the logger")
+		}
+		l.Infof("%s", expensive())
+	})
+	if calls != 1 || buf.String() != "INFO [test]: expensive\n" {
+		t.Errorf("Expected the enabled branch to log once, got %d calls and %q", calls, buf.String())
+	}
+}
+
+// wrappedError returns a three-layer error chain for the ErrorWith* tests.
+func wrappedError() error {
+	root := errors.New("connection refused")
+	query := fmt.Errorf("query users: %w", root)
+	return fmt.Errorf("load user 42: %w", query)
+}
+
+// TestLogger_ErrorWithf tests that the error is appended after the message in text output.
+func TestLogger_ErrorWithf(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf)
+
+	logger.ErrorWithf(wrappedError(), "request %d failed", 7)
+	logger.ErrorWithf(nil, "no cause")
+
+	expected := "ERROR [test]: request 7 failed: load user 42: query users: connection refused\n" +
+		"ERROR [test]: no cause\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	NewNopLogger().ErrorWithf(wrappedError(), "discarded")
+	if buf.Len() != 0 {
+		t.Errorf("Expected no output from a nop logger, got %q", buf.String())
+	}
+}
+
+// TestLogger_ErrorWithJSONf tests that every layer of a wrapped error appears in the error field.
+func TestLogger_ErrorWithJSONf(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf)
+
+	if err := logger.ErrorWithJSONf(wrappedError(), map[string]int{"id": 42}, "request failed"); err != nil {
+		t.Fatal(err)
+	}
+
+	var entry struct {
+		Message string         `json:"message"`
+		Object  map[string]int `json:"object"`
+		Error   struct {
+			Message string   `json:"message"`
+			Chain   []string `json:"chain"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+	}
+
+	if entry.Message != "request failed" || entry.Object["id"] != 42 {
+		t.Errorf("Expected the message and object to be kept, got %q", buf.String())
+	}
+	if entry.Error.Message != "load user 42: query users: connection refused" {
+		t.Errorf("Unexpected error message %q", entry.Error.Message)
+	}
+	chain := []string{"query users: connection refused", "connection refused"}
+	if strings.Join(entry.Error.Chain, "|") != strings.Join(chain, "|") {
+		t.Errorf("Expected chain %v, got %v", chain, entry.Error.Chain)
+	}
+
+	buf.Reset()
+	_ = logger.ErrorWithJSONf(nil, nil, "no cause")
+	if strings.Contains(buf.String(), `"error"`) {
+		t.Errorf("Expected no error field for a nil error, got %q", buf.String())
+	}
+}
+
 // TestLogger_LogJSONf tests the LogJSONf method for JSON logging without a specific level.
 // It verifies that structured data is correctly serialized to JSON.
 func TestLogger_LogJSONf(t *testing.T) {
@@ -519,7 +1076,7 @@ func TestLogger_TraceJSONf(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"TRACE"`) {
+	if !strings.Contains(output, `"level":"TRACE"`) {
 		t.Errorf("Expected TRACE Level in JSON, got '%s'", output)
 	}
 }
@@ -538,7 +1095,7 @@ func TestLogger_DebugJSONf(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"DEBUG"`) {
+	if !strings.Contains(output, `"level":"DEBUG"`) {
 		t.Errorf("Expected DEBUG Level in JSON, got '%s'", output)
 	}
 }
@@ -557,7 +1114,7 @@ func TestLogger_InfoJSONf(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"INFO"`) {
+	if !strings.Contains(output, `"level":"INFO"`) {
 		t.Errorf("Expected INFO Level in JSON, got '%s'", output)
 	}
 }
@@ -576,7 +1133,7 @@ func TestLogger_WarningJSONf(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"WARNING"`) {
+	if !strings.Contains(output, `"level":"WARNING"`) {
 		t.Errorf("Expected WARNING Level in JSON, got '%s'", output)
 	}
 }
@@ -595,7 +1152,7 @@ func TestLogger_ErrorJSONf(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"ERROR"`) {
+	if !strings.Contains(output, `"level":"ERROR"`) {
 		t.Errorf("Expected ERROR Level in JSON, got '%s'", output)
 	}
 }
@@ -652,7 +1209,7 @@ func TestLogger_TraceObjectf(t *testing.T) {
 		Build()
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"TRACE"`) {
+	if !strings.Contains(output, `"level":"TRACE"`) {
 		t.Errorf("Expected TRACE Level, got '%s'", output)
 	}
 }
@@ -668,7 +1225,7 @@ func TestLogger_DebugObjectf(t *testing.T) {
 		Build()
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"DEBUG"`) {
+	if !strings.Contains(output, `"level":"DEBUG"`) {
 		t.Errorf("Expected DEBUG Level, got '%s'", output)
 	}
 }
@@ -684,7 +1241,7 @@ func TestLogger_InfoObjectf(t *testing.T) {
 		Build()
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"INFO"`) {
+	if !strings.Contains(output, `"level":"INFO"`) {
 		t.Errorf("Expected INFO Level, got '%s'", output)
 	}
 }
@@ -700,7 +1257,7 @@ func TestLogger_WarningObjectf(t *testing.T) {
 		Build()
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"WARNING"`) {
+	if !strings.Contains(output, `"level":"WARNING"`) {
 		t.Errorf("Expected WARNING Level, got '%s'", output)
 	}
 }
@@ -716,7 +1273,7 @@ func TestLogger_ErrorObjectf(t *testing.T) {
 		Build()
 
 	output := buf.String()
-	if !strings.Contains(output, `"Level":"ERROR"`) {
+	if !strings.Contains(output, `"level":"ERROR"`) {
 		t.Errorf("Expected ERROR Level, got '%s'", output)
 	}
 }
@@ -862,10 +1419,10 @@ func TestLogger_MethodChaining(t *testing.T) {
 	if logger.name != "ChainTest" {
 		t.Error("Method chaining failed to set name")
 	}
-	if logger.options.Level != DEBUG {
+	if logger.config().Level != DEBUG {
 		t.Error("Method chaining failed to set log Level")
 	}
-	if !logger.options.Timestamp {
+	if !logger.config().Timestamp {
 		t.Error("Method chaining failed to set Timestamp")
 	}
 }
@@ -970,7 +1527,7 @@ func TestLogger_writeStringToStream_NilStream(t *testing.T) {
 	logger := NewLogger("test")
 
 	// Should not panic
-	logger.writeStringToStream(nil, INFO, "test")
+	logger.writeStringToStream(logger.config(), nil, INFO, "test")
 }
 
 // TestLogger_writeJSONToStream_NilStream tests writeJSONToStream with a nil stream.
@@ -979,7 +1536,7 @@ func TestLogger_writeJSONToStream_NilStream(t *testing.T) {
 	logger := NewLogger("test")
 
 	obj := map[string]interface{}{"key": "value"}
-	err := logger.writeJSONToStream(nil, INFO, obj, "test")
+	err := logger.writeJSONToStream(logger.config(), nil, INFO, obj, "test")
 
 	if err == nil {
 		t.Error("writeJSONToStream should return error for nil stream")
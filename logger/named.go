@@ -0,0 +1,113 @@
+package logger
+
+// defaultNameSeparator joins the names of a logger and the loggers derived from it by Named.
+const defaultNameSeparator = "."
+
+// Named derives a sub-logger for a component, named after this logger's name and the suffix
+// joined by a dot (see WithNameSeparator), e.g. "api" and "auth" give "api.auth".
+// If this logger has no name, the sub-logger is named after the suffix alone.
+//
+// The sub-logger starts with a copy of this logger's options: log Level, timestamps, coloring,
+// stack traces, sampling rates, base fields, field transform, JSON keys, name separator, clock and trace ID key.
+// It writes to the same outputs, including the levels routed with RouteLevel, under the same locks,
+// and an asynchronous logger shares its background writer with the loggers derived from it.
+// Configuring the sub-logger afterwards doesn't affect this logger, and vice versa.
+//
+// If the logger registry is enabled, the sub-logger is registered under the combined name;
+// a logger already registered under that name is returned instead, as with NewLogger.
+//
+// Parameters:
+//   - suffix: The name of the component, appended to this logger's name
+//
+// Returns:
+//   - A pointer to the derived Logger (new or existing)
+//
+// Example:
+//
+//	api := logger.NewLogger("api")
+//	jwt := api.Named("auth").Named("jwt")
+//	jwt.Infof("token refreshed") // Output: "INFO [api.auth.jwt]: token refreshed"
+func (logger *Logger) Named(suffix string) *Logger {
+	name := suffix
+	if len(logger.name) > 0 && len(suffix) > 0 {
+		name = logger.name + logger.separator() + suffix
+	} else if len(suffix) == 0 {
+		name = logger.name
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if loggerRegistry != nil {
+		if existing, exists := loggerRegistry[name]; exists {
+			return existing
+		}
+	}
+
+	options := *logger.config()
+	// The parent owns its background writer, the derived logger only sends to it
+	options.stopAsync = nil
+
+	derived := &Logger{
+		name:           name,
+		out:            logger.out,
+		err:            logger.err,
+		syncOut:        logger.syncOut,
+		syncErr:        logger.syncErr,
+		routes:         logger.routes,
+		outTerminal:    logger.outTerminal,
+		errTerminal:    logger.errTerminal,
+		routeTerminals: logger.routeTerminals,
+		syncRoutes:     logger.syncRoutes,
+		nop:            logger.nop,
+		jsonKeys:       logger.jsonKeys,
+		traceIDKey:     logger.traceIDKey,
+		clock:          logger.clock,
+		nameSeparator:  logger.nameSeparator,
+		fields:         logger.fields,
+		encodedFields:  logger.encodedFields,
+		transform:      logger.transform,
+	}
+
+	derived.options.Store(&options)
+
+	for level := range logger.sampling.rates {
+		derived.sampling.rates[level].Store(logger.sampling.rates[level].Load())
+	}
+	derived.sampling.errors.Store(logger.sampling.errors.Load())
+
+	if loggerRegistry != nil && !logger.nop {
+		loggerRegistry[name] = derived
+	}
+
+	return derived
+}
+
+// WithNameSeparator sets the separator joining this logger's name and the suffixes passed to Named.
+// Loggers derived by Named inherit the separator.
+//
+// Parameters:
+//   - separator: The separator to use (empty restores the default ".")
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	api := logger.NewLogger("api").WithNameSeparator("/")
+//	api.Named("auth").Infof("started") // Output: "INFO [api/auth]: started"
+func (logger *Logger) WithNameSeparator(separator string) *Logger {
+	logger.nameSeparator = separator
+	return logger
+}
+
+// separator returns the separator joining the names of this logger and the loggers derived from it.
+//
+// Returns:
+//   - The configured separator, or "." if none is set
+func (logger *Logger) separator() string {
+	if len(logger.nameSeparator) == 0 {
+		return defaultNameSeparator
+	}
+	return logger.nameSeparator
+}
@@ -0,0 +1,126 @@
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestLogger_Named tests that the composed name appears in the output of derived loggers.
+func TestLogger_Named(t *testing.T) {
+	var buf bytes.Buffer
+	api := NewLogger("api").OutputTo(&buf)
+
+	jwt := api.Named("auth").Named("jwt")
+	if jwt.name != "api.auth.jwt" {
+		t.Errorf("Expected name api.auth.jwt, got %q", jwt.name)
+	}
+
+	jwt.Infof("token refreshed")
+	if buf.String() != "INFO [api.auth.jwt]: token refreshed\n" {
+		t.Errorf("Unexpected output: %q", buf.String())
+	}
+
+	buf.Reset()
+	_ = jwt.InfoJSONf(nil, "json")
+	jwt.InfoObjectf("object").Build()
+	if strings.Count(buf.String(), `"source":"api.auth.jwt"`) != 2 {
+		t.Errorf("Expected the composed name in JSON and object output, got %q", buf.String())
+	}
+}
+
+// TestLogger_Named_ParentUnaffected tests that derived loggers inherit options
+// and can be reconfigured without changing their parent.
+func TestLogger_Named_ParentUnaffected(t *testing.T) {
+	var buf bytes.Buffer
+	parent := NewLogger("api").OutputTo(&buf).WithLogLevel(INFO).WithSamplingPer(DEBUG, 3)
+
+	child := parent.Named("db")
+	if child.config().Level != INFO || child.sampling.rates[DEBUG].Load() != 3 {
+		t.Errorf("Expected the options to be inherited, got level %s", child.config().Level.String())
+	}
+
+	child.Debugf("hidden")
+	if buf.Len() != 0 {
+		t.Errorf("Expected the inherited level to filter DEBUG, got %q", buf.String())
+	}
+
+	child.WithLogLevel(TRACE).WithTimestampFormat("15:04")
+	if parent.config().Level != INFO || parent.config().Timestamp {
+		t.Error("Configuring the child should not affect the parent")
+	}
+
+	parent.Infof("parent")
+	if buf.String() != "INFO [api]: parent\n" {
+		t.Errorf("Expected the parent name to be unchanged, got %q", buf.String())
+	}
+}
+
+// TestLogger_Named_Separator tests custom separators and empty names.
+func TestLogger_Named_Separator(t *testing.T) {
+	api := NewLogger("api").WithNameSeparator("/")
+
+	if name := api.Named("auth").Named("jwt").name; name != "api/auth/jwt" {
+		t.Errorf("Expected the separator to be inherited, got %q", name)
+	}
+	if name := NewLogger("").Named("worker").name; name != "worker" {
+		t.Errorf("Expected an unnamed parent to contribute nothing, got %q", name)
+	}
+	if name := api.Named("").name; name != "api" {
+		t.Errorf("Expected an empty suffix to keep the name, got %q", name)
+	}
+}
+
+// TestLogger_Named_Registry tests that derived loggers are registered under the combined name.
+func TestLogger_Named_Registry(t *testing.T) {
+	loggerRegistry = nil
+	WithLoggerRegistry()
+	defer func() { loggerRegistry = nil }()
+
+	auth := NewLogger("api").Named("auth")
+
+	if GetLogger("api.auth") != auth {
+		t.Error("Expected the derived logger to be registered under the combined name")
+	}
+	if NewLogger("api").Named("auth") != auth {
+		t.Error("Expected Named to return the registered logger")
+	}
+}
+
+// TestLogger_Named_Async tests that a derived logger writes through the background writer of its parent,
+// and that enabling asynchronous mode on it doesn't stop the parent's writer.
+func TestLogger_Named_Async(t *testing.T) {
+	capture := &LogCapture{}
+	parent, cancel := NewLogger("api").OutputTo(capture).WithAsync(true, 10)
+	defer cancel()
+
+	child := parent.Named("db")
+	child.Infof("from child")
+
+	if _, ok := waitForEntry(capture, func(entry LogEntry) bool { return entry.Source == "api.db" }); !ok {
+		t.Fatal("Expected the child message to be written")
+	}
+
+	_, cancelChild := child.WithAsync(true, 10)
+	defer cancelChild()
+
+	parent.Infof("from parent")
+	if _, ok := waitForEntry(capture, func(entry LogEntry) bool { return entry.Message == "from parent" }); !ok {
+		t.Error("Expected the parent to keep writing")
+	}
+}
+
+// waitForEntry polls the capture for up to a second until an entry matches.
+func waitForEntry(capture *LogCapture, match func(LogEntry) bool) (LogEntry, bool) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		for _, entry := range capture.Entries() {
+			if match(entry) {
+				return entry, true
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return LogEntry{}, false
+}
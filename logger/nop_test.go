@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestNewNopLogger tests that a nop logger produces no output for any write path.
+func TestNewNopLogger(t *testing.T) {
+	logger := NewNopLogger()
+	if logger == nil {
+		t.Fatal("NewNopLogger() returned nil")
+	}
+
+	logger.Logf("plain")
+	logger.Infof("info")
+	logger.Errorf("error")
+	logger.InfoContext(context.Background(), "context")
+	logger.ErrorEveryf("key", time.Second, "every")
+
+	if err := logger.LogJSONf(map[string]int{"a": 1}, "json"); err != nil {
+		t.Errorf("LogJSONf on nop logger returned error: %v", err)
+	}
+
+	if builder := logger.LogObjectf("object"); builder != nil {
+		t.Error("LogObjectf on nop logger should return a nil builder")
+	}
+}
+
+// TestNewNopLogger_ObjectChaining tests that builders from a nop logger are safe to chain and build.
+func TestNewNopLogger_ObjectChaining(t *testing.T) {
+	logger := NewNopLogger()
+
+	logger.InfoObjectf("request").
+		AssignString("method", "GET").
+		AssignInt("status", 200).
+		NestedStart("nested").
+		AssignBool("ok", true).
+		NestedEnd().
+		Build()
+
+	logger.ErrorObjectf("failure").AssignBytes("payload", []byte{1}, Hex).Build()
+}
+
+// TestNewNopLogger_ZeroAllocations tests that logging through a nop logger allocates nothing.
+func TestNewNopLogger_ZeroAllocations(t *testing.T) {
+	logger := NewNopLogger()
+
+	allocations := testing.AllocsPerRun(100, func() {
+		logger.Logf("plain")
+		logger.Infof("info")
+		logger.Errorf("error")
+		logger.InfoObjectf("object").AssignString("key", "value").Build()
+	})
+
+	if allocations != 0 {
+		t.Errorf("Expected 0 allocations, got %v", allocations)
+	}
+}
+
+// TestObjectLogBuilder_NilFiltered tests that a filtered builder is safe to chain.
+func TestObjectLogBuilder_NilFiltered(t *testing.T) {
+	logger := NewLogger("test").WithLogLevel(ERROR)
+
+	logger.DebugObjectf("filtered").AssignString("key", "value").Build()
+}
@@ -1,9 +1,10 @@
 package logger
 
 import (
+	"bytes"
+	stdjson "encoding/json"
 	"github.com/0x626f/go-kit/json"
 	"sync"
-	"time"
 )
 
 // builderPool is a sync.Pool that recycles ObjectLogBuilder instances to achieve zero allocations.
@@ -18,12 +19,18 @@ var builderPool = &sync.Pool{
 	},
 }
 
+// jsonNull is the JSON null literal, written for nil values
+var jsonNull = []byte("null")
+
 // ObjectLogBuilder provides a fluent interface for constructing structured JSON logs with zero allocations.
 // It uses a pooled JSON encoder to build log output incrementally without heap allocations.
 //
 // The builder must be finalized with Build() to emit the log and return the builder to the pool.
 // Do NOT reuse a builder after calling Build().
 //
+// A nil builder, returned when a log is discarded by filtering, sampling, or a nop logger,
+// is safe to use: all methods are no-ops, so chains do not need a nil check.
+//
 // Example usage:
 //
 //	logger.InfoObjectf("HTTP request").
@@ -36,7 +43,7 @@ var builderPool = &sync.Pool{
 // Output JSON:
 //
 //	{
-//	  "Level": "INFO",
+//	  "level": "INFO",
 //	  "message": "HTTP request",
 //	  "object": {
 //	    "method": "GET",
@@ -48,10 +55,16 @@ var builderPool = &sync.Pool{
 type ObjectLogBuilder struct {
 	// logger is the parent Logger instance that will emit the log
 	logger *Logger
+	// options is the configuration the log is built with, loaded once when the builder is created
+	options *Config
 	// json is the pooled JSON encoder used to build the output
 	json *json.JSONEncoder
 	// level is the log level for this message
 	level LogLevel
+	// objectStart is the offset of the object in the encoded log, used by the field transform
+	objectStart int
+	// done cancels the Async send of the log when closed (nil for builders without a context)
+	done <-chan struct{}
 }
 
 // newObjectLogBuilder retrieves a builder from the pool and initializes it for a new log entry.
@@ -59,37 +72,65 @@ type ObjectLogBuilder struct {
 //
 // Parameters:
 //   - logger: The Logger instance that will emit this log
+//   - options: The configuration to build the log with
 //   - Level: The log Level for this entry
 //   - msg: The log message bytes (already formatted)
+//   - traceID: The trace ID read from the context, or "" for none
 //
 // Returns:
 //   - A pooled ObjectLogBuilder ready for field assignment
-func newObjectLogBuilder(logger *Logger, level LogLevel, msg []byte) *ObjectLogBuilder {
+func newObjectLogBuilder(logger *Logger, options *Config, level LogLevel, msg []byte, traceID string) *ObjectLogBuilder {
 	instance := builderPool.Get().(*ObjectLogBuilder)
 	instance.logger = logger
+	instance.options = options
 	instance.level = level
 
+	keys := logger.keys()
 	instance.json.AppendObjectStart()
 
 	// insert log Level
-	instance.json.AppendKey("Level").AppendString(level.String()).AppendDelimiter()
+	instance.json.AppendKey(keys.Level).AppendString(level.String()).AppendDelimiter()
+	// insert OpenTelemetry severity number
+	if severity := level.SeverityNumber(); options.SeverityNumber && severity > 0 {
+		instance.json.AppendKey(severityNumberKey).AppendInt(severity).AppendDelimiter()
+	}
 	// insert Timestamp
-	if instance.logger.options.Timestamp {
-		timestamp := time.Now().Format(logger.options.TimestampFormat)
-		instance.json.AppendKey("Timestamp").AppendString(timestamp).AppendDelimiter()
+	if options.Timestamp {
+		timestamp := logger.timestamp(options)
+		instance.json.AppendKey(keys.Timestamp).AppendString(timestamp).AppendDelimiter()
 	}
 
 	// insert a source of the log
 	if len(logger.name) > 0 {
-		instance.json.AppendKey("source").AppendString(logger.name).AppendDelimiter()
+		instance.json.AppendKey(keys.Source).AppendString(logger.name).AppendDelimiter()
 	}
 
 	// insert log message
 	if len(msg) > 0 {
-		instance.json.AppendKey("message").AppendBytes(msg).AppendDelimiter()
+		instance.json.AppendKey(keys.Message).AppendBytes(msg).AppendDelimiter()
+	}
+
+	// insert trace ID
+	if len(traceID) > 0 {
+		instance.json.AppendKey(traceIDField).AppendString(traceID).AppendDelimiter()
+	}
+
+	// insert stack trace
+	if stack := logger.captureStack(options, level); len(stack) > 0 {
+		if raw, err := stdjson.Marshal(stack); err == nil {
+			instance.json.AppendKey("stack").AppendObject(raw).AppendDelimiter()
+		}
+	}
+
+	instance.json.AppendKey(keys.Object)
+	instance.objectStart = len(instance.json.Data())
+	instance.json.AppendObjectStart()
+
+	// insert base fields, the delimiter before the first Assign* call is added by the encoder
+	if len(logger.encodedFields) > 0 {
+		instance.json.AppendObject(logger.encodedFields)
 	}
 
-	instance.json.AppendKey("object").AppendObjectStart()
 	return instance
 }
 
@@ -104,15 +145,36 @@ func newObjectLogBuilder(logger *Logger, level LogLevel, msg []byte) *ObjectLogB
 //	    AssignInt("duration_sec", 42).
 //	    Build() // Emits the log and recycles the builder
 func (builder *ObjectLogBuilder) Build() {
-	builder.json.AppendObjectEnd().AppendObjectEnd().AppendNewLine()
+	if builder == nil {
+		return
+	}
 
-	if builder.logger.options.Async {
-		builder.logger.sendToChannelByLevel(builder.level, builder.json.Data())
+	builder.json.AppendObjectEnd()
+
+	if transform := builder.logger.transform; transform != nil {
+		data := builder.json.Data()
+		log := append([]byte(nil), data[:builder.objectStart]...)
+		log = append(log, transformFields(transform, data[builder.objectStart:])...)
+		log = append(log, '}', '\n')
+
+		if builder.options.Async {
+			builder.logger.sendToChannelUntil(builder.options, builder.level, log, builder.done)
+		} else {
+			builder.logger.writeByLevel(builder.level, log)
+		}
 	} else {
-		builder.logger.writeByLevel(builder.level, builder.json.Data())
+		builder.json.AppendObjectEnd().AppendNewLine()
+
+		if builder.options.Async {
+			// the buffer is reused once the builder returns to the pool, so the queued log needs its own copy
+			builder.logger.sendToChannelUntil(builder.options, builder.level, append([]byte(nil), builder.json.Data()...), builder.done)
+		} else {
+			builder.logger.writeByLevel(builder.level, builder.json.Data())
+		}
 	}
 
 	builder.json.Clear()
+	builder.done = nil
 	builderPool.Put(builder)
 }
 
@@ -130,6 +192,10 @@ func (builder *ObjectLogBuilder) Build() {
 //	builder.AssignString("username", "alice")
 //	// Produces: "username":"alice"
 func (builder *ObjectLogBuilder) AssignString(name string, value string) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendString(value)
 	return builder
 }
@@ -149,10 +215,57 @@ func (builder *ObjectLogBuilder) AssignString(name string, value string) *Object
 //	builder.AssignByte("initial", 'A')
 //	// Produces: "initial":"A"
 func (builder *ObjectLogBuilder) AssignByte(name string, value byte) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendByte(value)
 	return builder
 }
 
+// AssignBytes adds a binary field to the log object using the given encoding.
+// All encodings write directly into the builder's buffer without allocations; Raw bytes are escaped
+// as a JSON string, so quotes, control characters and invalid UTF-8 keep the log valid JSON.
+// A nil slice is written as JSON null.
+//
+// Parameters:
+//   - name: The field name
+//   - value: The byte slice
+//   - encoding: The encoding to use (Raw, Hex, or Base64)
+//
+// Returns:
+//   - The builder for method chaining
+//
+// Example:
+//
+//	builder.AssignBytes("digest", []byte{0xca, 0xfe}, logger.Hex)
+//	// Produces: "digest":"cafe"
+//	builder.AssignBytes("payload", []byte("hi"), logger.Base64)
+//	// Produces: "payload":"aGk="
+func (builder *ObjectLogBuilder) AssignBytes(name string, value []byte, encoding ByteEncoding) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
+	builder.json.AppendDelimiter().AppendKey(name)
+
+	if value == nil {
+		builder.json.AppendObject(jsonNull)
+		return builder
+	}
+
+	switch encoding {
+	case Hex:
+		builder.json.AppendHexBytes(value)
+	case Base64:
+		builder.json.AppendBase64Bytes(value)
+	default:
+		builder.json.AppendEscapedBytes(value)
+	}
+
+	return builder
+}
+
 // AssignBool adds a boolean field to the log object.
 //
 // Parameters:
@@ -167,6 +280,10 @@ func (builder *ObjectLogBuilder) AssignByte(name string, value byte) *ObjectLogB
 //	builder.AssignBool("success", true)
 //	// Produces: "success":true
 func (builder *ObjectLogBuilder) AssignBool(name string, value bool) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendBool(value)
 	return builder
 }
@@ -185,6 +302,10 @@ func (builder *ObjectLogBuilder) AssignBool(name string, value bool) *ObjectLogB
 //	builder.AssignInt("count", 42)
 //	// Produces: "count":42
 func (builder *ObjectLogBuilder) AssignInt(name string, value int) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter()
 	builder.json.AppendKey(name)
 	builder.json.AppendInt(value)
@@ -201,6 +322,10 @@ func (builder *ObjectLogBuilder) AssignInt(name string, value int) *ObjectLogBui
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt8(name string, value int8) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt8(value)
 	return builder
 }
@@ -214,6 +339,10 @@ func (builder *ObjectLogBuilder) AssignInt8(name string, value int8) *ObjectLogB
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt16(name string, value int16) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt16(value)
 	return builder
 }
@@ -227,6 +356,10 @@ func (builder *ObjectLogBuilder) AssignInt16(name string, value int16) *ObjectLo
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt32(name string, value int32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt32(value)
 	return builder
 }
@@ -240,6 +373,10 @@ func (builder *ObjectLogBuilder) AssignInt32(name string, value int32) *ObjectLo
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt64(name string, value int64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt64(value)
 	return builder
 }
@@ -253,6 +390,10 @@ func (builder *ObjectLogBuilder) AssignInt64(name string, value int64) *ObjectLo
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt(name string, value uint) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt(value)
 	return builder
 }
@@ -266,6 +407,10 @@ func (builder *ObjectLogBuilder) AssignUInt(name string, value uint) *ObjectLogB
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt8(name string, value uint8) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt8(value)
 	return builder
 }
@@ -279,6 +424,10 @@ func (builder *ObjectLogBuilder) AssignUInt8(name string, value uint8) *ObjectLo
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt16(name string, value uint16) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt16(value)
 	return builder
 }
@@ -292,6 +441,10 @@ func (builder *ObjectLogBuilder) AssignUInt16(name string, value uint16) *Object
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt32(name string, value uint32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt32(value)
 	return builder
 }
@@ -305,6 +458,10 @@ func (builder *ObjectLogBuilder) AssignUInt32(name string, value uint32) *Object
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt64(name string, value uint64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt64(value)
 	return builder
 }
@@ -324,6 +481,10 @@ func (builder *ObjectLogBuilder) AssignUInt64(name string, value uint64) *Object
 //	builder.AssignFloat32("temperature", 98.6)
 //	// Produces: "temperature":98.6
 func (builder *ObjectLogBuilder) AssignFloat32(name string, value float32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendFloat32(value)
 	return builder
 }
@@ -343,6 +504,10 @@ func (builder *ObjectLogBuilder) AssignFloat32(name string, value float32) *Obje
 //	builder.AssignFloat64("duration_ms", 125.437)
 //	// Produces: "duration_ms":125.437
 func (builder *ObjectLogBuilder) AssignFloat64(name string, value float64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendFloat64(value)
 	return builder
 }
@@ -361,6 +526,10 @@ func (builder *ObjectLogBuilder) AssignFloat64(name string, value float64) *Obje
 //	builder.AssignStringArray("tags", []string{"urgent", "bug", "security"})
 //	// Produces: "tags":["urgent","bug","security"]
 func (builder *ObjectLogBuilder) AssignStringArray(name string, value []string) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendStringArray(value)
 	return builder
 }
@@ -380,6 +549,10 @@ func (builder *ObjectLogBuilder) AssignStringArray(name string, value []string)
 //	builder.AssignByteArray("chars", []byte{'A', 'B', 'C'})
 //	// Produces: "chars":["A","B","C"]
 func (builder *ObjectLogBuilder) AssignByteArray(name string, value []byte) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendByteArray(value)
 	return builder
 }
@@ -398,6 +571,10 @@ func (builder *ObjectLogBuilder) AssignByteArray(name string, value []byte) *Obj
 //	builder.AssignBoolArray("flags", []bool{true, false, true})
 //	// Produces: "flags":[true,false,true]
 func (builder *ObjectLogBuilder) AssignBoolArray(name string, value []bool) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendBoolArray(value)
 	return builder
 }
@@ -416,6 +593,10 @@ func (builder *ObjectLogBuilder) AssignBoolArray(name string, value []bool) *Obj
 //	builder.AssignIntArray("scores", []int{95, 87, 92})
 //	// Produces: "scores":[95,87,92]
 func (builder *ObjectLogBuilder) AssignIntArray(name string, value []int) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendIntArray(value)
 	return builder
 }
@@ -429,6 +610,10 @@ func (builder *ObjectLogBuilder) AssignIntArray(name string, value []int) *Objec
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt8Array(name string, value []int8) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt8Array(value)
 	return builder
 }
@@ -442,6 +627,10 @@ func (builder *ObjectLogBuilder) AssignInt8Array(name string, value []int8) *Obj
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt16Array(name string, value []int16) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt16Array(value)
 	return builder
 }
@@ -455,6 +644,10 @@ func (builder *ObjectLogBuilder) AssignInt16Array(name string, value []int16) *O
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignInt32Array(name string, value []int32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt32Array(value)
 	return builder
 }
@@ -469,6 +662,10 @@ func (builder *ObjectLogBuilder) AssignInt32Array(name string, value []int32) *O
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignIn64Array(name string, value []int64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendInt64Array(value)
 	return builder
 }
@@ -482,6 +679,10 @@ func (builder *ObjectLogBuilder) AssignIn64Array(name string, value []int64) *Ob
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUIntArray(name string, value []uint) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUIntArray(value)
 	return builder
 }
@@ -495,6 +696,10 @@ func (builder *ObjectLogBuilder) AssignUIntArray(name string, value []uint) *Obj
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt8Array(name string, value []uint8) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt8Array(value)
 	return builder
 }
@@ -508,6 +713,10 @@ func (builder *ObjectLogBuilder) AssignUInt8Array(name string, value []uint8) *O
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt16Array(name string, value []uint16) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt16Array(value)
 	return builder
 }
@@ -521,6 +730,10 @@ func (builder *ObjectLogBuilder) AssignUInt16Array(name string, value []uint16)
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt32Array(name string, value []uint32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt32Array(value)
 	return builder
 }
@@ -534,6 +747,10 @@ func (builder *ObjectLogBuilder) AssignUInt32Array(name string, value []uint32)
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) AssignUInt64Array(name string, value []uint64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendUInt64Array(value)
 	return builder
 }
@@ -552,6 +769,10 @@ func (builder *ObjectLogBuilder) AssignUInt64Array(name string, value []uint64)
 //	builder.AssignFloat32Array("temps", []float32{98.6, 99.1, 97.8})
 //	// Produces: "temps":[98.6,99.1,97.8]
 func (builder *ObjectLogBuilder) AssignFloat32Array(name string, value []float32) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendFloat32Array(value)
 	return builder
 }
@@ -570,10 +791,94 @@ func (builder *ObjectLogBuilder) AssignFloat32Array(name string, value []float32
 //	builder.AssignFloat64Array("metrics", []float64{0.95, 0.87, 0.92})
 //	// Produces: "metrics":[0.95,0.87,0.92]
 func (builder *ObjectLogBuilder) AssignFloat64Array(name string, value []float64) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendFloat64Array(value)
 	return builder
 }
 
+// AssignJSON adds a field holding pre-serialized JSON, such as a payload received from another service.
+// Valid JSON is embedded verbatim without re-encoding or escaping; only line breaks are removed,
+// by compacting it, so the log stays on a single line. Bytes that aren't valid JSON are written
+// as an escaped JSON string instead, keeping the log parseable. A nil or empty value is written as null.
+//
+// Parameters:
+//   - name: The field name
+//   - raw: The JSON value
+//
+// Returns:
+//   - The builder for method chaining
+//
+// Example:
+//
+//	builder.AssignJSON("payload", json.RawMessage(`{"id":7,"tags":["a"]}`))
+//	// Produces: "payload":{"id":7,"tags":["a"]}
+func (builder *ObjectLogBuilder) AssignJSON(name string, raw stdjson.RawMessage) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
+	builder.json.AppendDelimiter().AppendKey(name)
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		builder.json.AppendObject(jsonNull)
+		return builder
+	}
+
+	if !stdjson.Valid(raw) {
+		quoted, _ := stdjson.Marshal(string(raw))
+		builder.json.AppendObject(quoted)
+		return builder
+	}
+
+	if bytes.ContainsAny(raw, "\r\n") {
+		var compacted bytes.Buffer
+		if stdjson.Compact(&compacted, raw) == nil {
+			raw = compacted.Bytes()
+		}
+	}
+
+	builder.json.AppendObject(raw)
+	return builder
+}
+
+// AssignError adds a field describing an error: its message and the messages of the errors it wraps,
+// unwrapped one layer at a time with errors.Unwrap (the same form as the error field of ErrorWithJSONf).
+// A nil error is written as null.
+//
+// Parameters:
+//   - name: The field name, conventionally "error"
+//   - err: The error to describe
+//
+// Returns:
+//   - The builder for method chaining
+//
+// Example:
+//
+//	builder.AssignError("error", fmt.Errorf("load user: %w", sql.ErrNoRows))
+//	// Produces: "error":{"message":"load user: sql: no rows in result set","chain":["sql: no rows in result set"]}
+func (builder *ObjectLogBuilder) AssignError(name string, err error) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
+	builder.json.AppendDelimiter().AppendKey(name)
+
+	if err == nil {
+		builder.json.AppendObject(jsonNull)
+		return builder
+	}
+
+	builder.json.AppendObjectStart().AppendKey("message").AppendString(err.Error())
+	if chain := errorChain(err); len(chain) > 0 {
+		builder.json.AppendDelimiter().AppendKey("chain").AppendStringArray(chain)
+	}
+	builder.json.AppendObjectEnd()
+	return builder
+}
+
 // NestedStart begins a nested object field.
 // Must be paired with NestedEnd() to close the nested object.
 //
@@ -591,6 +896,10 @@ func (builder *ObjectLogBuilder) AssignFloat64Array(name string, value []float64
 //	    NestedEnd()
 //	// Produces: "request":{"method":"GET","status":200}
 func (builder *ObjectLogBuilder) NestedStart(name string) *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendDelimiter().AppendKey(name).AppendObjectStart()
 	return builder
 }
@@ -600,6 +909,10 @@ func (builder *ObjectLogBuilder) NestedStart(name string) *ObjectLogBuilder {
 // Returns:
 //   - The builder for method chaining
 func (builder *ObjectLogBuilder) NestedEnd() *ObjectLogBuilder {
+	if builder == nil {
+		return nil
+	}
+
 	builder.json.AppendObjectEnd()
 	return builder
 }
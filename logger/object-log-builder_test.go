@@ -2,6 +2,8 @@ package logger
 
 import (
 	"bytes"
+	stdjson "encoding/json"
+	"errors"
 	"io"
 	"strings"
 	"testing"
@@ -25,6 +27,24 @@ func BenchmarkObjectLogBuilder_AssignString(b *testing.B) {
 	}
 }
 
+// BenchmarkObjectLogBuilder_AssignJSON validates zero allocations for compact JSON
+func BenchmarkObjectLogBuilder_AssignJSON(b *testing.B) {
+	logger := NewLogger("BenchmarkObjectLogBuilder_AssignJSON").OutputTo(io.Discard).WithLogLevel(INFO)
+	payload := stdjson.RawMessage(`{"id":7,"tags":["a","b"],"nested":{"ok":true}}`)
+
+	// Warm up the pool
+	logger.InfoObjectf("warmup").AssignJSON("payload", payload).Build()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		logger.InfoObjectf("test message").
+			AssignJSON("payload", payload).
+			Build()
+	}
+}
+
 // BenchmarkObjectLogBuilder_AssignInt validates zero allocations
 func BenchmarkObjectLogBuilder_AssignInt(b *testing.B) {
 	logger := NewLogger("BenchmarkObjectLogBuilder_AssignInt").OutputTo(io.Discard).WithLogLevel(INFO)
@@ -294,6 +314,91 @@ func TestObjectLogBuilder_AssignByte(t *testing.T) {
 	}
 }
 
+// Test AssignBytes
+func TestObjectLogBuilder_AssignBytes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("TestObjectLogBuilder_AssignBytes").OutputTo(&buf).WithLogLevel(INFO)
+
+	logger.InfoObjectf("test").
+		AssignBytes("hex", []byte{0xde, 0xad, 0xbe, 0xef}, Hex).
+		AssignBytes("base64", []byte("hello"), Base64).
+		AssignBytes("raw", []byte("plain"), Raw).
+		AssignBytes("nil", nil, Hex).
+		AssignBytes("empty", []byte{}, Base64).
+		Build()
+
+	output := buf.String()
+	expected := []string{`"hex":"deadbeef"`, `"base64":"aGVsbG8="`, `"raw":"plain"`, `"nil":null`, `"empty":""`}
+	for _, field := range expected {
+		if !strings.Contains(output, field) {
+			t.Errorf("Expected %s in output, got: %s", field, output)
+		}
+	}
+}
+
+// Test AssignBytes with Raw bytes that need escaping
+func TestObjectLogBuilder_AssignBytes_RawEscaped(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("TestObjectLogBuilder_AssignBytes_RawEscaped").OutputTo(&buf).WithLogLevel(INFO)
+
+	logger.InfoObjectf("test").
+		AssignBytes("raw", []byte("say \"hi\"\\\n\t\x01caf\xc3\xa9\xff"), Raw).
+		Build()
+
+	var log struct {
+		Object struct {
+			Raw string `json:"raw"`
+		} `json:"object"`
+	}
+	if err := stdjson.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Expected valid JSON, got %q: %v", buf.String(), err)
+	}
+
+	if expected := "say \"hi\"\\\n\t\x01caf\u00e9\ufffd"; log.Object.Raw != expected {
+		t.Errorf("Expected %q, got %q", expected, log.Object.Raw)
+	}
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("Expected a single line, got: %q", buf.String())
+	}
+}
+
+// Test AssignJSON
+func TestObjectLogBuilder_AssignJSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("TestObjectLogBuilder_AssignJSON").OutputTo(&buf).WithLogLevel(INFO)
+
+	logger.InfoObjectf("test").
+		AssignJSON("payload", stdjson.RawMessage(`{"text":"say \"hi\"","path":"a\\b","tags":["<x>"]}`)).
+		AssignJSON("formatted", stdjson.RawMessage("{\n  \"id\": 7\n}")).
+		AssignJSON("number", stdjson.RawMessage(`42`)).
+		AssignJSON("nil", nil).
+		AssignJSON("empty", stdjson.RawMessage{}).
+		AssignJSON("invalid", stdjson.RawMessage(`{"broken`)).
+		Build()
+
+	output := buf.String()
+	expected := []string{
+		`"payload":{"text":"say \"hi\"","path":"a\\b","tags":["<x>"]}`,
+		`"formatted":{"id":7}`,
+		`"number":42`,
+		`"nil":null`,
+		`"empty":null`,
+		`"invalid":"{\"broken"`,
+	}
+	for _, field := range expected {
+		if !strings.Contains(output, field) {
+			t.Errorf("Expected %s in output, got: %s", field, output)
+		}
+	}
+
+	if strings.Count(output, "\n") != 1 {
+		t.Errorf("Expected a single line, got: %q", output)
+	}
+	if !stdjson.Valid([]byte(output)) {
+		t.Errorf("Expected valid JSON, got: %s", output)
+	}
+}
+
 // Test AssignBool
 func TestObjectLogBuilder_AssignBool(t *testing.T) {
 	var buf bytes.Buffer
@@ -848,10 +953,39 @@ func TestObjectLogBuilder_AllLogLevels(t *testing.T) {
 				builder.AssignString("key", "value").Build()
 
 				output := buf.String()
-				if tt.expected != "" && !strings.Contains(output, `"Level":"`+tt.expected+`"`) {
+				if tt.expected != "" && !strings.Contains(output, `"level":"`+tt.expected+`"`) {
 					t.Errorf("Expected Level %s in output, got: %s", tt.expected, output)
 				}
 			}
 		})
 	}
 }
+
+func TestObjectLogBuilder_AssignError(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("TestObjectLogBuilder_AssignError").OutputTo(&buf).ErrorsTo(&buf)
+
+	logger.ErrorObjectf("failed").
+		AssignError("error", wrappedError()).
+		AssignError("plain", errors.New("timeout")).
+		AssignError("none", nil).
+		AssignInt("id", 42).
+		Build()
+
+	output := buf.String()
+	expected := []string{
+		`"error":{"message":"load user 42: query users: connection refused","chain":["query users: connection refused","connection refused"]}`,
+		`"plain":{"message":"timeout"}`,
+		`"none":null`,
+		`"id":42`,
+	}
+	for _, field := range expected {
+		if !strings.Contains(output, field) {
+			t.Errorf("Expected %s in output, got: %s", field, output)
+		}
+	}
+
+	if !stdjson.Valid(bytes.TrimSpace(buf.Bytes())) {
+		t.Errorf("Expected valid JSON, got: %s", output)
+	}
+}
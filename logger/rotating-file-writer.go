@@ -0,0 +1,318 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// compressedSuffix is appended to the names of rotated files compressed with gzip.
+const compressedSuffix = ".gz"
+
+// RotatingFileWriter is an io.Writer that writes to a file and rotates it once it exceeds a maximum size.
+// Rotated files are renamed with a numeric suffix, where ".1" is the most recent one:
+//
+//	app.log    <- current file
+//	app.log.1  <- previous file
+//	app.log.2  <- file before the previous one
+//
+// At most maxBackups rotated files are kept; older ones are removed.
+// If compression is enabled, rotated files are gzip-compressed and get an additional ".gz" suffix.
+//
+// The writer is safe for concurrent use and can be passed to Logger.OutputTo and Logger.ErrorsTo.
+//
+// Example usage:
+//
+//	writer, err := logger.NewRotatingFileWriter("app.log", 10<<20, 5)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer writer.Close()
+//
+//	log := logger.NewLogger("app").OutputTo(writer.WithCompression(true))
+//	log.Infof("Written to a rotating file")
+type RotatingFileWriter struct {
+	// path is the path of the current log file
+	path string
+	// maxSize is the size in bytes after which the file is rotated
+	maxSize int64
+	// maxBackups is the number of rotated files to keep
+	maxBackups int
+	// compress enables gzip compression of rotated files
+	compress bool
+	// file is the currently open log file
+	file *os.File
+	// size is the number of bytes written to the current file
+	size int64
+	// mutex guards the file and rotation state
+	mutex sync.Mutex
+}
+
+// NewRotatingFileWriter opens (or creates) the file at path for appending and returns a writer
+// that rotates it when it grows beyond maxSize bytes.
+//
+// Parameters:
+//   - path: The path of the log file
+//   - maxSize: The maximum size of the file in bytes before it is rotated (must be positive)
+//   - maxBackups: The number of rotated files to keep (0 discards rotated files)
+//
+// Returns:
+//   - A pointer to the RotatingFileWriter
+//   - Error if the arguments are invalid or the file cannot be opened
+//
+// Example:
+//
+//	writer, err := logger.NewRotatingFileWriter("/var/log/app.log", 50<<20, 3)
+func NewRotatingFileWriter(path string, maxSize int64, maxBackups int) (*RotatingFileWriter, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("max size must be positive")
+	}
+
+	if maxBackups < 0 {
+		return nil, errors.New("max backups must not be negative")
+	}
+
+	writer := &RotatingFileWriter{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := writer.open(); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// WithCompression enables or disables gzip compression of rotated files.
+//
+// Parameters:
+//   - option: If true, rotated files are compressed and get a ".gz" suffix
+//
+// Returns:
+//   - The writer for method chaining
+func (writer *RotatingFileWriter) WithCompression(option bool) *RotatingFileWriter {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	writer.compress = option
+	return writer
+}
+
+// Write appends data to the current file, rotating it first if the data would exceed the maximum size.
+// A single write larger than the maximum size is written to a fresh file as a whole.
+//
+// Parameters:
+//   - data: The bytes to write
+//
+// Returns:
+//   - The number of bytes written
+//   - Error if the writer is closed, rotation fails, or the write fails
+func (writer *RotatingFileWriter) Write(data []byte) (int, error) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if writer.size > 0 && writer.size+int64(len(data)) > writer.maxSize {
+		if err := writer.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	written, err := writer.file.Write(data)
+	writer.size += int64(written)
+
+	return written, err
+}
+
+// Rotate forces a rotation of the current file regardless of its size.
+//
+// Returns:
+//   - Error if the writer is closed or rotation fails
+func (writer *RotatingFileWriter) Rotate() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.file == nil {
+		return os.ErrClosed
+	}
+
+	return writer.rotate()
+}
+
+// Sync commits the current file contents to stable storage.
+//
+// Returns:
+//   - Error if the writer is closed or the sync fails
+func (writer *RotatingFileWriter) Sync() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.file == nil {
+		return os.ErrClosed
+	}
+
+	return writer.file.Sync()
+}
+
+// Close flushes and closes the current file. Subsequent writes return os.ErrClosed.
+//
+// Returns:
+//   - Error if flushing or closing the file fails
+func (writer *RotatingFileWriter) Close() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.file == nil {
+		return nil
+	}
+
+	err := errors.Join(writer.file.Sync(), writer.file.Close())
+	writer.file = nil
+
+	return err
+}
+
+// open opens the log file for appending and records its current size.
+//
+// Returns:
+//   - Error if the file cannot be opened or inspected
+func (writer *RotatingFileWriter) open() error {
+	file, err := os.OpenFile(writer.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	writer.file = file
+	writer.size = info.Size()
+
+	return nil
+}
+
+// rotate closes the current file, shifts the rotated files, and opens a fresh file.
+// Must be called with the mutex held.
+//
+// Returns:
+//   - Error if any file operation fails
+func (writer *RotatingFileWriter) rotate() error {
+	if err := errors.Join(writer.file.Sync(), writer.file.Close()); err != nil {
+		return err
+	}
+	writer.file = nil
+
+	// reopen the file even if shifting failed, so logging can continue
+	return errors.Join(writer.shift(), writer.open())
+}
+
+// shift renames rotated files to make room for the current one, removing files beyond maxBackups,
+// and moves the current file to the ".1" slot (compressing it if enabled).
+//
+// Returns:
+//   - Error if any rename, removal, or compression fails
+func (writer *RotatingFileWriter) shift() error {
+	if writer.maxBackups == 0 {
+		return os.Remove(writer.path)
+	}
+
+	for _, suffix := range []string{"", compressedSuffix} {
+		if err := removeIfExists(writer.backup(writer.maxBackups) + suffix); err != nil {
+			return err
+		}
+	}
+
+	for index := writer.maxBackups - 1; index > 0; index-- {
+		for _, suffix := range []string{"", compressedSuffix} {
+			if err := renameIfExists(writer.backup(index)+suffix, writer.backup(index+1)+suffix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.Rename(writer.path, writer.backup(1)); err != nil {
+		return err
+	}
+
+	if writer.compress {
+		return compressFile(writer.backup(1))
+	}
+
+	return nil
+}
+
+// backup returns the path of the rotated file with the given index.
+//
+// Parameters:
+//   - index: The index of the rotated file (1 is the most recent)
+//
+// Returns:
+//   - The path of the rotated file without compression suffix
+func (writer *RotatingFileWriter) backup(index int) string {
+	return writer.path + "." + strconv.Itoa(index)
+}
+
+// compressFile gzip-compresses the file at path into path + ".gz" and removes the original.
+// The compressed data is written to a temporary file that is renamed once complete,
+// so a partially compressed file never appears under the final name.
+//
+// Parameters:
+//   - path: The path of the file to compress
+//
+// Returns:
+//   - Error if reading, compressing, or renaming fails
+func compressFile(path string) error {
+	source, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	temporary := path + compressedSuffix + ".tmp"
+	target, err := os.OpenFile(temporary, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		_ = source.Close()
+		return err
+	}
+
+	compressor := gzip.NewWriter(target)
+	_, err = io.Copy(compressor, source)
+	err = errors.Join(err, compressor.Close(), target.Sync(), target.Close(), source.Close())
+
+	if err != nil {
+		_ = os.Remove(temporary)
+		return err
+	}
+
+	if err = os.Rename(temporary, path+compressedSuffix); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// removeIfExists removes the file at path, ignoring the error if it does not exist.
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// renameIfExists renames the file at source to target, ignoring the error if source does not exist.
+func renameIfExists(source, target string) error {
+	if err := os.Rename(source, target); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestNewRotatingFileWriter_InvalidArguments tests that invalid sizes and backup counts are rejected.
+func TestNewRotatingFileWriter_InvalidArguments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	if _, err := NewRotatingFileWriter(path, 0, 1); err == nil {
+		t.Error("Expected error for zero max size")
+	}
+	if _, err := NewRotatingFileWriter(path, 10, -1); err == nil {
+		t.Error("Expected error for negative max backups")
+	}
+}
+
+// TestRotatingFileWriter_Rollover tests that writing past the threshold creates rollover files
+// and that only maxBackups rotated files are kept.
+func TestRotatingFileWriter_Rollover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	writer, err := NewRotatingFileWriter(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+
+	for _, line := range []string{"first-01\n", "second-2\n", "third-03\n", "fourth-4\n"} {
+		if _, err := writer.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[string]string{
+		path:        "fourth-4\n",
+		path + ".1": "third-03\n",
+		path + ".2": "second-2\n",
+	}
+
+	for file, content := range expected {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Expected rollover file %s: %v", file, err)
+		}
+		if string(data) != content {
+			t.Errorf("Expected %s to contain %q, got %q", file, content, string(data))
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("Expected rotated files beyond max backups to be removed")
+	}
+}
+
+// TestRotatingFileWriter_Compression tests that rotated files are gzip-compressed.
+func TestRotatingFileWriter_Compression(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	writer, err := NewRotatingFileWriter(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	writer.WithCompression(true)
+
+	_, _ = writer.Write([]byte("compressed\n"))
+	_, _ = writer.Write([]byte("current\n"))
+
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Error("Expected uncompressed rotated file to be removed")
+	}
+
+	file, err := os.Open(path + ".1.gz")
+	if err != nil {
+		t.Fatalf("Expected compressed rollover file: %v", err)
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "compressed\n" {
+		t.Errorf("Expected decompressed content %q, got %q", "compressed\n", string(data))
+	}
+}
+
+// TestRotatingFileWriter_WithLogger tests that the writer can be used as a logger output.
+func TestRotatingFileWriter_WithLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	writer, err := NewRotatingFileWriter(path, 64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := NewLogger("rotating").OutputTo(writer)
+	for i := 0; i < 10; i++ {
+		logger.Infof("message %d", i)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	current, _ := os.ReadFile(path)
+	if !strings.Contains(string(current), "message 9") {
+		t.Errorf("Expected latest message in current file, got %q", string(current))
+	}
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Errorf("Expected rollover file: %v", err)
+	}
+
+	if _, err := writer.Write([]byte("closed")); err != os.ErrClosed {
+		t.Errorf("Expected os.ErrClosed after Close, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package logger
+
+import "sync/atomic"
+
+// sampling keeps per-level sampling rates and message counters.
+// A rate of n means that only every nth message of that level is emitted.
+// Rates of 0 and 1 disable sampling for the level.
+//
+// Counters are atomic, so concurrent callers share a single sequence per level
+// and exactly one message out of every n is emitted.
+type sampling struct {
+	// rates holds the sampling rate for each log level
+	rates [NONE]atomic.Uint64
+	// counters holds the number of messages seen for each log level
+	counters [NONE]atomic.Uint64
+	// errors enables sampling for ERROR level messages, which are exempt by default
+	errors atomic.Bool
+}
+
+// sample reports whether a message at the given level should be emitted.
+//
+// Parameters:
+//   - level: The log level of the message
+//
+// Returns:
+//   - true if the message passes sampling, false if it should be discarded
+func (sampling *sampling) sample(level LogLevel) bool {
+	if level >= NONE {
+		return true
+	}
+
+	if level == ERROR && !sampling.errors.Load() {
+		return true
+	}
+
+	rate := sampling.rates[level].Load()
+	if rate <= 1 {
+		return true
+	}
+
+	return (sampling.counters[level].Add(1)-1)%rate == 0
+}
+
+// WithSampling enables sampling for all log levels, emitting only every nth message per level.
+// Each level keeps its own counter, so a burst of INFO messages does not affect DEBUG sampling.
+// ERROR messages are exempt unless enabled with WithErrorSampling.
+// Messages logged without a level (Logf, LogJSONf, LogObjectf) are never sampled.
+//
+// Parameters:
+//   - n: The sampling rate; values of 0 or 1 disable sampling
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("worker").WithSampling(100)
+//	for i := 0; i < 1000; i++ {
+//	    logger.Infof("processing item %d", i) // Only 10 messages are emitted
+//	}
+func (logger *Logger) WithSampling(n int) *Logger {
+	for level := ERROR; level < NONE; level++ {
+		logger.WithSamplingPer(level, n)
+	}
+	return logger
+}
+
+// WithSamplingPer enables sampling for a single log level, emitting only every nth message.
+// ERROR messages are exempt unless enabled with WithErrorSampling.
+//
+// Parameters:
+//   - level: The log level to sample (NONE is ignored)
+//   - n: The sampling rate; values of 0 or 1 disable sampling
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("worker").
+//	    WithSamplingPer(logger.DEBUG, 1000).
+//	    WithSamplingPer(logger.INFO, 10)
+func (logger *Logger) WithSamplingPer(level LogLevel, n int) *Logger {
+	if level >= NONE {
+		return logger
+	}
+
+	if n < 0 {
+		n = 0
+	}
+
+	logger.sampling.rates[level].Store(uint64(n))
+	logger.sampling.counters[level].Store(0)
+	return logger
+}
+
+// WithErrorSampling includes ERROR messages in sampling.
+// By default ERROR messages are always emitted, even when a sampling rate is configured for them.
+//
+// Parameters:
+//   - option: If true, ERROR messages are sampled like any other level
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("worker").WithSampling(10).WithErrorSampling(true)
+func (logger *Logger) WithErrorSampling(option bool) *Logger {
+	logger.sampling.errors.Store(option)
+	return logger
+}
@@ -0,0 +1,129 @@
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestLogger_WithSampling tests that only every nth message is emitted per level.
+func TestLogger_WithSampling(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithSampling(3)
+
+	for i := 0; i < 9; i++ {
+		logger.Infof("info")
+		logger.Debugf("debug")
+	}
+
+	if count := strings.Count(buf.String(), "INFO"); count != 3 {
+		t.Errorf("Expected 3 sampled INFO messages, got %d", count)
+	}
+	if count := strings.Count(buf.String(), "DEBUG"); count != 3 {
+		t.Errorf("Expected 3 sampled DEBUG messages, got %d", count)
+	}
+}
+
+// TestLogger_WithSamplingPer tests that sampling rates are applied per level.
+func TestLogger_WithSamplingPer(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithSamplingPer(DEBUG, 5)
+
+	for i := 0; i < 10; i++ {
+		logger.Infof("info")
+		logger.Debugf("debug")
+	}
+
+	if count := strings.Count(buf.String(), "INFO"); count != 10 {
+		t.Errorf("Expected all 10 INFO messages, got %d", count)
+	}
+	if count := strings.Count(buf.String(), "DEBUG"); count != 2 {
+		t.Errorf("Expected 2 sampled DEBUG messages, got %d", count)
+	}
+}
+
+// TestLogger_WithSampling_ErrorsExempt tests that ERROR messages are not sampled by default.
+func TestLogger_WithSampling_ErrorsExempt(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithSampling(4)
+
+	for i := 0; i < 8; i++ {
+		logger.Errorf("error")
+	}
+
+	if count := strings.Count(buf.String(), "ERROR"); count != 8 {
+		t.Errorf("Expected all 8 ERROR messages, got %d", count)
+	}
+
+	buf.Reset()
+	logger.WithErrorSampling(true)
+
+	for i := 0; i < 8; i++ {
+		logger.Errorf("error")
+	}
+
+	if count := strings.Count(buf.String(), "ERROR"); count != 2 {
+		t.Errorf("Expected 2 sampled ERROR messages, got %d", count)
+	}
+}
+
+// TestLogger_WithSampling_ObjectAndJSON tests that sampling applies to JSON and object logs.
+func TestLogger_WithSampling_ObjectAndJSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithSampling(2)
+
+	if builder := logger.InfoObjectf("first"); builder == nil {
+		t.Error("First INFO object log should pass sampling")
+	} else {
+		builder.Build()
+	}
+
+	if builder := logger.InfoObjectf("second"); builder != nil {
+		t.Error("Second INFO object log should be discarded by sampling")
+	}
+
+	_ = logger.WarningJSONf(nil, "first")
+	_ = logger.WarningJSONf(nil, "second")
+
+	if count := strings.Count(buf.String(), "WARNING"); count != 1 {
+		t.Errorf("Expected 1 sampled WARNING message, got %d", count)
+	}
+}
+
+// TestLogger_WithSampling_Disabled tests that rates of 0 and 1 disable sampling.
+func TestLogger_WithSampling_Disabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithSampling(1).WithSamplingPer(INFO, 0)
+
+	for i := 0; i < 5; i++ {
+		logger.Infof("info")
+		logger.Warningf("warning")
+	}
+
+	if count := strings.Count(buf.String(), "\n"); count != 10 {
+		t.Errorf("Expected all 10 messages, got %d", count)
+	}
+}
+
+// TestLogger_WithSampling_Concurrent tests that concurrent callers are sampled exactly.
+func TestLogger_WithSampling_Concurrent(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithSampling(10)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				logger.Infof("concurrent")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := strings.Count(buf.String(), "INFO"); count != 100 {
+		t.Errorf("Expected 100 sampled messages, got %d", count)
+	}
+}
@@ -0,0 +1,158 @@
+package logger
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// defaultStackTraceDepth is the number of frames captured when StackTraceDepth is not set.
+const defaultStackTraceDepth = 32
+
+// packagePath is the import path of this package, used to skip the logger's own frames
+// when capturing stack traces.
+var packagePath = reflect.TypeOf((*Logger)(nil)).Elem().PkgPath()
+
+// internalFrames lists the function name prefixes of frames that belong to the logger itself.
+var internalFrames = []string{
+	packagePath + ".(*Logger).",
+	packagePath + ".(*ObjectLogBuilder).",
+	packagePath + ".newObjectLogBuilder",
+}
+
+// WithStackTrace enables stack trace capture for logs at or above the given severity.
+// Levels in order of severity: ERROR > WARNING > INFO > DEBUG > TRACE, so WithStackTrace(WARNING)
+// captures stacks for ERROR and WARNING logs. Logs without a level (Logf, LogJSONf, LogObjectf)
+// never capture stacks.
+//
+// The stack is captured only after level filtering and sampling pass, and holds at most
+// StackTraceDepth frames (32 if not set, see WithStackTraceDepth). It is written as:
+//   - An indented block of frames after the message in text output
+//   - A "stack" array of frames in JSON and object output
+//
+// Parameters:
+//   - minLevel: The least severe log Level that captures a stack trace
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithStackTrace(logger.ERROR)
+//	logger.Errorf("request failed: %v", err)
+//	// Output:
+//	// ERROR [api]: request failed: connection refused
+//	//	main.handle (/app/main.go:42)
+//	//	main.main (/app/main.go:17)
+func (logger *Logger) WithStackTrace(minLevel LogLevel) *Logger {
+	logger.update(func(options *Config) {
+		options.StackTrace = true
+		options.StackTraceLevel = minLevel
+	})
+	return logger
+}
+
+// WithStackTraceDepth sets the maximum number of frames captured in a stack trace.
+//
+// Parameters:
+//   - frames: The maximum number of frames; values below 1 are ignored
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger := logger.NewLogger("api").WithStackTrace(logger.ERROR).WithStackTraceDepth(8)
+func (logger *Logger) WithStackTraceDepth(frames int) *Logger {
+	if frames > 0 {
+		logger.update(func(options *Config) {
+			options.StackTraceDepth = frames
+		})
+	}
+	return logger
+}
+
+// captureStack captures the stack of the goroutine that emits a log at the given level.
+// Frames of the logger itself are skipped, so the first frame is the caller of the log method.
+//
+// Parameters:
+//   - options: The options loaded by the log call
+//   - level: The log Level of the message being emitted
+//
+// Returns:
+//   - The formatted frames ("function (file:line)"), or nil if no stack should be captured
+func (logger *Logger) captureStack(options *Config, level LogLevel) []string {
+	if !options.StackTrace || level >= NONE || level > options.StackTraceLevel {
+		return nil
+	}
+
+	depth := options.StackTraceDepth
+	if depth < 1 {
+		depth = defaultStackTraceDepth
+	}
+
+	// reserve room for the logger's own frames, growing the buffer if they don't fit
+	size := depth + len(internalFrames) + 4
+	for {
+		pcs := make([]uintptr, size)
+		// skip runtime.Callers and captureStack
+		count := runtime.Callers(2, pcs)
+
+		stack := formatStack(pcs[:count], depth)
+		if len(stack) == depth || count < size {
+			return stack
+		}
+		size *= 2
+	}
+}
+
+// formatStack formats the frames of the program counters, skipping the logger's own leading frames.
+//
+// Parameters:
+//   - pcs: The program counters returned by runtime.Callers
+//   - depth: The maximum number of frames to format
+//
+// Returns:
+//   - The formatted frames ("function (file:line)")
+func formatStack(pcs []uintptr, depth int) []string {
+	frames := runtime.CallersFrames(pcs)
+	stack := make([]string, 0, depth)
+	internal := true
+
+	for len(stack) < depth {
+		frame, more := frames.Next()
+
+		if internal && isInternalFrame(frame.Function) {
+			if !more {
+				break
+			}
+			continue
+		}
+		internal = false
+
+		stack = append(stack, frame.Function+" ("+frame.File+":"+strconv.Itoa(frame.Line)+")")
+
+		if !more {
+			break
+		}
+	}
+
+	return stack
+}
+
+// isInternalFrame reports whether the function belongs to the logger's own call chain.
+//
+// Parameters:
+//   - function: The fully qualified function name of a stack frame
+//
+// Returns:
+//   - true if the frame belongs to the logger, false otherwise
+func isInternalFrame(function string) bool {
+	for _, prefix := range internalFrames {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestLogger_WithStackTrace_Text tests that text logs include an indented stack block.
+// It verifies that the first frame is the caller of the log method, not the logger itself.
+func TestLogger_WithStackTrace_Text(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithStackTrace(ERROR)
+
+	logger.Errorf("failure")
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("Expected stack frames after the message, got %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[1], "\t") {
+		t.Errorf("Expected indented stack frame, got %q", lines[1])
+	}
+	if !strings.Contains(lines[1], "TestLogger_WithStackTrace_Text") {
+		t.Errorf("Expected first frame to be the test function, got %q", lines[1])
+	}
+}
+
+// TestLogger_WithStackTrace_LevelFiltering tests that stacks are captured only at or above minLevel.
+func TestLogger_WithStackTrace_LevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithStackTrace(WARNING)
+
+	logger.Infof("info")
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("INFO should not capture a stack when minLevel is WARNING, got %q", buf.String())
+	}
+
+	buf.Reset()
+	logger.Warningf("warning")
+	if strings.Count(buf.String(), "\n") < 2 {
+		t.Errorf("WARNING should capture a stack when minLevel is WARNING, got %q", buf.String())
+	}
+
+	buf.Reset()
+	logger.Logf("plain")
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("Logf should never capture a stack, got %q", buf.String())
+	}
+}
+
+// TestLogger_WithStackTraceDepth tests that the number of captured frames is bounded.
+func TestLogger_WithStackTraceDepth(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithStackTrace(ERROR).WithStackTraceDepth(1)
+
+	logger.Errorf("failure")
+
+	if count := strings.Count(buf.String(), "\n\t"); count != 1 {
+		t.Errorf("Expected exactly 1 stack frame, got %d", count)
+	}
+}
+
+// nestedLogCall logs from depth nested calls, each counted as a logger frame by
+// TestLogger_WithStackTrace_DeepInternalFrames.
+//
+//go:noinline
+func nestedLogCall(logger *Logger, depth int) {
+	if depth > 0 {
+		nestedLogCall(logger, depth-1)
+		return
+	}
+	logger.Errorf("failure")
+}
+
+// TestLogger_WithStackTrace_DeepInternalFrames tests that the stack still reaches the caller
+// when the logger's own frames outnumber the room reserved for them.
+func TestLogger_WithStackTrace_DeepInternalFrames(t *testing.T) {
+	defer func(frames []string) { internalFrames = frames }(internalFrames)
+	internalFrames = append(internalFrames[:len(internalFrames):len(internalFrames)], packagePath+".nestedLogCall")
+
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithStackTrace(ERROR).WithStackTraceDepth(2)
+
+	nestedLogCall(logger, 100)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 2 stack frames after the message, got %q", buf.String())
+	}
+	if !strings.Contains(lines[1], "TestLogger_WithStackTrace_DeepInternalFrames") {
+		t.Errorf("Expected first frame to be the test function, got %q", lines[1])
+	}
+}
+
+// TestLogger_WithStackTrace_JSON tests that JSON logs include a stack array.
+func TestLogger_WithStackTrace_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithStackTrace(ERROR)
+
+	if err := logger.ErrorJSONf(map[string]int{"code": 1}, "failure"); err != nil {
+		t.Fatal(err)
+	}
+
+	var log jsonLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Invalid JSON output: %v", err)
+	}
+	if len(log.Stack) == 0 || !strings.Contains(log.Stack[0], "TestLogger_WithStackTrace_JSON") {
+		t.Errorf("Expected stack starting at the test function, got %v", log.Stack)
+	}
+}
+
+// TestLogger_WithStackTrace_Object tests that object logs include a stack array and stay valid JSON.
+func TestLogger_WithStackTrace_Object(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf).WithStackTrace(ERROR)
+
+	logger.ErrorObjectf("failure").AssignInt("code", 1).Build()
+
+	var log struct {
+		Stack  []string       `json:"stack"`
+		Object map[string]int `json:"object"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", buf.String(), err)
+	}
+	if len(log.Stack) == 0 || !strings.Contains(log.Stack[0], "TestLogger_WithStackTrace_Object") {
+		t.Errorf("Expected stack starting at the test function, got %v", log.Stack)
+	}
+	if log.Object["code"] != 1 {
+		t.Errorf("Expected object field code=1, got %v", log.Object)
+	}
+}
+
+// TestLogger_WithStackTrace_Disabled tests that no stack is captured by default.
+func TestLogger_WithStackTrace_Disabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").ErrorsTo(&buf)
+
+	logger.Errorf("failure")
+
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("Expected a single line without stack, got %q", buf.String())
+	}
+}
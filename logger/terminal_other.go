@@ -0,0 +1,41 @@
+//go:build !windows
+
+package logger
+
+import (
+	"io"
+	"os"
+)
+
+// enableVirtualTerminal reports whether ANSI escape sequences can be written to target.
+// Unix/Linux/macOS terminals interpret ANSI codes natively, so no setup is required.
+//
+// Parameters:
+//   - target: The writer that colored output will be written to
+//
+// Returns:
+//   - Always true on non-Windows platforms
+func enableVirtualTerminal(target io.Writer) bool {
+	return true
+}
+
+// isTerminal reports whether target is a character device such as a terminal.
+//
+// Parameters:
+//   - target: The writer to check
+//
+// Returns:
+//   - true if target is an *os.File backed by a terminal, false otherwise
+func isTerminal(target io.Writer) bool {
+	file, ok := target.(*os.File)
+	if !ok || file == nil {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
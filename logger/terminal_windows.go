@@ -0,0 +1,55 @@
+//go:build windows
+
+package logger
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableVirtualTerminal turns on ANSI escape sequence processing for the console behind target.
+// Windows 10+ consoles interpret ANSI codes only after ENABLE_VIRTUAL_TERMINAL_PROCESSING is set.
+//
+// Parameters:
+//   - target: The writer that colored output will be written to
+//
+// Returns:
+//   - true if target is a console with virtual terminal processing enabled, false otherwise
+func enableVirtualTerminal(target io.Writer) bool {
+	file, ok := target.(*os.File)
+	if !ok || file == nil {
+		return false
+	}
+
+	handle := windows.Handle(file.Fd())
+
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		return false
+	}
+
+	if mode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
+		return true
+	}
+
+	return windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING) == nil
+}
+
+// isTerminal reports whether target is a Windows console.
+//
+// Parameters:
+//   - target: The writer to check
+//
+// Returns:
+//   - true if target is an *os.File backed by a console, false otherwise
+func isTerminal(target io.Writer) bool {
+	file, ok := target.(*os.File)
+	if !ok || file == nil {
+		return false
+	}
+
+	var mode uint32
+	return windows.GetConsoleMode(windows.Handle(file.Fd()), &mode) == nil
+}
@@ -0,0 +1,211 @@
+package logger
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/0x626f/go-kit/cache"
+)
+
+// throttleCapacity is the maximum number of keys tracked by a logger for rate-limited logging.
+// The least recently used keys are evicted once the limit is reached.
+const throttleCapacity = 1024
+
+// throttleEntry holds the rate-limiting state of a single message key.
+type throttleEntry struct {
+	// last is the time the message was last emitted
+	last time.Time
+	// suppressed is the number of occurrences suppressed since the last emission
+	suppressed int
+}
+
+// throttle tracks the last emission time per message key for the *Everyf methods.
+// Keys are stored in an LRU cache, so the key table never grows beyond throttleCapacity.
+type throttle struct {
+	// mutex makes the lookup and update of a key atomic and guards the entries, which are mutated in place;
+	// the cache being thread-safe on its own doesn't keep two callers from both emitting the same key
+	mutex sync.Mutex
+	// keys maps message keys to their rate-limiting state (created lazily)
+	keys *cache.LRUCache[string, *throttleEntry]
+}
+
+// allow reports whether a message with the given key may be emitted now.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - now: The current time, read from the logger clock
+//
+// Returns:
+//   - true if the message should be emitted, false if it is suppressed
+//   - The number of occurrences suppressed since the previous emission (only if emitted)
+func (throttle *throttle) allow(key string, interval time.Duration, now time.Time) (bool, int) {
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+
+	if throttle.keys == nil {
+		throttle.keys = cache.NewLRUCache[string, *throttleEntry](throttleCapacity)
+	}
+
+	entry, exists := throttle.keys.Get(key)
+	if !exists {
+		throttle.keys.Set(key, &throttleEntry{last: now})
+		return true, 0
+	}
+
+	if now.Sub(entry.last) < interval {
+		entry.suppressed++
+		return false, 0
+	}
+
+	suppressed := entry.suppressed
+	entry.last, entry.suppressed = now, 0
+
+	return true, suppressed
+}
+
+// everyf writes a rate-limited message at the given level, suffixed with the number of occurrences
+// suppressed before it. Level filtering and sampling run before the throttle, so a message discarded
+// by them neither counts as an emission nor resets the suppressed count: once the throttle lets
+// a message through, it is written with the options already loaded.
+//
+// Parameters:
+//   - level: The log Level
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if the message isn't written)
+func (logger *Logger) everyf(level LogLevel, key string, interval time.Duration, msg string, args ...any) int {
+	options := logger.enabled(level)
+	if options == nil {
+		return 0
+	}
+
+	allowed, suppressed := logger.throttle.allow(key, interval, logger.now())
+	if !allowed {
+		return 0
+	}
+
+	message := logger.format(msg, args...)
+	if suppressed > 0 {
+		message = append(message, " (suppressed "...)
+		message = strconv.AppendInt(message, int64(suppressed), 10)
+		message = append(message, " times)"...)
+	}
+
+	stream, mutex := logger.stream(level)
+
+	if options.Async {
+		logger.sendToChannelByLevel(options, level, logger.formatMessage(options, level, "%s", message))
+		return suppressed
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger.writeStringToStream(options, stream, level, "%s", message)
+	return suppressed
+}
+
+// LogEveryf logs a message without a log Level prefix at most once per interval for the given key.
+// Occurrences arriving within the interval are suppressed and counted; the next emitted message
+// is suffixed with the number of suppressed occurrences.
+//
+// Parameters:
+//   - key: The key identifying the message (e.g., "db-timeout")
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed)
+//
+// Example:
+//
+//	for {
+//	    logger.LogEveryf("heartbeat", 5*time.Second, "worker %d alive", id)
+//	}
+func (logger *Logger) LogEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(NONE, key, interval, msg, args...)
+}
+
+// TraceEveryf logs a message at TRACE Level at most once per interval for the given key.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed or filtered)
+func (logger *Logger) TraceEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(TRACE, key, interval, msg, args...)
+}
+
+// DebugEveryf logs a message at DEBUG Level at most once per interval for the given key.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed or filtered)
+func (logger *Logger) DebugEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(DEBUG, key, interval, msg, args...)
+}
+
+// InfoEveryf logs a message at INFO Level at most once per interval for the given key.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed or filtered)
+func (logger *Logger) InfoEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(INFO, key, interval, msg, args...)
+}
+
+// WarningEveryf logs a message at WARNING Level at most once per interval for the given key.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed or filtered)
+func (logger *Logger) WarningEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(WARNING, key, interval, msg, args...)
+}
+
+// ErrorEveryf logs a message at ERROR Level at most once per interval for the given key.
+// This is useful for noisy error paths, such as a failing dependency retried in a loop.
+//
+// Parameters:
+//   - key: The key identifying the message
+//   - interval: The minimum time between two emissions of the key
+//   - msg: The message format string
+//   - args: Optional format arguments
+//
+// Returns:
+//   - The number of occurrences suppressed before this emission (0 if this message is suppressed or sampled out)
+//
+// Example:
+//
+//	if err := db.Ping(); err != nil {
+//	    logger.ErrorEveryf("db-ping", 5*time.Second, "database unreachable: %v", err)
+//	}
+func (logger *Logger) ErrorEveryf(key string, interval time.Duration, msg string, args ...any) int {
+	return logger.everyf(ERROR, key, interval, msg, args...)
+}
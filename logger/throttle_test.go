@@ -0,0 +1,153 @@
+package logger
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestLogger_InfoEveryf tests that messages with the same key are suppressed within the interval.
+// It verifies that the next emission reports the number of suppressed occurrences.
+func TestLogger_InfoEveryf(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+
+	for i := 0; i < 5; i++ {
+		logger.InfoEveryf("noisy", time.Hour, "noisy message %d", i)
+	}
+
+	if count := strings.Count(buf.String(), "noisy message"); count != 1 {
+		t.Fatalf("Expected 1 emitted message, got %d: %q", count, buf.String())
+	}
+
+	buf.Reset()
+	suppressed := logger.InfoEveryf("noisy", 0, "noisy message")
+
+	if suppressed != 4 {
+		t.Errorf("Expected 4 suppressed occurrences, got %d", suppressed)
+	}
+	if !strings.Contains(buf.String(), "(suppressed 4 times)") {
+		t.Errorf("Expected suppression note, got %q", buf.String())
+	}
+}
+
+// TestLogger_Everyf_Clock tests that intervals are measured with the logger clock.
+func TestLogger_Everyf_Clock(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2025, 12, 6, 10, 30, 0, 0, time.UTC)
+	logger := NewLogger("test").OutputTo(&buf).WithClock(func() time.Time { return now })
+
+	logger.InfoEveryf("tick", time.Minute, "tick")
+	logger.InfoEveryf("tick", time.Minute, "tick")
+
+	now = now.Add(time.Minute)
+	if suppressed := logger.InfoEveryf("tick", time.Minute, "tick"); suppressed != 1 {
+		t.Errorf("Expected 1 suppressed occurrence once the clock advanced, got %d", suppressed)
+	}
+	if count := strings.Count(buf.String(), "tick"); count != 2 {
+		t.Errorf("Expected 2 emitted messages, got %d: %q", count, buf.String())
+	}
+}
+
+// TestLogger_Everyf_Sampling tests that a message dropped by sampling keeps the suppressed count
+// for the next message actually written.
+func TestLogger_Everyf_Sampling(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2025, 12, 6, 10, 30, 0, 0, time.UTC)
+	logger := NewLogger("test").OutputTo(&buf).WithClock(func() time.Time { return now }).WithSamplingPer(INFO, 2)
+
+	logger.InfoEveryf("noisy", time.Minute, "noisy") // sampled in, emitted
+	logger.InfoEveryf("noisy", time.Minute, "noisy") // sampled out
+	logger.InfoEveryf("noisy", time.Minute, "noisy") // sampled in, suppressed
+
+	now = now.Add(time.Hour)
+	if suppressed := logger.InfoEveryf("noisy", time.Minute, "noisy"); suppressed != 0 {
+		t.Errorf("Expected a sampled out message to report nothing, got %d", suppressed)
+	}
+	if suppressed := logger.InfoEveryf("noisy", time.Minute, "noisy"); suppressed != 1 {
+		t.Errorf("Expected the written message to report 1 suppressed occurrence, got %d", suppressed)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[1], "noisy (suppressed 1 times)") {
+		t.Errorf("Expected the suppression note on the second written message, got %q", buf.String())
+	}
+}
+
+// TestLogger_Everyf_IndependentKeys tests that different keys are rate-limited independently.
+func TestLogger_Everyf_IndependentKeys(t *testing.T) {
+	var out, errs bytes.Buffer
+	logger := NewLogger("test").OutputTo(&out).ErrorsTo(&errs)
+
+	logger.WarningEveryf("first", time.Hour, "first")
+	logger.WarningEveryf("second", time.Hour, "second")
+	logger.ErrorEveryf("third", time.Hour, "third")
+	logger.ErrorEveryf("third", time.Hour, "third")
+
+	if !strings.Contains(out.String(), "first") || !strings.Contains(out.String(), "second") {
+		t.Errorf("Expected both keys to be emitted, got %q", out.String())
+	}
+	if count := strings.Count(errs.String(), "third"); count != 1 {
+		t.Errorf("Expected 1 emitted error, got %d", count)
+	}
+}
+
+// TestLogger_Everyf_LevelFiltering tests that filtered messages are not counted as suppressed.
+func TestLogger_Everyf_LevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf).WithLogLevel(INFO)
+
+	logger.DebugEveryf("filtered", time.Hour, "debug")
+	logger.TraceEveryf("filtered", time.Hour, "trace")
+
+	if buf.Len() > 0 {
+		t.Errorf("Expected filtered messages to be discarded, got %q", buf.String())
+	}
+
+	logger.InfoEveryf("filtered", time.Hour, "info")
+	if !strings.Contains(buf.String(), "info") || strings.Contains(buf.String(), "suppressed") {
+		t.Errorf("Expected first INFO emission without suppression note, got %q", buf.String())
+	}
+}
+
+// TestLogger_LogEveryf_KeyEviction tests that the key table is bounded.
+func TestLogger_LogEveryf_KeyEviction(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+
+	logger.LogEveryf("evicted", time.Hour, "evicted")
+	for i := 0; i < throttleCapacity; i++ {
+		logger.LogEveryf(strconv.Itoa(i), time.Hour, "filler")
+	}
+
+	buf.Reset()
+	logger.LogEveryf("evicted", time.Hour, "evicted")
+	if !strings.Contains(buf.String(), "evicted") {
+		t.Error("Expected evicted key to be emitted again")
+	}
+}
+
+// TestLogger_Everyf_Concurrent tests that concurrent callers emit a key only once per interval.
+func TestLogger_Everyf_Concurrent(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("test").OutputTo(&buf)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				logger.InfoEveryf("concurrent", time.Hour, "concurrent")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := strings.Count(buf.String(), "concurrent"); count != 1 {
+		t.Errorf("Expected 1 emitted message, got %d", count)
+	}
+}
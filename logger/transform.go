@@ -0,0 +1,251 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// FieldTransform rewrites a field of the object of JSON and object logs (see WithFieldTransform).
+// It receives the field name and its value and returns the name and value to write,
+// or false to drop the field.
+type FieldTransform func(key string, value any) (string, any, bool)
+
+// WithFieldTransform sets a transform applied to every field of the object of JSON and object logs,
+// including base fields and the fields of nested objects. It can rename fields, rewrite their values
+// (e.g. truncate long strings) or drop them. The standard fields (level, message, timestamp...)
+// are not transformed, use WithJSONKeys to rename them.
+//
+// Values are passed as decoded from their JSON encoding: string, json.Number, bool, nil,
+// []any or map[string]any. Returned values are encoded with encoding/json.
+// Fields are transformed top-down: the fields of a nested object are transformed only if the object
+// is kept and its map is returned unchanged; returning another value replaces the object as a whole.
+//
+// The transform is applied to the encoded log, so object logs allocate while a transform is set.
+// Passing nil removes the transform.
+//
+// Parameters:
+//   - transform: The function applied to every field
+//
+// Returns:
+//   - The logger for method chaining
+//
+// Example:
+//
+//	logger.WithFieldTransform(func(key string, value any) (string, any, bool) {
+//	    if key == "password" {
+//	        return key, nil, false
+//	    }
+//	    if text, ok := value.(string); ok && len(text) > 64 {
+//	        return key, text[:64], true
+//	    }
+//	    return strings.ToLower(key), value, true
+//	})
+func (logger *Logger) WithFieldTransform(transform FieldTransform) *Logger {
+	logger.transform = transform
+	return logger
+}
+
+// objectNode is a decoded JSON object keeping the order of its members.
+type objectNode struct {
+	keys   []string
+	values []any
+}
+
+// arrayNode is a decoded JSON array whose objects keep the order of their members.
+type arrayNode struct {
+	items []any
+}
+
+// transformFields applies a transform to the members of the JSON encoding of an object.
+//
+// Parameters:
+//   - transform: The transform to apply
+//   - object: The JSON encoding of the object
+//
+// Returns:
+//   - The transformed encoding, or object unchanged if it isn't a JSON object
+func transformFields(transform FieldTransform, object []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(object))
+	decoder.UseNumber()
+
+	value, err := decodeNode(decoder)
+	if err != nil {
+		return object
+	}
+
+	node, ok := value.(*objectNode)
+	if !ok {
+		return object
+	}
+
+	return appendTransformed(nil, transform, node)
+}
+
+// decodeNode decodes the next JSON value of a decoder, objects as *objectNode and arrays as *arrayNode.
+//
+// Parameters:
+//   - decoder: The decoder positioned before the value
+//
+// Returns:
+//   - The decoded value
+//   - Error if the input is not valid JSON
+func decodeNode(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delimiter, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delimiter {
+	case '{':
+		node := &objectNode{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.keys = append(node.keys, key.(string))
+			node.values = append(node.values, value)
+		}
+		_, err = decoder.Token()
+		return node, err
+	case '[':
+		node := &arrayNode{}
+		for decoder.More() {
+			value, err := decodeNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.items = append(node.items, value)
+		}
+		_, err = decoder.Token()
+		return node, err
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %v", delimiter)
+	}
+}
+
+// appendTransformed appends the encoding of a decoded value, transforming the members of its objects.
+//
+// Parameters:
+//   - encoded: The buffer to append to
+//   - transform: The transform to apply
+//   - value: The decoded value (see decodeNode)
+//
+// Returns:
+//   - The extended buffer
+func appendTransformed(encoded []byte, transform FieldTransform, value any) []byte {
+	switch node := value.(type) {
+	case *objectNode:
+		encoded = append(encoded, '{')
+		first := true
+		for i, key := range node.keys {
+			view := viewOf(node.values[i])
+			name, replaced, keep := transform(key, view)
+			if !keep {
+				continue
+			}
+
+			if !first {
+				encoded = append(encoded, ',')
+			}
+			first = false
+
+			quoted, _ := json.Marshal(name)
+			encoded = append(encoded, quoted...)
+			encoded = append(encoded, ':')
+
+			if sameContainer(replaced, view) {
+				encoded = appendTransformed(encoded, transform, node.values[i])
+			} else {
+				encoded = appendValue(encoded, replaced)
+			}
+		}
+		return append(encoded, '}')
+	case *arrayNode:
+		encoded = append(encoded, '[')
+		for i, item := range node.items {
+			if i > 0 {
+				encoded = append(encoded, ',')
+			}
+			encoded = appendTransformed(encoded, transform, item)
+		}
+		return append(encoded, ']')
+	default:
+		return appendValue(encoded, value)
+	}
+}
+
+// appendValue appends the JSON encoding of a value, or of its fmt.Sprint representation if it can't be encoded.
+//
+// Parameters:
+//   - encoded: The buffer to append to
+//   - value: The value to encode
+//
+// Returns:
+//   - The extended buffer
+func appendValue(encoded []byte, value any) []byte {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		raw, _ = json.Marshal(fmt.Sprint(value))
+	}
+	return append(encoded, raw...)
+}
+
+// viewOf converts a decoded value to the value passed to a transform: objects become map[string]any
+// and arrays []any.
+//
+// Parameters:
+//   - value: The decoded value (see decodeNode)
+//
+// Returns:
+//   - The value as seen by the transform
+func viewOf(value any) any {
+	switch node := value.(type) {
+	case *objectNode:
+		view := make(map[string]any, len(node.keys))
+		for i, key := range node.keys {
+			view[key] = viewOf(node.values[i])
+		}
+		return view
+	case *arrayNode:
+		view := make([]any, len(node.items))
+		for i, item := range node.items {
+			view[i] = viewOf(item)
+		}
+		return view
+	default:
+		return value
+	}
+}
+
+// sameContainer reports whether a transform returned the map or slice it was passed.
+//
+// Parameters:
+//   - returned: The value returned by the transform
+//   - view: The value passed to the transform
+//
+// Returns:
+//   - true if view is a map or slice and returned is the same one, false otherwise
+func sameContainer(returned, view any) bool {
+	switch view := view.(type) {
+	case map[string]any:
+		other, ok := returned.(map[string]any)
+		return ok && reflect.ValueOf(other).UnsafePointer() == reflect.ValueOf(view).UnsafePointer()
+	case []any:
+		other, ok := returned.([]any)
+		return ok && len(other) == len(view) && reflect.ValueOf(other).UnsafePointer() == reflect.ValueOf(view).UnsafePointer()
+	default:
+		return false
+	}
+}
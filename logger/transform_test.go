@@ -0,0 +1,125 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestLogger_WithFieldTransform_Object tests renaming, dropping and rewriting the fields of object logs,
+// including base and nested fields.
+func TestLogger_WithFieldTransform_Object(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("api").OutputTo(&buf).WithFields(map[string]any{"Service": "billing"}).
+		WithFieldTransform(func(key string, value any) (string, any, bool) {
+			if key == "password" {
+				return key, nil, false
+			}
+			if text, ok := value.(string); ok && len(text) > 5 {
+				value = text[:5]
+			}
+			return strings.ToLower(key), value, true
+		})
+
+	logger.InfoObjectf("login").
+		AssignString("User", "alice").
+		AssignString("password", "secret").
+		NestedStart("Request").
+		AssignString("Path", "/api/login").
+		AssignString("password", "secret").
+		AssignInt("Status", 200).
+		NestedEnd().
+		Build()
+
+	expected := `{"level":"INFO","source":"api","message":"login","object":{"service":"billi","user":"alice","request":{"path":"/api/","status":200}}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// TestLogger_WithFieldTransform_JSON tests that the transform applies to the objects of JSON logs.
+func TestLogger_WithFieldTransform_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger("").OutputTo(&buf).WithFieldTransform(func(key string, value any) (string, any, bool) {
+		if key == "at" {
+			at, err := time.Parse(time.RFC3339, value.(string))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return "at_ms", at.UnixMilli(), true
+		}
+		return key, value, key != "internal"
+	})
+
+	object := map[string]any{
+		"at":       time.Unix(1700000000, 0).UTC(),
+		"internal": true,
+		"items":    []any{map[string]any{"id": 1, "internal": "x"}},
+	}
+	if err := logger.InfoJSONf(object, "event"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"level":"INFO","message":"event","object":{"at_ms":1700000000000,"items":[{"id":1}]}}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := logger.InfoJSONf([]int{1, 2}, "list"); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"object":[1,2]`)) {
+		t.Errorf("Expected an object that isn't a JSON object unchanged, got %s", buf.String())
+	}
+}
+
+// TestLogger_WithFieldTransform_ReplaceNested tests that replacing a nested object skips the transform
+// of its fields, and that the order of kept fields is preserved.
+func TestLogger_WithFieldTransform_ReplaceNested(t *testing.T) {
+	var buf bytes.Buffer
+	calls := 0
+	logger := NewLogger("").OutputTo(&buf).WithFieldTransform(func(key string, value any) (string, any, bool) {
+		calls++
+		if key == "user" {
+			return key, value.(map[string]any)["id"], true
+		}
+		return key, value, true
+	})
+
+	logger.InfoObjectf("").
+		AssignInt("z", 1).
+		NestedStart("user").AssignInt("id", 7).AssignString("name", "alice").NestedEnd().
+		AssignInt("a", 2).
+		Build()
+
+	if !json.Valid(buf.Bytes()) || !bytes.Contains(buf.Bytes(), []byte(`"object":{"z":1,"user":7,"a":2}`)) {
+		t.Errorf("Expected the nested object replaced in place, got %s", buf.String())
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 transform calls, got %d", calls)
+	}
+}
+
+// TestLogger_WithFieldTransform_Named tests that derived loggers inherit the transform and that nil removes it.
+func TestLogger_WithFieldTransform_Named(t *testing.T) {
+	var buf bytes.Buffer
+	parent := NewLogger("").OutputTo(&buf).WithFieldTransform(func(key string, value any) (string, any, bool) {
+		return strings.ToUpper(key), value, true
+	})
+	child := parent.Named("child")
+	parent.WithFieldTransform(nil)
+
+	child.InfoObjectf("").AssignInt("id", 1).Build()
+	if !bytes.Contains(buf.Bytes(), []byte(`"object":{"ID":1}`)) {
+		t.Errorf("Expected the derived logger to transform fields, got %s", buf.String())
+	}
+
+	buf.Reset()
+	parent.InfoObjectf("").AssignInt("id", 1).Build()
+	if !bytes.Contains(buf.Bytes(), []byte(`"object":{"id":1}`)) {
+		t.Errorf("Expected no transform after removing it, got %s", buf.String())
+	}
+}
@@ -0,0 +1,235 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// flusher is implemented by writers buffering data, such as GzipWriter and bufio.Writer.
+type flusher interface {
+	Flush() error
+}
+
+// syncer is implemented by writers backed by a file, such as *os.File and RotatingFileWriter.
+type syncer interface {
+	Sync() error
+}
+
+// levelWriter is an io.Writer emitting everything written to it as logs of a fixed level.
+type levelWriter struct {
+	// logger emits the logs
+	logger *Logger
+	// level is the log Level of every emitted message
+	level LogLevel
+}
+
+// WriterForLevel returns an io.Writer that emits every write as a single log message at the given level,
+// so libraries writing to an io.Writer (like the standard log package) can be routed through the logger.
+// A trailing newline is trimmed from each write. Writes are filtered and sampled like any other
+// message of the level, and never fail.
+//
+// Parameters:
+//   - level: The log Level of the emitted messages (NONE logs without a level prefix, like Logf)
+//
+// Returns:
+//   - The writer emitting logs through this logger
+//
+// Example:
+//
+//	server := &http.Server{
+//	    ErrorLog: log.New(logger.WriterForLevel(logger.ERROR), "", 0),
+//	}
+func (logger *Logger) WriterForLevel(level LogLevel) io.Writer {
+	return &levelWriter{logger: logger, level: level}
+}
+
+// Write emits data as a log message. It implements io.Writer.
+//
+// Parameters:
+//   - data: The message, usually a single line
+//
+// Returns:
+//   - The length of data and nil, even if the message is filtered out
+func (writer *levelWriter) Write(data []byte) (int, error) {
+	message := bytes.TrimSuffix(bytes.TrimSuffix(data, []byte{ln}), []byte{'\r'})
+
+	switch writer.level {
+	case ERROR:
+		writer.logger.Errorf("%s", message)
+	case WARNING:
+		writer.logger.Warningf("%s", message)
+	case INFO:
+		writer.logger.Infof("%s", message)
+	case DEBUG:
+		writer.logger.Debugf("%s", message)
+	case TRACE:
+		writer.logger.Tracef("%s", message)
+	default:
+		writer.logger.Logf("%s", message)
+	}
+
+	return len(data), nil
+}
+
+// Flush flushes every output of the logger that buffers data (one with a Flush() error method,
+// like GzipWriter or bufio.Writer): the output and error streams and the writers routed with RouteLevel.
+// Logs still queued by an asynchronous logger are written first.
+//
+// Returns:
+//   - The errors of the failed flushes joined, or nil
+//
+// Example:
+//
+//	log := logger.NewLogger("app").OutputTo(bufio.NewWriter(file))
+//	defer log.Flush()
+func (logger *Logger) Flush() error {
+	logger.waitAsync()
+
+	var err error
+
+	logger.eachOutput(func(output io.Writer) {
+		if buffered, ok := output.(flusher); ok {
+			err = errors.Join(err, buffered.Flush())
+		}
+	})
+
+	return err
+}
+
+// Close flushes the outputs of the logger like Flush and closes those implementing io.Closer,
+// except os.Stdout and os.Stderr. Loggers derived by Named share the outputs, so they
+// must not be used after Close. An asynchronous logger writes the logs still queued and
+// stops its background goroutine before the outputs are closed.
+//
+// Returns:
+//   - The errors of the failed flushes and closes joined, or nil
+//
+// Example:
+//
+//	log := logger.NewLogger("app").OutputTo(logger.NewGzipWriter(file, time.Second))
+//	defer log.Close()
+func (logger *Logger) Close() error {
+	logger.waitAsync()
+	if stop := logger.config().stopAsync; stop != nil {
+		stop()
+	}
+
+	var err error
+
+	logger.eachOutput(func(output io.Writer) {
+		closer, closable := output.(io.Closer)
+		if closable && output != os.Stdout && output != os.Stderr {
+			err = errors.Join(err, closer.Close())
+		} else if buffered, ok := output.(flusher); ok {
+			err = errors.Join(err, buffered.Flush())
+		}
+	})
+
+	return err
+}
+
+// Sync writes the logs still queued by an asynchronous logger, flushes the outputs like Flush
+// and commits those backed by a file (with a Sync() error method, like *os.File, RotatingFileWriter
+// or a GzipWriter writing to a file) to stable storage, so logs written before the call survive a crash.
+// os.Stdout and os.Stderr are not synced. Unlike Close, the outputs stay open.
+//
+// Returns:
+//   - The errors of the failed flushes and syncs joined, or nil
+//
+// Example:
+//
+//	audit := logger.NewLogger("audit").OutputTo(file)
+//	audit.InfoJSONf(event, "payment captured")
+//	if err := audit.Sync(); err != nil {
+//	    return err
+//	}
+func (logger *Logger) Sync() error {
+	logger.waitAsync()
+
+	var err error
+
+	logger.eachOutput(func(output io.Writer) {
+		if synced, ok := output.(syncer); ok && output != os.Stdout && output != os.Stderr {
+			err = errors.Join(err, synced.Sync())
+		} else if buffered, ok := output.(flusher); ok {
+			err = errors.Join(err, buffered.Flush())
+		}
+	})
+
+	return err
+}
+
+// waitAsync blocks until the logs queued by an asynchronous logger before the call have been written.
+// It returns early if the background goroutine of the logger is stopped.
+func (logger *Logger) waitAsync() {
+	options := logger.config()
+	if !options.Async {
+		return
+	}
+
+	if dispatcher := options.dispatcher; dispatcher != nil {
+		dispatcher.wait()
+		return
+	}
+
+	cancel := options.cancelAsync
+	for _, channel := range []chan asyncMessage{options.logs, options.errors} {
+		if channel == nil {
+			continue
+		}
+
+		barrier := make(chan struct{})
+		select {
+		case channel <- asyncMessage{barrier: barrier}:
+		case <-cancel:
+			return
+		}
+
+		select {
+		case <-barrier:
+		case <-cancel:
+			return
+		}
+	}
+}
+
+// eachOutput calls action once for every distinct output of the logger,
+// holding the mutex of the stream the output was first found in.
+//
+// Parameters:
+//   - action: The function to call with each output
+func (logger *Logger) eachOutput(action func(output io.Writer)) {
+	type stream struct {
+		output io.Writer
+		mutex  *sync.Mutex
+	}
+
+	streams := []stream{{logger.out, logger.syncOut}, {logger.err, logger.syncErr}}
+	for _, route := range logger.routes {
+		streams = append(streams, stream{route, logger.syncRoutes})
+	}
+
+	var seen []io.Writer
+	for _, current := range streams {
+		if current.output == nil || slices.ContainsFunc(seen, func(output io.Writer) bool {
+			return sameWriter(output, current.output)
+		}) {
+			continue
+		}
+		seen = append(seen, current.output)
+
+		current.mutex.Lock()
+		action(current.output)
+		current.mutex.Unlock()
+	}
+}
+
+// sameWriter reports whether two writers are the same, treating writers of incomparable types as distinct.
+func sameWriter(a, b io.Writer) bool {
+	return reflect.TypeOf(a) == reflect.TypeOf(b) && reflect.TypeOf(a).Comparable() && a == b
+}
@@ -0,0 +1,202 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLogger_WriterForLevel tests that writes through the adapter are logged at the chosen level.
+// It verifies that the standard log package can be routed through the logger.
+func TestLogger_WriterForLevel(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	stdlog := log.New(logger.WriterForLevel(ERROR), "http: ", 0)
+	stdlog.Printf("TLS handshake error from %s", "10.0.0.1:5000")
+
+	entry, ok := capture.LastEntry()
+	if !ok {
+		t.Fatal("Expected an entry")
+	}
+	if entry.Level != ERROR || entry.Message != "http: TLS handshake error from 10.0.0.1:5000" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if len(capture.Entries()) != 1 {
+		t.Errorf("Expected a single entry, got %d", len(capture.Entries()))
+	}
+}
+
+// TestLogger_WriterForLevel_Levels tests every level and that messages are not treated as format strings.
+func TestLogger_WriterForLevel_Levels(t *testing.T) {
+	logger, capture := NewTestLogger()
+
+	for _, level := range []LogLevel{ERROR, WARNING, INFO, DEBUG, TRACE, NONE} {
+		capture.Reset()
+
+		message := fmt.Sprintf("100%% done at %s\r\n", level.String())
+		n, err := logger.WriterForLevel(level).Write([]byte(message))
+		if n != len(message) || err != nil {
+			t.Errorf("Expected %d bytes written without error, got %d, %v", len(message), n, err)
+		}
+
+		entry, ok := capture.LastEntry()
+		if !ok {
+			t.Fatalf("Expected an entry at %s", level.String())
+		}
+		if entry.Level != level || entry.Message != fmt.Sprintf("100%% done at %s", level.String()) {
+			t.Errorf("Unexpected entry at %s: %+v", level.String(), entry)
+		}
+	}
+}
+
+// TestLogger_WriterForLevel_Filtered tests that writes below the logger level are discarded but reported as written.
+func TestLogger_WriterForLevel_Filtered(t *testing.T) {
+	logger, capture := NewTestLogger()
+	logger.WithLogLevel(WARNING)
+
+	n, err := logger.WriterForLevel(DEBUG).Write([]byte("verbose\n"))
+	if n != len("verbose\n") || err != nil {
+		t.Errorf("Expected the write to succeed, got %d, %v", n, err)
+	}
+	if entries := capture.Entries(); len(entries) != 0 {
+		t.Errorf("Expected no entries, got %+v", entries)
+	}
+}
+
+// syncedBuffer records the writes done before each call to Sync.
+type syncedBuffer struct {
+	bytes.Buffer
+	synced []string
+}
+
+func (buffer *syncedBuffer) Sync() error {
+	buffer.synced = append(buffer.synced, buffer.String())
+	return nil
+}
+
+// TestLogger_Sync_File tests that logs of an asynchronous logger are in the file after Sync, before Close.
+func TestLogger_Sync_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	logger, cancel := NewLogger("audit").OutputTo(file).ErrorsTo(file).WithAsync(true, 100)
+	defer cancel()
+
+	var expected strings.Builder
+	for i := 0; i < 50; i++ {
+		logger.Infof("event %d", i)
+		fmt.Fprintf(&expected, "INFO [audit]: event %d\n", i)
+	}
+	logger.Errorf("failure")
+	expected.WriteString("ERROR [audit]: failure\n")
+
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != expected.Len() || !strings.Contains(string(data), "failure") {
+		t.Errorf("Expected all logs in the file after Sync, got %q", string(data))
+	}
+}
+
+// TestLogger_Sync_Outputs tests that Sync syncs every output once, after the queued logs are written.
+func TestLogger_Sync_Outputs(t *testing.T) {
+	var out, routed syncedBuffer
+
+	dispatcher := NewAsyncDispatcher(10)
+	defer dispatcher.Close()
+
+	logger := NewLogger("").OutputTo(&out).ErrorsTo(&out).RouteLevel(DEBUG, &routed).WithSharedAsync(dispatcher)
+	logger.Infof("info")
+	logger.Errorf("error")
+	logger.Debugf("debug")
+
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out.synced) != 1 || out.synced[0] != "INFO: info\nERROR: error\n" {
+		t.Errorf("Expected the output synced once after the queued logs, got %q", out.synced)
+	}
+	if len(routed.synced) != 1 || routed.synced[0] != "DEBUG: debug\n" {
+		t.Errorf("Expected the routed writer synced once after the queued logs, got %q", routed.synced)
+	}
+}
+
+// TestLogger_Flush_Async tests that Flush writes the logs queued by an asynchronous logger before flushing.
+func TestLogger_Flush_Async(t *testing.T) {
+	var buffer bytes.Buffer
+	buffered := bufio.NewWriter(&buffer)
+
+	logger, cancel := NewLogger("").OutputTo(buffered).WithAsync(true, 500)
+	defer cancel()
+
+	var expected strings.Builder
+	for i := 0; i < 200; i++ {
+		logger.Infof("event %d", i)
+		fmt.Fprintf(&expected, "INFO: event %d\n", i)
+	}
+
+	if err := logger.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if buffer.String() != expected.String() {
+		t.Errorf("Expected all queued logs after Flush, got %d bytes of %d", buffer.Len(), expected.Len())
+	}
+}
+
+// TestLogger_Close_Async tests that Close writes the logs queued by an asynchronous logger before closing the outputs.
+func TestLogger_Close_Async(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger, cancel := NewLogger("").OutputTo(file).ErrorsTo(file).WithAsync(true, 500)
+	defer cancel()
+
+	for i := 0; i < 200; i++ {
+		if err := logger.InfoJSONf(map[string]int{"event": i}, "queued"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 200 {
+		t.Errorf("Expected 200 logs in the file after Close, got %d", lines)
+	}
+}
+
+// TestLogger_Sync_Stopped tests that Sync returns when the background goroutine of the logger is stopped.
+func TestLogger_Sync_Stopped(t *testing.T) {
+	var buf bytes.Buffer
+	logger, cancel := NewLogger("").OutputTo(&buf).WithAsync(true, 10)
+	cancel()
+
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+}
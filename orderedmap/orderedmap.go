@@ -0,0 +1,140 @@
+// Package orderedmap provides a generic map that remembers the insertion order of its keys.
+package orderedmap
+
+import (
+	"github.com/0x626f/go-kit/linkedlist"
+	"github.com/0x626f/go-kit/types"
+	"github.com/0x626f/go-kit/utils"
+)
+
+// Option configures an OrderedMap created by New.
+type Option func(*options)
+
+// options holds the settings of an OrderedMap.
+type options struct {
+	// moveOnUpdate makes Set move an existing key to the end of the order
+	moveOnUpdate bool
+}
+
+// WithMoveOnUpdate makes Set on an existing key move it to the end of the order,
+// as if it had been deleted and inserted again. By default an update keeps the original position.
+func WithMoveOnUpdate() Option {
+	return func(options *options) {
+		options.moveOnUpdate = true
+	}
+}
+
+// OrderedMap is a map that iterates over its entries in insertion order.
+// Lookups go through a Go map while the order is kept in a doubly linked list,
+// so Set, Get and Delete run in O(1) time.
+//
+// An OrderedMap is not safe for concurrent use.
+//
+// Type parameters:
+//   - K: The type of keys (must be comparable)
+//   - V: The type of values
+type OrderedMap[K comparable, V any] struct {
+	options
+	// nodes maps keys to their entries in order
+	nodes map[K]*linkedlist.LinkedNode[types.Pair[K, V]]
+	// order holds the entries from oldest to newest
+	order *linkedlist.LinkedList[types.Pair[K, V]]
+}
+
+// New creates an empty OrderedMap.
+//
+// Parameters:
+//   - opts: Optional settings such as WithMoveOnUpdate
+//
+// Example:
+//
+//	headers := orderedmap.New[string, string]()
+//	headers.Set("Host", "example.com")
+//	headers.Set("Accept", "*/*")
+//	headers.Keys() // [Host Accept]
+func New[K comparable, V any](opts ...Option) *OrderedMap[K, V] {
+	orderedMap := &OrderedMap[K, V]{
+		nodes: make(map[K]*linkedlist.LinkedNode[types.Pair[K, V]]),
+		order: linkedlist.NewLinkedList[types.Pair[K, V]](),
+	}
+
+	for _, opt := range opts {
+		opt(&orderedMap.options)
+	}
+
+	return orderedMap
+}
+
+// Set stores value under key. A new key is appended to the end of the order;
+// an existing key keeps its position unless the map was created with WithMoveOnUpdate.
+//
+// Time complexity: O(1)
+func (orderedMap *OrderedMap[K, V]) Set(key K, value V) {
+	if node, exists := orderedMap.nodes[key]; exists {
+		if !orderedMap.moveOnUpdate {
+			node.Data.Second = value
+			return
+		}
+		orderedMap.order.Remove(node)
+	}
+
+	orderedMap.nodes[key] = orderedMap.order.Insert(types.Pair[K, V]{First: key, Second: value})
+}
+
+// Get returns the value stored under key.
+//
+// Returns:
+//   - The value and true if the key exists
+//   - A zero value and false otherwise
+//
+// Time complexity: O(1)
+func (orderedMap *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if node, exists := orderedMap.nodes[key]; exists {
+		return node.Data.Second, true
+	}
+	return utils.Zero[V](), false
+}
+
+// Delete removes key from the map.
+//
+// Returns:
+//   - true if the key existed and was removed
+//   - false otherwise
+//
+// Time complexity: O(1)
+func (orderedMap *OrderedMap[K, V]) Delete(key K) bool {
+	node, exists := orderedMap.nodes[key]
+	if !exists {
+		return false
+	}
+
+	orderedMap.order.Remove(node)
+	delete(orderedMap.nodes, key)
+	return true
+}
+
+// Len returns the number of entries in the map.
+func (orderedMap *OrderedMap[K, V]) Len() int {
+	return len(orderedMap.nodes)
+}
+
+// ForEach calls receiver for every entry in insertion order.
+// If the receiver function returns false, the iteration is stopped.
+// The map must not be modified during the iteration.
+func (orderedMap *OrderedMap[K, V]) ForEach(receiver func(key K, value V) bool) {
+	orderedMap.order.ForEach(func(_ int, entry types.Pair[K, V]) bool {
+		return receiver(entry.First, entry.Second)
+	})
+}
+
+// Keys returns the keys in insertion order.
+//
+// Time complexity: O(n)
+func (orderedMap *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(orderedMap.nodes))
+	orderedMap.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
@@ -0,0 +1,141 @@
+package orderedmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedMap_InsertOrder(t *testing.T) {
+	orderedMap := New[string, int]()
+	for i, key := range []string{"c", "a", "b"} {
+		orderedMap.Set(key, i)
+	}
+
+	if !slices.Equal(orderedMap.Keys(), []string{"c", "a", "b"}) {
+		t.Fatalf("Expected [c a b], got %v", orderedMap.Keys())
+	}
+	if orderedMap.Len() != 3 {
+		t.Fatalf("Expected length 3, got %d", orderedMap.Len())
+	}
+
+	var values []int
+	orderedMap.ForEach(func(key string, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	if !slices.Equal(values, []int{0, 1, 2}) {
+		t.Fatalf("Expected [0 1 2], got %v", values)
+	}
+}
+
+func TestOrderedMap_Get(t *testing.T) {
+	orderedMap := New[string, int]()
+	orderedMap.Set("a", 1)
+
+	if value, ok := orderedMap.Get("a"); !ok || value != 1 {
+		t.Fatalf("Expected 1, got %d, %v", value, ok)
+	}
+	if value, ok := orderedMap.Get("missing"); ok || value != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", value, ok)
+	}
+}
+
+func TestOrderedMap_UpdateKeepsPosition(t *testing.T) {
+	orderedMap := New[string, int]()
+	orderedMap.Set("a", 1)
+	orderedMap.Set("b", 2)
+	orderedMap.Set("c", 3)
+
+	orderedMap.Set("a", 10)
+
+	if !slices.Equal(orderedMap.Keys(), []string{"a", "b", "c"}) {
+		t.Fatalf("Expected [a b c], got %v", orderedMap.Keys())
+	}
+	if value, _ := orderedMap.Get("a"); value != 10 {
+		t.Fatalf("Expected updated value 10, got %d", value)
+	}
+	if orderedMap.Len() != 3 {
+		t.Fatalf("Expected length 3, got %d", orderedMap.Len())
+	}
+}
+
+func TestOrderedMap_UpdateMovesToEnd(t *testing.T) {
+	orderedMap := New[string, int](WithMoveOnUpdate())
+	orderedMap.Set("a", 1)
+	orderedMap.Set("b", 2)
+	orderedMap.Set("c", 3)
+
+	orderedMap.Set("a", 10)
+	if !slices.Equal(orderedMap.Keys(), []string{"b", "c", "a"}) {
+		t.Fatalf("Expected [b c a], got %v", orderedMap.Keys())
+	}
+
+	// updating the last key keeps it last
+	orderedMap.Set("a", 20)
+	if !slices.Equal(orderedMap.Keys(), []string{"b", "c", "a"}) {
+		t.Fatalf("Expected [b c a], got %v", orderedMap.Keys())
+	}
+	if value, _ := orderedMap.Get("a"); value != 20 {
+		t.Fatalf("Expected updated value 20, got %d", value)
+	}
+	if orderedMap.Len() != 3 {
+		t.Fatalf("Expected length 3, got %d", orderedMap.Len())
+	}
+}
+
+func TestOrderedMap_Delete(t *testing.T) {
+	orderedMap := New[string, int]()
+	for i, key := range []string{"a", "b", "c", "d"} {
+		orderedMap.Set(key, i)
+	}
+
+	if !orderedMap.Delete("b") {
+		t.Fatal("Delete of existing key should succeed")
+	}
+	if orderedMap.Delete("b") {
+		t.Fatal("Delete of missing key should fail")
+	}
+	if !slices.Equal(orderedMap.Keys(), []string{"a", "c", "d"}) {
+		t.Fatalf("Expected [a c d], got %v", orderedMap.Keys())
+	}
+
+	// deleting the ends
+	orderedMap.Delete("a")
+	orderedMap.Delete("d")
+	if !slices.Equal(orderedMap.Keys(), []string{"c"}) {
+		t.Fatalf("Expected [c], got %v", orderedMap.Keys())
+	}
+
+	// a re-inserted key goes to the end
+	orderedMap.Set("a", 0)
+	if !slices.Equal(orderedMap.Keys(), []string{"c", "a"}) {
+		t.Fatalf("Expected [c a], got %v", orderedMap.Keys())
+	}
+
+	orderedMap.Delete("c")
+	orderedMap.Delete("a")
+	if orderedMap.Len() != 0 || len(orderedMap.Keys()) != 0 {
+		t.Fatal("Map should be empty")
+	}
+
+	orderedMap.Set("z", 1)
+	if !slices.Equal(orderedMap.Keys(), []string{"z"}) {
+		t.Fatalf("Expected [z], got %v", orderedMap.Keys())
+	}
+}
+
+func TestOrderedMap_ForEachStops(t *testing.T) {
+	orderedMap := New[int, int]()
+	for i := 0; i < 10; i++ {
+		orderedMap.Set(i, i)
+	}
+
+	var visited []int
+	orderedMap.ForEach(func(key, value int) bool {
+		visited = append(visited, key)
+		return key < 2
+	})
+	if !slices.Equal(visited, []int{0, 1, 2}) {
+		t.Fatalf("Expected [0 1 2], got %v", visited)
+	}
+}
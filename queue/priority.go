@@ -0,0 +1,186 @@
+package queue
+
+import (
+	"github.com/0x626f/go-kit/abstract"
+	"github.com/0x626f/go-kit/utils"
+)
+
+// PriorityQueue is a slice-backed binary heap that always yields the element
+// ordered first by its comparator: the smallest one for an ascending comparator (min-heap),
+// or the largest one for a descending comparator (max-heap).
+// Elements with equal priority are returned in unspecified order.
+//
+// A PriorityQueue is not safe for concurrent use.
+//
+// Type parameters:
+//   - D: The type of elements stored in the queue
+//
+// Example:
+//
+//	minQueue := queue.NewPriorityQueue(number.NumericComparator[int])
+//	maxQueue := queue.NewPriorityQueue(func(a, b int) int { return number.NumericComparator(b, a) })
+type PriorityQueue[D any] struct {
+	// heap holds the elements in binary heap order
+	heap []*Element[D]
+	// comparator orders the elements; the lowest element is at the root
+	comparator abstract.Comparator[D]
+}
+
+// Element is a handle to a value stored in a PriorityQueue, returned by Push
+// so the value can later be changed with UpdatePriority.
+//
+// Type parameters:
+//   - D: The type of the stored value
+type Element[D any] struct {
+	// Data is the stored value
+	Data D
+	// index is the position of the element in the heap, or -1 once it was popped
+	index int
+}
+
+// NewPriorityQueue creates an empty PriorityQueue ordered by comparator.
+//
+// Parameters:
+//   - comparator: The function ordering elements; Pop returns the element comparing lowest
+func NewPriorityQueue[D any](comparator abstract.Comparator[D]) *PriorityQueue[D] {
+	return &PriorityQueue[D]{comparator: comparator}
+}
+
+// Push adds an element to the queue.
+//
+// Returns:
+//   - A handle to the element, usable with UpdatePriority while it stays in the queue
+//
+// Time complexity: O(log n)
+func (queue *PriorityQueue[D]) Push(data D) *Element[D] {
+	element := &Element[D]{Data: data, index: len(queue.heap)}
+	queue.heap = append(queue.heap, element)
+	queue.up(element.index)
+	return element
+}
+
+// Pop removes and returns the element ordered first by the comparator.
+//
+// Returns:
+//   - The first element and true if the queue wasn't empty
+//   - A zero value and false if the queue is empty
+//
+// Time complexity: O(log n)
+func (queue *PriorityQueue[D]) Pop() (D, bool) {
+	if len(queue.heap) == 0 {
+		return utils.Zero[D](), false
+	}
+
+	last := len(queue.heap) - 1
+	queue.swap(0, last)
+
+	element := queue.heap[last]
+	queue.heap[last] = nil
+	queue.heap = queue.heap[:last]
+	element.index = -1
+
+	queue.down(0)
+
+	return element.Data, true
+}
+
+// Peek returns the element ordered first by the comparator without removing it.
+//
+// Returns:
+//   - The first element and true if the queue isn't empty
+//   - A zero value and false if the queue is empty
+//
+// Time complexity: O(1)
+func (queue *PriorityQueue[D]) Peek() (D, bool) {
+	if len(queue.heap) == 0 {
+		return utils.Zero[D](), false
+	}
+
+	return queue.heap[0].Data, true
+}
+
+// Len returns the number of elements in the queue.
+func (queue *PriorityQueue[D]) Len() int {
+	return len(queue.heap)
+}
+
+// IsEmpty returns true if the queue contains no elements.
+func (queue *PriorityQueue[D]) IsEmpty() bool {
+	return len(queue.heap) == 0
+}
+
+// UpdatePriority replaces the value of an element still in the queue and restores the heap order.
+//
+// Parameters:
+//   - element: The handle returned by Push
+//   - data: The new value of the element
+//
+// Returns:
+//   - true if the element was updated
+//   - false if the element was already popped or belongs to another queue
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	tasks := queue.NewPriorityQueue(byDeadline)
+//	handle := tasks.Push(task)
+//	task.Deadline = task.Deadline.Add(-time.Hour)
+//	tasks.UpdatePriority(handle, task)
+func (queue *PriorityQueue[D]) UpdatePriority(element *Element[D], data D) bool {
+	if element == nil || element.index < 0 || element.index >= len(queue.heap) || queue.heap[element.index] != element {
+		return false
+	}
+
+	element.Data = data
+	if !queue.up(element.index) {
+		queue.down(element.index)
+	}
+	return true
+}
+
+// less reports whether the element at i is ordered before the element at j.
+func (queue *PriorityQueue[D]) less(i, j int) bool {
+	return queue.comparator(queue.heap[i].Data, queue.heap[j].Data) < 0
+}
+
+// swap exchanges the elements at i and j, keeping their indices in sync.
+func (queue *PriorityQueue[D]) swap(i, j int) {
+	queue.heap[i], queue.heap[j] = queue.heap[j], queue.heap[i]
+	queue.heap[i].index = i
+	queue.heap[j].index = j
+}
+
+// up moves the element at index towards the root until its parent is ordered before it.
+// It reports whether the element moved.
+func (queue *PriorityQueue[D]) up(index int) bool {
+	start := index
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !queue.less(index, parent) {
+			break
+		}
+		queue.swap(index, parent)
+		index = parent
+	}
+	return index != start
+}
+
+// down moves the element at index towards the leaves until it is ordered before its children.
+func (queue *PriorityQueue[D]) down(index int) {
+	size := len(queue.heap)
+	for {
+		child := 2*index + 1
+		if child >= size {
+			return
+		}
+		if right := child + 1; right < size && queue.less(right, child) {
+			child = right
+		}
+		if !queue.less(child, index) {
+			return
+		}
+		queue.swap(index, child)
+		index = child
+	}
+}
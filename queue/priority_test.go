@@ -0,0 +1,164 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/0x626f/go-kit/number"
+)
+
+func ascending(a, b int) int  { return number.NumericComparator(a, b) }
+func descending(a, b int) int { return number.NumericComparator(b, a) }
+
+func TestPriorityQueue_Empty(t *testing.T) {
+	queue := NewPriorityQueue(ascending)
+
+	if item, ok := queue.Pop(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if item, ok := queue.Peek(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if !queue.IsEmpty() || queue.Len() != 0 {
+		t.Fatal("New queue should be empty")
+	}
+}
+
+func TestPriorityQueue_MinHeap(t *testing.T) {
+	const count = 1000
+	queue := NewPriorityQueue(ascending)
+
+	for _, item := range rand.New(rand.NewSource(1)).Perm(count) {
+		queue.Push(item)
+	}
+	if queue.Len() != count {
+		t.Fatalf("Expected %d elements, got %d", count, queue.Len())
+	}
+
+	for expected := 0; expected < count; expected++ {
+		if top, _ := queue.Peek(); top != expected {
+			t.Fatalf("Expected %d on top, got %d", expected, top)
+		}
+		if item, ok := queue.Pop(); !ok || item != expected {
+			t.Fatalf("Expected %d, got %d, %v", expected, item, ok)
+		}
+	}
+
+	if !queue.IsEmpty() {
+		t.Fatal("Queue should be empty after popping every element")
+	}
+}
+
+func TestPriorityQueue_MaxHeap(t *testing.T) {
+	const count = 1000
+	queue := NewPriorityQueue(descending)
+
+	for _, item := range rand.New(rand.NewSource(2)).Perm(count) {
+		queue.Push(item)
+	}
+
+	for expected := count - 1; expected >= 0; expected-- {
+		if item, ok := queue.Pop(); !ok || item != expected {
+			t.Fatalf("Expected %d, got %d, %v", expected, item, ok)
+		}
+	}
+}
+
+func TestPriorityQueue_Duplicates(t *testing.T) {
+	queue := NewPriorityQueue(ascending)
+	for _, item := range []int{3, 1, 3, 2, 1} {
+		queue.Push(item)
+	}
+
+	for _, expected := range []int{1, 1, 2, 3, 3} {
+		if item, _ := queue.Pop(); item != expected {
+			t.Fatalf("Expected %d, got %d", expected, item)
+		}
+	}
+}
+
+type task struct {
+	name     string
+	priority int
+}
+
+func byPriority(a, b task) int { return number.NumericComparator(a.priority, b.priority) }
+
+func TestPriorityQueue_UpdatePriority(t *testing.T) {
+	queue := NewPriorityQueue(byPriority)
+
+	handles := map[string]*Element[task]{}
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		handles[name] = queue.Push(task{name: name, priority: (i + 1) * 10})
+	}
+
+	// move "e" to the front
+	if !queue.UpdatePriority(handles["e"], task{name: "e", priority: 1}) {
+		t.Fatal("Update of queued element should succeed")
+	}
+	// move "a" to the back
+	if !queue.UpdatePriority(handles["a"], task{name: "a", priority: 100}) {
+		t.Fatal("Update of queued element should succeed")
+	}
+
+	if top, _ := queue.Peek(); top.name != "e" {
+		t.Fatalf("Expected e on top, got %s", top.name)
+	}
+
+	var order []string
+	for !queue.IsEmpty() {
+		item, _ := queue.Pop()
+		order = append(order, item.name)
+	}
+
+	expected := []string{"e", "b", "c", "d", "a"}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("Expected order %v, got %v", expected, order)
+		}
+	}
+
+	if queue.UpdatePriority(handles["a"], task{name: "a", priority: 0}) {
+		t.Fatal("Update of popped element should fail")
+	}
+	if queue.UpdatePriority(nil, task{}) {
+		t.Fatal("Update of nil element should fail")
+	}
+}
+
+func TestPriorityQueue_UpdatePriority_ForeignElement(t *testing.T) {
+	queue := NewPriorityQueue(ascending)
+	other := NewPriorityQueue(ascending)
+
+	queue.Push(1)
+	foreign := other.Push(2)
+
+	if queue.UpdatePriority(foreign, 0) {
+		t.Fatal("Update of element from another queue should fail")
+	}
+	if top, _ := queue.Peek(); top != 1 {
+		t.Fatalf("Foreign update should not change the queue, got %d on top", top)
+	}
+}
+
+func TestPriorityQueue_UpdatePriority_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	queue := NewPriorityQueue(ascending)
+
+	var handles []*Element[int]
+	for i := 0; i < 500; i++ {
+		handles = append(handles, queue.Push(random.Intn(1000)))
+	}
+	for i := 0; i < 500; i++ {
+		queue.UpdatePriority(handles[random.Intn(len(handles))], random.Intn(1000))
+	}
+
+	previous := -1
+	for !queue.IsEmpty() {
+		item, _ := queue.Pop()
+		if item < previous {
+			t.Fatalf("Heap order violated: %d popped after %d", item, previous)
+		}
+		previous = item
+	}
+}
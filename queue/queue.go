@@ -0,0 +1,89 @@
+// Package queue provides a generic FIFO (first in, first out) queue, a fixed-capacity ring buffer
+// and a binary-heap-based priority queue.
+package queue
+
+import (
+	"github.com/0x626f/go-kit/linkedlist"
+	"github.com/0x626f/go-kit/utils"
+)
+
+// Queue is a FIFO collection backed by a doubly linked list: elements are enqueued
+// at the back and dequeued from the front, so they leave in the order they arrived.
+// Every operation runs in O(1) time. List nodes are pooled, so a queue with a steady
+// flow of elements doesn't allocate once it has warmed up.
+//
+// A Queue is not safe for concurrent use.
+//
+// Type parameters:
+//   - D: The type of elements stored in the queue
+type Queue[D any] struct {
+	list *linkedlist.LinkedList[D]
+}
+
+// NewQueue creates and returns an empty Queue.
+func NewQueue[D any]() *Queue[D] {
+	return &Queue[D]{list: linkedlist.NewLinkedListPooled[D]()}
+}
+
+// FromSlice creates a new Queue containing the provided items,
+// so that the first item of the slice is dequeued first.
+//
+// Parameters:
+//   - items: The elements to enqueue, in order
+//
+// Example:
+//
+//	q := queue.FromSlice([]string{"a", "b"})
+//	first, _ := q.Dequeue() // first == "a"
+func FromSlice[D any](items []D) *Queue[D] {
+	instance := NewQueue[D]()
+	instance.list.PushAll(items...)
+	return instance
+}
+
+// Enqueue adds an element to the back of the queue.
+//
+// Time complexity: O(1)
+func (queue *Queue[D]) Enqueue(item D) {
+	queue.list.Push(item)
+}
+
+// Dequeue removes and returns the element at the front of the queue.
+//
+// Returns:
+//   - The front element and true if the queue wasn't empty
+//   - A zero value and false if the queue is empty
+//
+// Time complexity: O(1)
+func (queue *Queue[D]) Dequeue() (D, bool) {
+	if queue.list.IsEmpty() {
+		return utils.Zero[D](), false
+	}
+
+	return queue.list.PopLeft(), true
+}
+
+// Peek returns the element at the front of the queue without removing it.
+//
+// Returns:
+//   - The front element and true if the queue isn't empty
+//   - A zero value and false if the queue is empty
+//
+// Time complexity: O(1)
+func (queue *Queue[D]) Peek() (D, bool) {
+	if queue.list.IsEmpty() {
+		return utils.Zero[D](), false
+	}
+
+	return queue.list.First(), true
+}
+
+// Len returns the number of elements in the queue.
+func (queue *Queue[D]) Len() int {
+	return queue.list.Size()
+}
+
+// IsEmpty returns true if the queue contains no elements.
+func (queue *Queue[D]) IsEmpty() bool {
+	return queue.list.IsEmpty()
+}
@@ -0,0 +1,99 @@
+package queue
+
+import "testing"
+
+func TestQueue_EmptyDequeue(t *testing.T) {
+	queue := NewQueue[int]()
+
+	if item, ok := queue.Dequeue(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if item, ok := queue.Peek(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if !queue.IsEmpty() || queue.Len() != 0 {
+		t.Fatal("New queue should be empty")
+	}
+}
+
+func TestQueue_Ordering(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	if item, ok := queue.Peek(); !ok || item != 0 {
+		t.Fatalf("Expected 0 at the front, got %d, %v", item, ok)
+	}
+	if queue.Len() != 5 {
+		t.Fatalf("Peek should not remove elements, got length %d", queue.Len())
+	}
+
+	for expected := 0; expected < 5; expected++ {
+		if item, ok := queue.Dequeue(); !ok || item != expected {
+			t.Fatalf("Expected %d, got %d, %v", expected, item, ok)
+		}
+	}
+
+	if _, ok := queue.Dequeue(); ok {
+		t.Fatal("Queue should be empty after dequeuing every element")
+	}
+}
+
+func TestQueue_FromSlice(t *testing.T) {
+	queue := FromSlice([]string{"a", "b", "c"})
+
+	if queue.Len() != 3 {
+		t.Fatalf("Expected 3 elements, got %d", queue.Len())
+	}
+	for _, expected := range []string{"a", "b", "c"} {
+		if item, _ := queue.Dequeue(); item != expected {
+			t.Fatalf("Expected %s, got %s", expected, item)
+		}
+	}
+
+	if empty := FromSlice[int](nil); !empty.IsEmpty() {
+		t.Fatal("Queue from nil slice should be empty")
+	}
+}
+
+func TestQueue_Interleaved(t *testing.T) {
+	queue := NewQueue[int]()
+	next := 0
+	expected := 0
+
+	// enqueue two, dequeue one, until the queue holds many elements
+	for round := 0; round < 100; round++ {
+		queue.Enqueue(next)
+		next++
+		queue.Enqueue(next)
+		next++
+
+		if item, ok := queue.Dequeue(); !ok || item != expected {
+			t.Fatalf("Expected %d, got %d, %v", expected, item, ok)
+		}
+		expected++
+	}
+
+	if queue.Len() != 100 {
+		t.Fatalf("Expected 100 elements, got %d", queue.Len())
+	}
+
+	// drain the queue, enqueueing every tenth element again
+	for !queue.IsEmpty() {
+		item, _ := queue.Dequeue()
+		if item != expected {
+			t.Fatalf("Expected %d, got %d", expected, item)
+		}
+		expected++
+
+		if item%10 == 0 {
+			queue.Enqueue(next)
+			next++
+		}
+	}
+
+	if expected != next {
+		t.Fatalf("Expected to dequeue %d elements, dequeued %d", next, expected)
+	}
+}
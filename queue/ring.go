@@ -0,0 +1,146 @@
+package queue
+
+import "github.com/0x626f/go-kit/utils"
+
+// FullPolicy decides what RingBuffer.Push does when the buffer is full.
+type FullPolicy uint8
+
+const (
+	// Overwrite makes Push replace the oldest element when the buffer is full.
+	Overwrite FullPolicy = iota
+
+	// Reject makes Push discard the new element when the buffer is full.
+	Reject
+)
+
+// RingBuffer is a fixed-capacity FIFO circular buffer. Elements are pushed at the back
+// and popped from the front; when the buffer is full, Push either overwrites the oldest
+// element or rejects the new one, depending on its FullPolicy.
+// It suits rolling windows such as recent metrics or the last N log lines.
+//
+// A RingBuffer is not safe for concurrent use.
+//
+// Type parameters:
+//   - D: The type of elements stored in the buffer
+type RingBuffer[D any] struct {
+	// items is the circular storage, allocated once with the buffer's capacity
+	items []D
+	// head is the position of the oldest element
+	head int
+	// size is the number of stored elements
+	size int
+	// policy decides how Push behaves when the buffer is full
+	policy FullPolicy
+}
+
+// NewRingBuffer creates an empty RingBuffer holding at most capacity elements.
+// A capacity lower than 1 is raised to 1.
+//
+// Parameters:
+//   - capacity: The maximum number of elements
+//   - policy: What Push does when the buffer is full
+//
+// Example:
+//
+//	tail := queue.NewRingBuffer[string](100, queue.Overwrite)
+//	for _, line := range lines {
+//	    tail.Push(line)
+//	}
+//	last := tail.ToSlice() // at most the last 100 lines, oldest first
+func NewRingBuffer[D any](capacity int, policy FullPolicy) *RingBuffer[D] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &RingBuffer[D]{
+		items:  make([]D, capacity),
+		policy: policy,
+	}
+}
+
+// Push adds an element at the back of the buffer.
+// When the buffer is full, the Overwrite policy drops the oldest element to make room,
+// while the Reject policy leaves the buffer unchanged.
+//
+// Returns:
+//   - true if the element was stored
+//   - false if the buffer is full and its policy is Reject
+//
+// Time complexity: O(1)
+func (buffer *RingBuffer[D]) Push(item D) bool {
+	if buffer.size == len(buffer.items) {
+		if buffer.policy == Reject {
+			return false
+		}
+
+		buffer.items[buffer.head] = item
+		buffer.head = buffer.wrap(buffer.head + 1)
+		return true
+	}
+
+	buffer.items[buffer.wrap(buffer.head+buffer.size)] = item
+	buffer.size++
+	return true
+}
+
+// Pop removes and returns the oldest element.
+//
+// Returns:
+//   - The oldest element and true if the buffer wasn't empty
+//   - A zero value and false if the buffer is empty
+//
+// Time complexity: O(1)
+func (buffer *RingBuffer[D]) Pop() (D, bool) {
+	if buffer.size == 0 {
+		return utils.Zero[D](), false
+	}
+
+	item := buffer.items[buffer.head]
+
+	// release the reference so the popped element can be garbage collected
+	buffer.items[buffer.head] = utils.Zero[D]()
+	buffer.head = buffer.wrap(buffer.head + 1)
+	buffer.size--
+
+	return item, true
+}
+
+// Len returns the number of elements in the buffer.
+func (buffer *RingBuffer[D]) Len() int {
+	return buffer.size
+}
+
+// Cap returns the maximum number of elements the buffer holds.
+func (buffer *RingBuffer[D]) Cap() int {
+	return len(buffer.items)
+}
+
+// IsEmpty returns true if the buffer contains no elements.
+func (buffer *RingBuffer[D]) IsEmpty() bool {
+	return buffer.size == 0
+}
+
+// IsFull returns true if the buffer holds Cap elements.
+func (buffer *RingBuffer[D]) IsFull() bool {
+	return buffer.size == len(buffer.items)
+}
+
+// ToSlice returns the elements in a new slice, ordered from oldest to newest.
+//
+// Time complexity: O(n)
+func (buffer *RingBuffer[D]) ToSlice() []D {
+	result := make([]D, buffer.size)
+
+	copied := copy(result, buffer.items[buffer.head:min(buffer.head+buffer.size, len(buffer.items))])
+	copy(result[copied:], buffer.items[:buffer.size-copied])
+
+	return result
+}
+
+// wrap maps a position past the end of the storage back to its start.
+func (buffer *RingBuffer[D]) wrap(index int) int {
+	if index >= len(buffer.items) {
+		return index - len(buffer.items)
+	}
+	return index
+}
@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBuffer_Empty(t *testing.T) {
+	buffer := NewRingBuffer[int](3, Overwrite)
+
+	if item, ok := buffer.Pop(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if !buffer.IsEmpty() || buffer.IsFull() || buffer.Len() != 0 || buffer.Cap() != 3 {
+		t.Fatal("New buffer should be empty with capacity 3")
+	}
+	if len(buffer.ToSlice()) != 0 {
+		t.Fatal("Empty buffer should have no elements")
+	}
+}
+
+func TestRingBuffer_Ordering(t *testing.T) {
+	buffer := NewRingBuffer[int](3, Overwrite)
+	buffer.Push(1)
+	buffer.Push(2)
+
+	if !slices.Equal(buffer.ToSlice(), []int{1, 2}) {
+		t.Fatalf("Expected [1 2], got %v", buffer.ToSlice())
+	}
+	if item, _ := buffer.Pop(); item != 1 {
+		t.Fatalf("Expected 1, got %d", item)
+	}
+	if item, _ := buffer.Pop(); item != 2 {
+		t.Fatalf("Expected 2, got %d", item)
+	}
+	if !buffer.IsEmpty() {
+		t.Fatal("Buffer should be empty")
+	}
+}
+
+func TestRingBuffer_Overwrite(t *testing.T) {
+	buffer := NewRingBuffer[int](3, Overwrite)
+	for i := 1; i <= 3; i++ {
+		buffer.Push(i)
+	}
+	if !buffer.IsFull() {
+		t.Fatal("Buffer should be full")
+	}
+
+	if !buffer.Push(4) {
+		t.Fatal("Overwriting push should succeed")
+	}
+	if buffer.Len() != 3 {
+		t.Fatalf("Expected length 3, got %d", buffer.Len())
+	}
+	if !slices.Equal(buffer.ToSlice(), []int{2, 3, 4}) {
+		t.Fatalf("Expected [2 3 4], got %v", buffer.ToSlice())
+	}
+
+	// overwrite the whole buffer more than once
+	for i := 5; i <= 10; i++ {
+		buffer.Push(i)
+	}
+	if !slices.Equal(buffer.ToSlice(), []int{8, 9, 10}) {
+		t.Fatalf("Expected [8 9 10], got %v", buffer.ToSlice())
+	}
+	if item, _ := buffer.Pop(); item != 8 {
+		t.Fatalf("Expected 8, got %d", item)
+	}
+}
+
+func TestRingBuffer_Reject(t *testing.T) {
+	buffer := NewRingBuffer[int](2, Reject)
+
+	if !buffer.Push(1) || !buffer.Push(2) {
+		t.Fatal("Push below capacity should succeed")
+	}
+	if buffer.Push(3) {
+		t.Fatal("Push on full buffer should be rejected")
+	}
+	if !slices.Equal(buffer.ToSlice(), []int{1, 2}) {
+		t.Fatalf("Rejected push should not change the buffer, got %v", buffer.ToSlice())
+	}
+
+	buffer.Pop()
+	if !buffer.Push(3) {
+		t.Fatal("Push after Pop should succeed")
+	}
+	if !slices.Equal(buffer.ToSlice(), []int{2, 3}) {
+		t.Fatalf("Expected [2 3], got %v", buffer.ToSlice())
+	}
+}
+
+func TestRingBuffer_Wraparound(t *testing.T) {
+	buffer := NewRingBuffer[int](4, Reject)
+	next, expected := 0, 0
+
+	// keep the buffer partially filled while the head walks around the storage several times
+	for round := 0; round < 20; round++ {
+		for buffer.Len() < 3 {
+			buffer.Push(next)
+			next++
+		}
+
+		want := []int{expected, expected + 1, expected + 2}
+		if !slices.Equal(buffer.ToSlice(), want) {
+			t.Fatalf("Round %d: expected %v, got %v", round, want, buffer.ToSlice())
+		}
+
+		for i := 0; i < 2; i++ {
+			if item, _ := buffer.Pop(); item != expected {
+				t.Fatalf("Round %d: expected %d, got %d", round, expected, item)
+			}
+			expected++
+		}
+	}
+}
+
+func TestRingBuffer_MinimumCapacity(t *testing.T) {
+	buffer := NewRingBuffer[string](0, Overwrite)
+	if buffer.Cap() != 1 {
+		t.Fatalf("Expected capacity 1, got %d", buffer.Cap())
+	}
+
+	buffer.Push("a")
+	buffer.Push("b")
+	if !slices.Equal(buffer.ToSlice(), []string{"b"}) {
+		t.Fatalf("Expected [b], got %v", buffer.ToSlice())
+	}
+}
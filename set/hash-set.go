@@ -0,0 +1,159 @@
+package set
+
+import "github.com/0x626f/go-kit/abstract"
+
+// HashSet is a set of comparable values backed by a map. Unlike Set and PrimarySet,
+// it stores values directly, without requiring them to implement the Keyable interface.
+//
+// The algebra operations (Union, Intersection, Difference) return new sets and never
+// modify their inputs. Iteration order (ForEach, ToSlice) is unspecified and may differ
+// between calls, as with Go maps.
+//
+// A HashSet is not safe for concurrent use.
+//
+// Type parameters:
+//   - T: The element type, must be comparable (support == and != operators)
+type HashSet[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewHashSet creates a HashSet containing the provided items, ignoring duplicates.
+//
+// Example:
+//
+//	primes := set.NewHashSet(2, 3, 5, 7)
+//	primes.Contains(5) // true
+func NewHashSet[T comparable](items ...T) *HashSet[T] {
+	instance := &HashSet[T]{items: make(map[T]struct{}, len(items))}
+	instance.Add(items...)
+	return instance
+}
+
+// Add inserts the provided items into the set. Items already present are ignored.
+//
+// Time complexity: O(n) where n is the number of items
+func (set *HashSet[T]) Add(items ...T) {
+	for _, item := range items {
+		set.items[item] = struct{}{}
+	}
+}
+
+// Remove deletes the provided items from the set. Items not present are ignored.
+//
+// Time complexity: O(n) where n is the number of items
+func (set *HashSet[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(set.items, item)
+	}
+}
+
+// Contains returns true if the item is in the set.
+//
+// Time complexity: O(1)
+func (set *HashSet[T]) Contains(item T) bool {
+	_, exists := set.items[item]
+	return exists
+}
+
+// Len returns the number of elements in the set.
+func (set *HashSet[T]) Len() int {
+	return len(set.items)
+}
+
+// IsEmpty returns true if the set contains no elements.
+func (set *HashSet[T]) IsEmpty() bool {
+	return len(set.items) == 0
+}
+
+// ForEach executes the provided function once for each element in the set, in unspecified order.
+// If the receiver function returns false, the iteration is stopped.
+func (set *HashSet[T]) ForEach(receiver abstract.Receiver[T]) {
+	for item := range set.items {
+		if !receiver(item) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the elements of the set in a new slice, in unspecified order.
+func (set *HashSet[T]) ToSlice() []T {
+	result := make([]T, 0, len(set.items))
+	for item := range set.items {
+		result = append(result, item)
+	}
+	return result
+}
+
+// Union returns a new set containing the elements that are in either set.
+//
+// Time complexity: O(n + m)
+//
+// Example:
+//
+//	set.NewHashSet(1, 2).Union(set.NewHashSet(2, 3)) // {1, 2, 3}
+func (set *HashSet[T]) Union(other *HashSet[T]) *HashSet[T] {
+	result := &HashSet[T]{items: make(map[T]struct{}, len(set.items)+len(other.items))}
+	for item := range set.items {
+		result.items[item] = struct{}{}
+	}
+	for item := range other.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns a new set containing the elements that are in both sets.
+//
+// Time complexity: O(min(n, m))
+//
+// Example:
+//
+//	set.NewHashSet(1, 2).Intersection(set.NewHashSet(2, 3)) // {2}
+func (set *HashSet[T]) Intersection(other *HashSet[T]) *HashSet[T] {
+	smaller, larger := set, other
+	if len(smaller.items) > len(larger.items) {
+		smaller, larger = larger, smaller
+	}
+
+	result := NewHashSet[T]()
+	for item := range smaller.items {
+		if larger.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set containing the elements of this set that are not in other.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	set.NewHashSet(1, 2).Difference(set.NewHashSet(2, 3)) // {1}
+func (set *HashSet[T]) Difference(other *HashSet[T]) *HashSet[T] {
+	result := NewHashSet[T]()
+	for item := range set.items {
+		if !other.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset returns true if every element of this set is also in other.
+// The empty set is a subset of every set.
+//
+// Time complexity: O(n)
+func (set *HashSet[T]) IsSubset(other *HashSet[T]) bool {
+	if len(set.items) > len(other.items) {
+		return false
+	}
+
+	for item := range set.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
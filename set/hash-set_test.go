@@ -0,0 +1,170 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func sortedItems(set *HashSet[int]) []int {
+	items := set.ToSlice()
+	slices.Sort(items)
+	return items
+}
+
+func TestHashSet_Basic(t *testing.T) {
+	set := NewHashSet(1, 2, 2, 3)
+
+	if set.Len() != 3 {
+		t.Fatalf("duplicates were stored, got %d elements", set.Len())
+	}
+
+	set.Add(4, 1)
+	if !set.Contains(4) || set.Len() != 4 {
+		t.Fatal("add failed")
+	}
+
+	set.Remove(1, 10)
+	if set.Contains(1) || set.Len() != 3 {
+		t.Fatal("remove failed")
+	}
+
+	if !slices.Equal(sortedItems(set), []int{2, 3, 4}) {
+		t.Fatalf("wrong items %v", sortedItems(set))
+	}
+}
+
+func TestHashSet_Empty(t *testing.T) {
+	set := NewHashSet[string]()
+
+	if !set.IsEmpty() || set.Len() != 0 {
+		t.Fatal("new set is not empty")
+	}
+	if set.Contains("") {
+		t.Fatal("empty set contains zero value")
+	}
+	if len(set.ToSlice()) != 0 {
+		t.Fatal("empty set has items")
+	}
+}
+
+func TestHashSet_ForEach(t *testing.T) {
+	set := NewHashSet(1, 2, 3, 4, 5)
+
+	sum := 0
+	set.ForEach(func(item int) bool {
+		sum += item
+		return true
+	})
+	if sum != 15 {
+		t.Fatalf("wrong sum %d", sum)
+	}
+
+	visited := 0
+	set.ForEach(func(item int) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Fatalf("iteration was not stopped, visited %d", visited)
+	}
+}
+
+func TestHashSet_Union(t *testing.T) {
+	left := NewHashSet(1, 2, 3)
+	right := NewHashSet(3, 4)
+
+	if union := left.Union(right); !slices.Equal(sortedItems(union), []int{1, 2, 3, 4}) {
+		t.Fatalf("wrong union %v", sortedItems(union))
+	}
+	if left.Len() != 3 || right.Len() != 2 {
+		t.Fatal("union modified its inputs")
+	}
+
+	empty := NewHashSet[int]()
+	if union := left.Union(empty); !slices.Equal(sortedItems(union), []int{1, 2, 3}) {
+		t.Fatalf("wrong union with empty set %v", sortedItems(union))
+	}
+	if union := empty.Union(empty); !union.IsEmpty() {
+		t.Fatal("union of empty sets is not empty")
+	}
+
+	// the result must not share storage with the inputs
+	union := left.Union(right)
+	union.Add(100)
+	if left.Contains(100) {
+		t.Fatal("union shares storage with its input")
+	}
+}
+
+func TestHashSet_Intersection(t *testing.T) {
+	left := NewHashSet(1, 2, 3, 4)
+	right := NewHashSet(3, 4, 5)
+
+	if intersection := left.Intersection(right); !slices.Equal(sortedItems(intersection), []int{3, 4}) {
+		t.Fatalf("wrong intersection %v", sortedItems(intersection))
+	}
+	if intersection := right.Intersection(left); !slices.Equal(sortedItems(intersection), []int{3, 4}) {
+		t.Fatalf("intersection is not commutative %v", sortedItems(intersection))
+	}
+	if left.Len() != 4 || right.Len() != 3 {
+		t.Fatal("intersection modified its inputs")
+	}
+
+	if intersection := left.Intersection(NewHashSet(7, 8)); !intersection.IsEmpty() {
+		t.Fatal("intersection of disjoint sets is not empty")
+	}
+	if intersection := left.Intersection(NewHashSet[int]()); !intersection.IsEmpty() {
+		t.Fatal("intersection with empty set is not empty")
+	}
+}
+
+func TestHashSet_Difference(t *testing.T) {
+	left := NewHashSet(1, 2, 3, 4)
+	right := NewHashSet(3, 4, 5)
+
+	if difference := left.Difference(right); !slices.Equal(sortedItems(difference), []int{1, 2}) {
+		t.Fatalf("wrong difference %v", sortedItems(difference))
+	}
+	if difference := right.Difference(left); !slices.Equal(sortedItems(difference), []int{5}) {
+		t.Fatalf("wrong reverse difference %v", sortedItems(difference))
+	}
+	if left.Len() != 4 || right.Len() != 3 {
+		t.Fatal("difference modified its inputs")
+	}
+
+	empty := NewHashSet[int]()
+	if difference := left.Difference(empty); !slices.Equal(sortedItems(difference), []int{1, 2, 3, 4}) {
+		t.Fatalf("wrong difference with empty set %v", sortedItems(difference))
+	}
+	if difference := empty.Difference(left); !difference.IsEmpty() {
+		t.Fatal("difference of empty set is not empty")
+	}
+	if difference := left.Difference(left); !difference.IsEmpty() {
+		t.Fatal("difference with itself is not empty")
+	}
+}
+
+func TestHashSet_IsSubset(t *testing.T) {
+	small := NewHashSet(1, 2)
+	large := NewHashSet(1, 2, 3)
+	empty := NewHashSet[int]()
+
+	if !small.IsSubset(large) {
+		t.Fatal("expected subset")
+	}
+	if large.IsSubset(small) {
+		t.Fatal("superset reported as subset")
+	}
+	if !small.IsSubset(small) {
+		t.Fatal("a set is a subset of itself")
+	}
+	if !empty.IsSubset(small) || !empty.IsSubset(empty) {
+		t.Fatal("the empty set is a subset of every set")
+	}
+	if small.IsSubset(empty) {
+		t.Fatal("non-empty set reported as subset of the empty set")
+	}
+	if NewHashSet(1, 4).IsSubset(large) {
+		t.Fatal("set with foreign element reported as subset")
+	}
+}
@@ -0,0 +1,104 @@
+// Package stack provides a generic LIFO (last in, first out) stack.
+package stack
+
+import "github.com/0x626f/go-kit/utils"
+
+// Stack is a slice-backed LIFO collection: the last pushed element is the first one popped.
+// Push, Pop and Peek run in amortized O(1) time; the backing slice grows as needed
+// and its capacity is reused after elements are popped.
+//
+// The zero value is an empty stack ready to use. A Stack is not safe for concurrent use.
+//
+// Type parameters:
+//   - D: The type of elements stored in the stack
+type Stack[D any] struct {
+	items []D
+}
+
+// New creates and returns an empty Stack.
+func New[D any]() *Stack[D] {
+	return &Stack[D]{}
+}
+
+// NewWithCapacity creates an empty Stack with room for capacity elements before it has to grow.
+//
+// Parameters:
+//   - capacity: The number of elements to preallocate
+func NewWithCapacity[D any](capacity int) *Stack[D] {
+	return &Stack[D]{items: make([]D, 0, capacity)}
+}
+
+// Wrap creates a new Stack containing the provided items, pushed in order,
+// so the last item ends up on top.
+func Wrap[D any](items ...D) *Stack[D] {
+	instance := NewWithCapacity[D](len(items))
+	instance.items = append(instance.items, items...)
+	return instance
+}
+
+// Push adds an element on top of the stack.
+//
+// Time complexity: amortized O(1)
+func (stack *Stack[D]) Push(item D) {
+	stack.items = append(stack.items, item)
+}
+
+// Pop removes and returns the element on top of the stack.
+//
+// Returns:
+//   - The top element and true if the stack wasn't empty
+//   - A zero value and false if the stack is empty
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := stack.Wrap(1, 2, 3)
+//	top, _ := s.Pop() // top == 3
+func (stack *Stack[D]) Pop() (D, bool) {
+	if len(stack.items) == 0 {
+		return utils.Zero[D](), false
+	}
+
+	last := len(stack.items) - 1
+	item := stack.items[last]
+
+	// release the reference so the popped element can be garbage collected
+	stack.items[last] = utils.Zero[D]()
+	stack.items = stack.items[:last]
+
+	return item, true
+}
+
+// Peek returns the element on top of the stack without removing it.
+//
+// Returns:
+//   - The top element and true if the stack isn't empty
+//   - A zero value and false if the stack is empty
+//
+// Time complexity: O(1)
+func (stack *Stack[D]) Peek() (D, bool) {
+	if len(stack.items) == 0 {
+		return utils.Zero[D](), false
+	}
+
+	return stack.items[len(stack.items)-1], true
+}
+
+// Len returns the number of elements in the stack.
+func (stack *Stack[D]) Len() int {
+	return len(stack.items)
+}
+
+// IsEmpty returns true if the stack contains no elements.
+func (stack *Stack[D]) IsEmpty() bool {
+	return len(stack.items) == 0
+}
+
+// Clear removes all elements from the stack, keeping the allocated capacity for reuse.
+//
+// Time complexity: O(n)
+func (stack *Stack[D]) Clear() {
+	clear(stack.items)
+	stack.items = stack.items[:0]
+}
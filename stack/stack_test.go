@@ -0,0 +1,115 @@
+package stack
+
+import "testing"
+
+func TestStack_EmptyPop(t *testing.T) {
+	stack := New[int]()
+
+	if item, ok := stack.Pop(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if item, ok := stack.Peek(); ok || item != 0 {
+		t.Fatalf("Expected zero value and false, got %d, %v", item, ok)
+	}
+	if !stack.IsEmpty() || stack.Len() != 0 {
+		t.Fatal("New stack should be empty")
+	}
+}
+
+func TestStack_ZeroValue(t *testing.T) {
+	var stack Stack[string]
+
+	stack.Push("a")
+	if item, ok := stack.Pop(); !ok || item != "a" {
+		t.Fatalf("Expected a, got %q, %v", item, ok)
+	}
+}
+
+func TestStack_Ordering(t *testing.T) {
+	stack := New[int]()
+	for i := 0; i < 5; i++ {
+		stack.Push(i)
+	}
+
+	if item, ok := stack.Peek(); !ok || item != 4 {
+		t.Fatalf("Expected 4 on top, got %d, %v", item, ok)
+	}
+	if stack.Len() != 5 {
+		t.Fatalf("Peek should not remove elements, got length %d", stack.Len())
+	}
+
+	for expected := 4; expected >= 0; expected-- {
+		if item, ok := stack.Pop(); !ok || item != expected {
+			t.Fatalf("Expected %d, got %d, %v", expected, item, ok)
+		}
+	}
+
+	if _, ok := stack.Pop(); ok {
+		t.Fatal("Stack should be empty after popping every element")
+	}
+}
+
+func TestStack_Wrap(t *testing.T) {
+	stack := Wrap("a", "b", "c")
+
+	for _, expected := range []string{"c", "b", "a"} {
+		if item, _ := stack.Pop(); item != expected {
+			t.Fatalf("Expected %s, got %s", expected, item)
+		}
+	}
+}
+
+func TestStack_Growth(t *testing.T) {
+	stack := NewWithCapacity[int](2)
+	const count = 10000
+
+	for i := 0; i < count; i++ {
+		stack.Push(i)
+	}
+	if stack.Len() != count {
+		t.Fatalf("Expected %d elements, got %d", count, stack.Len())
+	}
+
+	for i := count - 1; i >= count/2; i-- {
+		if item, _ := stack.Pop(); item != i {
+			t.Fatalf("Expected %d, got %d", i, item)
+		}
+	}
+
+	// interleave pushes with the remaining elements to reuse the freed capacity
+	stack.Push(-1)
+	if item, _ := stack.Pop(); item != -1 {
+		t.Fatalf("Expected -1, got %d", item)
+	}
+	if item, _ := stack.Peek(); item != count/2-1 {
+		t.Fatalf("Expected %d, got %d", count/2-1, item)
+	}
+}
+
+func TestStack_Clear(t *testing.T) {
+	stack := Wrap(1, 2, 3)
+	stack.Clear()
+
+	if !stack.IsEmpty() {
+		t.Fatalf("Expected empty stack, got length %d", stack.Len())
+	}
+	if _, ok := stack.Peek(); ok {
+		t.Fatal("Peek on cleared stack should fail")
+	}
+
+	stack.Push(4)
+	if item, _ := stack.Pop(); item != 4 {
+		t.Fatalf("Expected 4, got %d", item)
+	}
+}
+
+func TestStack_PopReleasesReference(t *testing.T) {
+	stack := New[*int]()
+	value := 1
+	stack.Push(&value)
+	stack.Pop()
+
+	if stack.items[:1][0] != nil {
+		t.Fatal("Popped slot should be zeroed")
+	}
+}
@@ -0,0 +1,111 @@
+package utils
+
+// Map applies f to every element of s and returns the results in a new slice of the same length.
+//
+// Type parameters:
+//   - A: The element type of the input slice
+//   - B: The element type of the result
+//
+// Parameters:
+//   - s: The slice to transform
+//   - f: The function applied to every element
+//
+// Returns a new slice holding f(s[i]) at index i. A nil or empty input yields an empty slice.
+//
+// Example:
+//
+//	lengths := Map([]string{"a", "bcd"}, func(s string) int { return len(s) }) // [1 3]
+func Map[A, B any](s []A, f func(A) B) []B {
+	result := make([]B, len(s))
+	for index, item := range s {
+		result[index] = f(item)
+	}
+	return result
+}
+
+// Filter returns the elements of s satisfying pred, in their original order.
+// The input slice is not modified.
+//
+// Type parameters:
+//   - A: The element type of the slice
+//
+// Parameters:
+//   - s: The slice to filter
+//   - pred: The function deciding whether an element is kept
+//
+// Returns a new slice containing only the elements for which pred returns true.
+//
+// Example:
+//
+//	even := Filter([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 }) // [2 4]
+func Filter[A any](s []A, pred func(A) bool) []A {
+	result := make([]A, 0, len(s))
+	for _, item := range s {
+		if pred(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Reduce folds the elements of s into a single value, from first to last.
+//
+// Type parameters:
+//   - A: The element type of the slice
+//   - B: The type of the accumulated value
+//
+// Parameters:
+//   - s: The slice to fold
+//   - init: The initial accumulated value
+//   - f: The function combining the accumulated value with the next element
+//
+// Returns the final accumulated value, or init if s is empty.
+//
+// Example:
+//
+//	sum := Reduce([]int{1, 2, 3}, 0, func(acc, n int) int { return acc + n }) // 6
+func Reduce[A, B any](s []A, init B, f func(B, A) B) B {
+	result := init
+	for _, item := range s {
+		result = f(result, item)
+	}
+	return result
+}
+
+// Contains reports whether v is an element of s.
+//
+// Type parameters:
+//   - T: The element type of the slice, must be comparable
+//
+// Parameters:
+//   - s: The slice to search
+//   - v: The value to look for
+//
+// Returns true if any element of s equals v.
+func Contains[T comparable](s []T, v T) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the keys of m in a new slice.
+//
+// Type parameters:
+//   - K: The key type of the map must be comparable
+//   - V: The value type of the map
+//
+// Parameters:
+//   - m: The map from which to extract keys
+//
+// Returns a slice of length len(m) containing all the keys of m.
+// Note: The order of keys in the returned slice is not deterministic.
+func Keys[K comparable, V any](m map[K]V) []K {
+	result := make([]K, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []string
+	}{
+		{"nil", nil, []string{}},
+		{"empty", []int{}, []string{}},
+		{"single", []int{1}, []string{"1"}},
+		{"multiple", []int{1, 2, 3}, []string{"1", "2", "3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := Map(test.input, strconv.Itoa)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"nil", nil, []int{}},
+		{"none match", []int{1, 3, 5}, []int{}},
+		{"some match", []int{1, 2, 3, 4}, []int{2, 4}},
+		{"all match", []int{2, 4}, []int{2, 4}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := slices.Clone(test.input)
+			result := Filter(input, even)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+			if !slices.Equal(input, test.input) {
+				t.Fatalf("input was modified: %v", input)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		init     int
+		expected int
+	}{
+		{"nil returns init", nil, 7, 7},
+		{"single", []string{"abc"}, 0, 3},
+		{"multiple", []string{"a", "bc", "def"}, 1, 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := Reduce(test.input, test.init, func(acc int, s string) int { return acc + len(s) })
+			if result != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, result)
+			}
+		})
+	}
+
+	// order of application is first to last
+	joined := Reduce([]string{"a", "b", "c"}, "", func(acc, s string) string { return acc + s })
+	if joined != "abc" {
+		t.Fatalf("expected abc, got %s", joined)
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		value    string
+		expected bool
+	}{
+		{"nil", nil, "a", false},
+		{"present", []string{"a", "b"}, "b", true},
+		{"absent", []string{"a", "b"}, "c", false},
+		{"zero value", []string{""}, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := Contains(test.input, test.value); result != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]int
+		expected []string
+	}{
+		{"nil", nil, []string{}},
+		{"single", map[string]int{"a": 1}, []string{"a"}},
+		{"multiple", map[string]int{"a": 1, "b": 2, "c": 3}, []string{"a", "b", "c"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := Keys(test.input)
+			slices.Sort(result)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
@@ -121,9 +121,9 @@ func Zero[T any]() T {
 // Returns a slice containing all the keys from the input map.
 // Note: The order of keys in the returned slice is not deterministic.
 func MapToKeySlice[K comparable, V any](m map[K]V) []K {
-	slice := make([]K, len(m))
+	slice := make([]K, 0, len(m))
 
-	for key, _ := range m {
+	for key := range m {
 		slice = append(slice, key)
 	}
 	return slice
@@ -144,7 +144,7 @@ func MapToKeySlice[K comparable, V any](m map[K]V) []K {
 // Note: The order of values in the returned slice is not deterministic
 // and corresponds to the order of keys in the map's internal representation.
 func MapToValueSlice[K comparable, V any](m map[K]V) []V {
-	slice := make([]V, len(m))
+	slice := make([]V, 0, len(m))
 
 	for _, value := range m {
 		slice = append(slice, value)
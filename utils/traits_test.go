@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMapToKeySlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]int
+		expected []string
+	}{
+		{"nil", nil, []string{}},
+		{"multiple", map[string]int{"a": 1, "b": 2}, []string{"a", "b"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := MapToKeySlice(test.input)
+			slices.Sort(result)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapToValueSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]int
+		expected []int
+	}{
+		{"nil", nil, []int{}},
+		{"multiple", map[string]int{"a": 1, "b": 2}, []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := MapToValueSlice(test.input)
+			slices.Sort(result)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}